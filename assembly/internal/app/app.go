@@ -38,6 +38,12 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	go func() {
+		if err := a.runOrderRefundedConsumer(ctx); err != nil {
+			errCh <- fmt.Errorf("order refunded consumer crashed: %w", err)
+		}
+	}()
+
 	select {
 	case <-ctx.Done():
 		logger.Info(ctx, "Shutdown signal received")
@@ -93,3 +99,14 @@ func (a *App) runConsumer(ctx context.Context) error {
 
 	return nil
 }
+
+func (a *App) runOrderRefundedConsumer(ctx context.Context) error {
+	logger.Info(ctx, "OrderRefunded Kafka consumer starting")
+
+	err := a.diContainer.OrderRefundedConsumerService().RunConsumer(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ZanDattSu/star-factory/assembly/internal/service"
 	assemblyService "github.com/ZanDattSu/star-factory/assembly/internal/service/assembly"
 	orderPaidConsumer "github.com/ZanDattSu/star-factory/assembly/internal/service/consumer/order_paid_consumer"
+	orderRefundedConsumer "github.com/ZanDattSu/star-factory/assembly/internal/service/consumer/order_refunded_consumer"
 	shipAssembledProducer "github.com/ZanDattSu/star-factory/assembly/internal/service/producer/ship_assembled_producer"
 	"github.com/ZanDattSu/star-factory/platform/pkg/closer"
 	wrappedKafka "github.com/ZanDattSu/star-factory/platform/pkg/kafka"
@@ -25,16 +26,20 @@ type diContainer struct {
 	// Services
 	assemblyService              service.AssemblyService
 	orderPaidConsumerService     service.OrderPaidConsumerService
+	orderRefundedConsumerService service.OrderRefundedConsumerService
 	shipAssembledProducerService service.ShipAssembledProducerService
 
 	// Converters
-	orderPaidDecoder kafkaConverter.OrderPaidDecoder
+	orderPaidDecoder     kafkaConverter.OrderPaidDecoder
+	orderRefundedDecoder kafkaConverter.OrderRefundedDecoder
 
 	// Kafka infrastructure
-	consumerGroup         sarama.ConsumerGroup
-	orderPaidConsumer     wrappedKafka.Consumer
-	shipAssembledProducer wrappedKafka.Producer
-	syncProducer          sarama.SyncProducer
+	consumerGroup              sarama.ConsumerGroup
+	orderRefundedConsumerGroup sarama.ConsumerGroup
+	orderPaidConsumer          wrappedKafka.Consumer
+	orderRefundedConsumer      wrappedKafka.Consumer
+	shipAssembledProducer      wrappedKafka.Producer
+	syncProducer               sarama.SyncProducer
 }
 
 func NewDIContainer() *diContainer {
@@ -59,6 +64,17 @@ func (d *diContainer) OrderPaidConsumerService() service.OrderPaidConsumerServic
 	return d.orderPaidConsumerService
 }
 
+func (d *diContainer) OrderRefundedConsumerService() service.OrderRefundedConsumerService {
+	if d.orderRefundedConsumerService == nil {
+		d.orderRefundedConsumerService = orderRefundedConsumer.NewService(
+			d.OrderRefundedConsumer(),
+			d.OrderRefundedDecoder(),
+			d.AssemblyService(),
+		)
+	}
+	return d.orderRefundedConsumerService
+}
+
 func (d *diContainer) ShipAssembledProducerService() service.ShipAssembledProducerService {
 	if d.shipAssembledProducerService == nil {
 		d.shipAssembledProducerService = shipAssembledProducer.NewService(d.ShipAssembledProducer())
@@ -85,6 +101,25 @@ func (d *diContainer) ConsumerGroup() sarama.ConsumerGroup {
 	return d.consumerGroup
 }
 
+func (d *diContainer) OrderRefundedConsumerGroup() sarama.ConsumerGroup {
+	if d.orderRefundedConsumerGroup == nil {
+		consumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().OrderRefundedConsumer.GroupID(),
+			config.AppConfig().OrderRefundedConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create order refunded consumer group: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka order refunded consumer group", func(ctx context.Context) error {
+			return d.orderRefundedConsumerGroup.Close()
+		})
+
+		d.orderRefundedConsumerGroup = consumerGroup
+	}
+	return d.orderRefundedConsumerGroup
+}
+
 func (d *diContainer) OrderPaidConsumer() wrappedKafka.Consumer {
 	if d.orderPaidConsumer == nil {
 		d.orderPaidConsumer = wrappedKafkaConsumer.NewConsumer(
@@ -99,6 +134,20 @@ func (d *diContainer) OrderPaidConsumer() wrappedKafka.Consumer {
 	return d.orderPaidConsumer
 }
 
+func (d *diContainer) OrderRefundedConsumer() wrappedKafka.Consumer {
+	if d.orderRefundedConsumer == nil {
+		d.orderRefundedConsumer = wrappedKafkaConsumer.NewConsumer(
+			d.OrderRefundedConsumerGroup(),
+			[]string{
+				config.AppConfig().OrderRefundedConsumer.Topic(),
+			},
+			logger.Logger(),
+			kafkaMiddleware.Logging(logger.Logger()),
+		)
+	}
+	return d.orderRefundedConsumer
+}
+
 func (d *diContainer) SyncProducer() sarama.SyncProducer {
 	if d.syncProducer == nil {
 		p, err := sarama.NewSyncProducer(
@@ -135,3 +184,11 @@ func (d *diContainer) OrderPaidDecoder() kafkaConverter.OrderPaidDecoder {
 
 	return d.orderPaidDecoder
 }
+
+func (d *diContainer) OrderRefundedDecoder() kafkaConverter.OrderRefundedDecoder {
+	if d.orderRefundedDecoder == nil {
+		d.orderRefundedDecoder = decoder.NewOrderRefundedDecoder()
+	}
+
+	return d.orderRefundedDecoder
+}
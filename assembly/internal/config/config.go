@@ -11,10 +11,11 @@ import (
 var appConfig *config
 
 type config struct {
-	Logger        LoggerConfig
-	Kafka         KafkaConfig
-	OrderConsumer AssemblyConsumerConfig
-	OrderProducer AssemblyProducerConfig
+	Logger                LoggerConfig
+	Kafka                 KafkaConfig
+	OrderConsumer         AssemblyConsumerConfig
+	OrderRefundedConsumer AssemblyConsumerConfig
+	OrderProducer         AssemblyProducerConfig
 }
 
 func Load(path ...string) error {
@@ -38,16 +39,22 @@ func Load(path ...string) error {
 		return err
 	}
 
+	refundedConsumerCfg, err := env.NewOrderRefundedConsumerConfig()
+	if err != nil {
+		return err
+	}
+
 	producerCfg, err := env.NewOrderProduceConfig()
 	if err != nil {
 		return err
 	}
 
 	appConfig = &config{
-		Logger:        loggerCfg,
-		Kafka:         kafkaCfg,
-		OrderConsumer: consumerCfg,
-		OrderProducer: producerCfg,
+		Logger:                loggerCfg,
+		Kafka:                 kafkaCfg,
+		OrderConsumer:         consumerCfg,
+		OrderRefundedConsumer: refundedConsumerCfg,
+		OrderProducer:         producerCfg,
 	}
 
 	return nil
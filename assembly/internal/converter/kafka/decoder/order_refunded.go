@@ -0,0 +1,31 @@
+package decoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ZanDattSu/star-factory/assembly/internal/model"
+	eventsV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/events/v1"
+)
+
+type orderRefundedDecoder struct{}
+
+func NewOrderRefundedDecoder() *orderRefundedDecoder {
+	return &orderRefundedDecoder{}
+}
+
+func (d *orderRefundedDecoder) Decode(data []byte) (model.OrderRefundedEvent, error) {
+	var pb eventsV1.OrderRefunded
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return model.OrderRefundedEvent{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return model.OrderRefundedEvent{
+		EventUuid:       pb.EventUuid,
+		OrderUuid:       pb.OrderUuid,
+		UserUuid:        pb.UserUuid,
+		TransactionUuid: pb.TransactionUuid,
+		RefundUuid:      pb.RefundUuid,
+	}, nil
+}
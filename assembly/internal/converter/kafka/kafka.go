@@ -6,3 +6,8 @@ import "github.com/ZanDattSu/star-factory/assembly/internal/model"
 type OrderPaidDecoder interface {
 	Decode(data []byte) (model.OrderPaidEvent, error)
 }
+
+// OrderRefundedDecoder - декодер для OrderRefundedEvent события
+type OrderRefundedDecoder interface {
+	Decode(data []byte) (model.OrderRefundedEvent, error)
+}
@@ -11,6 +11,15 @@ type OrderPaidEvent struct {
 	TransactionUuid string
 }
 
+// OrderRefundedEvent - событие "оплата заказа возвращена" (приходит от Order Service)
+type OrderRefundedEvent struct {
+	EventUuid       string
+	OrderUuid       string
+	UserUuid        string
+	TransactionUuid string
+	RefundUuid      string
+}
+
 // ShipAssembledEvent - событие "корабль собран"
 type ShipAssembledEvent struct {
 	EventUuid string
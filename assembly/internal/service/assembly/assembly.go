@@ -14,6 +14,15 @@ import (
 )
 
 func (s *service) ProcessOrderPaid(ctx context.Context, event *model.OrderPaidEvent) error {
+	assemblyCtx, ok := s.startAssembly(ctx, event.OrderUuid)
+	if !ok {
+		logger.Info(ctx, "Skipping ship assembly: order refunded",
+			zap.String("order_uuid", event.OrderUuid),
+		)
+		return nil
+	}
+	defer s.finishAssembly(event.OrderUuid)
+
 	logger.Info(ctx, "Starting ship assembly",
 		zap.String("order_uuid", event.OrderUuid),
 		zap.String("user_uuid", event.UserUuid),
@@ -33,9 +42,16 @@ func (s *service) ProcessOrderPaid(ctx context.Context, event *model.OrderPaidEv
 
 	timer := time.NewTimer(buildTime)
 	select {
-	case <-ctx.Done():
+	case <-assemblyCtx.Done():
 		timer.Stop()
-		return ctx.Err()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// Сборку остановил возврат оплаты: событие о сборке не публикуем
+		logger.Info(ctx, "Ship assembly cancelled",
+			zap.String("order_uuid", event.OrderUuid),
+		)
+		return nil
 	case <-timer.C:
 	}
 
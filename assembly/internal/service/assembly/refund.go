@@ -0,0 +1,29 @@
+package assembly
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/assembly/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+func (s *service) ProcessOrderRefunded(ctx context.Context, event *model.OrderRefundedEvent) error {
+	stopped := s.stopAssembly(event.OrderUuid)
+
+	if stopped {
+		logger.Info(ctx, "Ship assembly stopped: order refunded",
+			zap.String("order_uuid", event.OrderUuid),
+			zap.String("refund_uuid", event.RefundUuid),
+		)
+		return nil
+	}
+
+	logger.Info(ctx, "No assembly in progress for refunded order",
+		zap.String("order_uuid", event.OrderUuid),
+		zap.String("refund_uuid", event.RefundUuid),
+	)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package assembly
+
+import "context"
+
+// startAssembly регистрирует сборку заказа и возвращает контекст, который отменяется при возврате оплаты.
+// Если по заказу уже пришёл возврат, сборка не начинается.
+func (s *service) startAssembly(ctx context.Context, orderUUID string) (context.Context, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.refunded[orderUUID]; ok {
+		return nil, false
+	}
+
+	assemblyCtx, cancel := context.WithCancel(ctx)
+	s.inProgress[orderUUID] = cancel
+
+	return assemblyCtx, true
+}
+
+// finishAssembly снимает сборку заказа с учёта.
+func (s *service) finishAssembly(orderUUID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cancel, ok := s.inProgress[orderUUID]; ok {
+		cancel()
+		delete(s.inProgress, orderUUID)
+	}
+}
+
+// stopAssembly останавливает идущую сборку заказа и запоминает возврат, чтобы не начать сборку
+// при повторной или запоздавшей доставке OrderPaid. Возвращает true, если сборка была прервана.
+func (s *service) stopAssembly(orderUUID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.refunded[orderUUID] = struct{}{}
+
+	cancel, ok := s.inProgress[orderUUID]
+	if !ok {
+		return false
+	}
+
+	cancel()
+	delete(s.inProgress, orderUUID)
+
+	return true
+}
@@ -1,15 +1,29 @@
 package assembly
 
-import serv "github.com/ZanDattSu/star-factory/assembly/internal/service"
+import (
+	"context"
+	"sync"
+
+	serv "github.com/ZanDattSu/star-factory/assembly/internal/service"
+)
 
 var _ serv.AssemblyService = (*service)(nil)
 
 type service struct {
 	shipAssembledProducer serv.ShipAssembledProducerService
+
+	// Состояние сборок живёт в памяти процесса и рассчитано на один экземпляр сервиса.
+	mu sync.Mutex
+	// inProgress отмена идущих сборок по UUID заказа.
+	inProgress map[string]context.CancelFunc
+	// refunded заказы, возврат по которым пришёл раньше OrderPaid.
+	refunded map[string]struct{}
 }
 
 func NewService(shipAssembledProducer serv.ShipAssembledProducerService) *service {
 	return &service{
 		shipAssembledProducer: shipAssembledProducer,
+		inProgress:            make(map[string]context.CancelFunc),
+		refunded:              make(map[string]struct{}),
 	}
 }
@@ -0,0 +1,42 @@
+package order_refunded_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/ZanDattSu/star-factory/assembly/internal/converter/kafka"
+	serv "github.com/ZanDattSu/star-factory/assembly/internal/service"
+	"github.com/ZanDattSu/star-factory/platform/pkg/kafka"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+type service struct {
+	orderRefundedConsumer kafka.Consumer
+	orderRefundedDecoder  kafkaConverter.OrderRefundedDecoder
+	assemblyService       serv.AssemblyService
+}
+
+func NewService(
+	orderRefundedConsumer kafka.Consumer,
+	orderRefundedDecoder kafkaConverter.OrderRefundedDecoder,
+	assemblyService serv.AssemblyService,
+) *service {
+	return &service{
+		orderRefundedConsumer: orderRefundedConsumer,
+		orderRefundedDecoder:  orderRefundedDecoder,
+		assemblyService:       assemblyService,
+	}
+}
+
+func (s *service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting consumer for order.refunded topic")
+
+	err := s.orderRefundedConsumer.Consume(ctx, s.handleOrderRefunded)
+	if err != nil {
+		logger.Error(ctx, "Failed to consume from order.refunded topic", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
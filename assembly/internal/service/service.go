@@ -9,6 +9,7 @@ import (
 // AssemblyService - бизнес-логика сборки корабля
 type AssemblyService interface {
 	ProcessOrderPaid(ctx context.Context, event *model.OrderPaidEvent) error
+	ProcessOrderRefunded(ctx context.Context, event *model.OrderRefundedEvent) error
 }
 
 // OrderPaidConsumerService - слушает "order.paid" топик
@@ -16,6 +17,11 @@ type OrderPaidConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
 
+// OrderRefundedConsumerService - слушает "order.refunded" топик
+type OrderRefundedConsumerService interface {
+	RunConsumer(ctx context.Context) error
+}
+
 // ShipAssembledProducerService - отправляет в "ship.assembled" топик
 type ShipAssembledProducerService interface {
 	PublishShipAssembled(ctx context.Context, event *model.ShipAssembledEvent) error
@@ -56,6 +56,16 @@ func main() {
 		}
 	}()
 
+	go func() {
+		if err = a.RunReservationSweeper(appCtx); err != nil {
+
+			logger.Error(appCtx, "Ошибка в sweeper'е резервов", zap.Error(err))
+
+			return
+
+		}
+	}()
+
 	if err = a.RunHTTP(appCtx); err != nil {
 
 		logger.Error(appCtx, "Ошибка при работе приложения", zap.Error(err))
@@ -7,11 +7,19 @@ import (
 
 type api struct {
 	inventoryV1.UnimplementedInventoryServiceServer
-	partService service.PartService
+	partService        service.PartService
+	reservationService service.ReservationService
+	stockService       service.StockService
 }
 
-func NewApi(partService service.PartService) *api {
+func NewApi(
+	partService service.PartService,
+	reservationService service.ReservationService,
+	stockService service.StockService,
+) *api {
 	return &api{
-		partService: partService,
+		partService:        partService,
+		reservationService: reservationService,
+		stockService:       stockService,
 	}
 }
@@ -0,0 +1,138 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/converter"
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/grpc/interceptor"
+	inventoryV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CreatePart(ctx context.Context, req *inventoryV1.CreatePartRequest) (*inventoryV1.CreatePartResponse, error) {
+	userUUID, err := sessionUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	part, err := a.partService.CreatePart(ctx, userUUID, converter.PartInfoToModel(req.Info))
+	if err != nil {
+		return nil, catalogError(err)
+	}
+
+	return &inventoryV1.CreatePartResponse{
+		Part: converter.PartToProto(part),
+	}, nil
+}
+
+func (a *api) UpdatePart(ctx context.Context, req *inventoryV1.UpdatePartRequest) (*inventoryV1.UpdatePartResponse, error) {
+	userUUID, err := sessionUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.UpdateMask.IsValid(&inventoryV1.PartInfo{}) {
+		return nil, status.Error(codes.InvalidArgument, "update_mask contains unknown fields")
+	}
+	if err = validateMasked(req.Info, req.UpdateMask.GetPaths()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("validation failed: %v", err))
+	}
+
+	part, err := a.partService.UpdatePart(ctx, userUUID, req.Uuid, converter.PartInfoToModel(req.Info), req.UpdateMask.GetPaths())
+	if err != nil {
+		return nil, catalogError(err)
+	}
+
+	return &inventoryV1.UpdatePartResponse{
+		Part: converter.PartToProto(part),
+	}, nil
+}
+
+func (a *api) DeletePart(ctx context.Context, req *inventoryV1.DeletePartRequest) (*inventoryV1.DeletePartResponse, error) {
+	userUUID, err := sessionUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.partService.DeletePart(ctx, userUUID, req.Uuid); err != nil {
+		return nil, catalogError(err)
+	}
+
+	return &inventoryV1.DeletePartResponse{}, nil
+}
+
+func (a *api) BatchUpsertParts(ctx context.Context, req *inventoryV1.BatchUpsertPartsRequest) (*inventoryV1.BatchUpsertPartsResponse, error) {
+	userUUID, err := sessionUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := a.partService.BatchUpsertParts(ctx, userUUID, converter.UpsertPartsToModel(req.Parts))
+	if err != nil {
+		return nil, catalogError(err)
+	}
+
+	return &inventoryV1.BatchUpsertPartsResponse{
+		Parts: converter.PartsToProto(parts),
+	}, nil
+}
+
+// sessionUserUUID возвращает UUID пользователя, которого auth interceptor положил в контекст.
+func sessionUserUUID(ctx context.Context) (string, error) {
+	user, ok := interceptor.GetUserFromContext(ctx)
+	if !ok || user.GetUuid() == "" {
+		return "", status.Error(codes.Unauthenticated, "user is not authenticated")
+	}
+
+	return user.GetUuid(), nil
+}
+
+// validateMasked проверяет правила PartInfo только для полей из маски: остальные поля в запросе не заданы.
+func validateMasked(info *inventoryV1.PartInfo, paths []string) error {
+	var multi inventoryV1.PartInfoMultiError
+	if !errors.As(info.ValidateAll(), &multi) {
+		return nil
+	}
+
+	for _, err := range multi.AllErrors() {
+		var fieldErr inventoryV1.PartInfoValidationError
+		if !errors.As(err, &fieldErr) {
+			continue
+		}
+		// PGV называет поля по имени в Go: stock_quantity → StockQuantity
+		if slices.ContainsFunc(paths, func(path string) bool {
+			return strings.EqualFold(strings.ReplaceAll(path, "_", ""), fieldErr.Field())
+		}) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// catalogError переводит доменные ошибки управления каталогом в gRPC-статусы.
+func catalogError(err error) error {
+	var (
+		forbidden *model.ForbiddenError
+		notFound  *model.PartNotFoundError
+		invalid   *model.InvalidPartUpdateError
+	)
+
+	switch {
+	case errors.As(err, &forbidden):
+		return status.Error(codes.PermissionDenied, forbidden.Error())
+	case errors.As(err, &notFound):
+		return status.Error(codes.NotFound, notFound.Error())
+	case errors.As(err, &invalid):
+		return status.Error(codes.InvalidArgument, invalid.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
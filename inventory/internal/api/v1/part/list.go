@@ -2,21 +2,32 @@ package part
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/ZanDattSu/star-factory/inventory/internal/converter"
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
 	inventoryV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/inventory/v1"
 )
 
 func (a *api) ListParts(ctx context.Context, req *inventoryV1.ListPartsRequest) (*inventoryV1.ListPartsResponse, error) {
-	parts, err := a.partService.ListParts(ctx, converter.PartsFilterToModel(req.Filter))
+	list, err := a.partService.ListParts(ctx,
+		converter.PartsFilterToModel(req.Filter),
+		converter.PartsPageRequestToModel(req),
+	)
 	if err != nil {
+		var tokenErr *model.InvalidPageTokenError
+		if errors.As(err, &tokenErr) {
+			return nil, status.Error(codes.InvalidArgument, tokenErr.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &inventoryV1.ListPartsResponse{
-		Parts: converter.PartsToProto(parts),
+		Parts:         converter.PartsToProto(list.Parts),
+		NextPageToken: list.NextPageToken,
+		TotalCount:    list.TotalCount,
 	}, nil
 }
@@ -0,0 +1,79 @@
+package part
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/converter"
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	inventoryV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ReserveParts(ctx context.Context, req *inventoryV1.ReservePartsRequest) (*inventoryV1.ReservePartsResponse, error) {
+	reservation, err := a.reservationService.ReserveParts(ctx,
+		req.OrderUuid,
+		converter.ReservationItemsToModel(req.Items),
+		req.Ttl.AsDuration(),
+	)
+	if err != nil {
+		return nil, reservationError(err)
+	}
+
+	return &inventoryV1.ReservePartsResponse{
+		ReservationUuid: reservation.Uuid,
+		ExpiresAt:       timestamppb.New(reservation.ExpiresAt),
+	}, nil
+}
+
+func (a *api) ReleaseReservation(ctx context.Context, req *inventoryV1.ReleaseReservationRequest) (*inventoryV1.ReleaseReservationResponse, error) {
+	err := a.reservationService.ReleaseReservation(ctx, req.ReservationUuid)
+	if err != nil {
+		return nil, reservationError(err)
+	}
+
+	return &inventoryV1.ReleaseReservationResponse{}, nil
+}
+
+func (a *api) CommitReservation(ctx context.Context, req *inventoryV1.CommitReservationRequest) (*inventoryV1.CommitReservationResponse, error) {
+	err := a.reservationService.CommitReservation(ctx, req.ReservationUuid)
+	if err != nil {
+		return nil, reservationError(err)
+	}
+
+	return &inventoryV1.CommitReservationResponse{}, nil
+}
+
+// reservationError переводит доменные ошибки резервирования в gRPC-статусы.
+// Нехватка остатков отдаётся как FailedPrecondition с деталями InsufficientStock.
+func reservationError(err error) error {
+	var stockErr *model.InsufficientStockError
+	if errors.As(err, &stockErr) {
+		st, detailErr := status.New(codes.FailedPrecondition, stockErr.Error()).
+			WithDetails(converter.InsufficientStockToProto(stockErr))
+		if detailErr != nil {
+			return status.Error(codes.FailedPrecondition, stockErr.Error())
+		}
+		return st.Err()
+	}
+
+	var notFoundErr *model.ReservationNotFoundError
+	if errors.As(err, &notFoundErr) {
+		return status.Error(codes.NotFound, notFoundErr.Error())
+	}
+
+	var conflictErr *model.ReservationConflictError
+	if errors.As(err, &conflictErr) {
+		return status.Error(codes.AlreadyExists, conflictErr.Error())
+	}
+
+	var stateErr *model.ReservationStateError
+	if errors.As(err, &stateErr) {
+		return status.Error(codes.FailedPrecondition, stateErr.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
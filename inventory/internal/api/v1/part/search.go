@@ -0,0 +1,26 @@
+package part
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/converter"
+	inventoryV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) SearchParts(ctx context.Context, req *inventoryV1.SearchPartsRequest) (*inventoryV1.SearchPartsResponse, error) {
+	hits, err := a.partService.SearchParts(ctx,
+		req.Query,
+		converter.PartsFilterToModel(req.Filter),
+		int(req.Limit),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &inventoryV1.SearchPartsResponse{
+		Hits: converter.PartSearchHitsToProto(hits),
+	}, nil
+}
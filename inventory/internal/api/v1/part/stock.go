@@ -0,0 +1,76 @@
+package part
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/converter"
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	inventoryV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) AdjustStock(ctx context.Context, req *inventoryV1.AdjustStockRequest) (*inventoryV1.AdjustStockResponse, error) {
+	userUUID, err := sessionUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	movement, err := a.stockService.AdjustStock(ctx, userUUID, converter.AdjustStockRequestToModel(req))
+	if err != nil {
+		return nil, stockError(err)
+	}
+
+	return &inventoryV1.AdjustStockResponse{
+		Movement: converter.StockMovementToProto(movement),
+	}, nil
+}
+
+func (a *api) ListStockMovements(ctx context.Context, req *inventoryV1.ListStockMovementsRequest) (*inventoryV1.ListStockMovementsResponse, error) {
+	userUUID, err := sessionUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := a.stockService.ListStockMovements(ctx, userUUID, req.PartUuid, model.StockMovementsPageRequest{
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		return nil, stockError(err)
+	}
+
+	return &inventoryV1.ListStockMovementsResponse{
+		Movements:     converter.StockMovementsToProto(list.Movements),
+		NextPageToken: list.NextPageToken,
+	}, nil
+}
+
+// stockError переводит доменные ошибки движения остатков в gRPC-статусы.
+// Нехватка остатка на списание отдаётся так же, как при резервировании.
+func stockError(err error) error {
+	var (
+		stockErr  *model.InsufficientStockError
+		invalid   *model.InvalidStockMovementError
+		tokenErr  *model.InvalidPageTokenError
+		forbidden *model.ForbiddenError
+		notFound  *model.PartNotFoundError
+	)
+
+	switch {
+	case errors.As(err, &stockErr):
+		return reservationError(stockErr)
+	case errors.As(err, &invalid):
+		return status.Error(codes.InvalidArgument, invalid.Error())
+	case errors.As(err, &tokenErr):
+		return status.Error(codes.InvalidArgument, tokenErr.Error())
+	case errors.As(err, &forbidden):
+		return status.Error(codes.PermissionDenied, forbidden.Error())
+	case errors.As(err, &notFound):
+		return status.Error(codes.NotFound, notFound.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
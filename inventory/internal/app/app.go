@@ -43,6 +43,10 @@ func (a *App) RunHTTP(ctx context.Context) error {
 	return a.runHTTPServer(ctx)
 }
 
+func (a *App) RunReservationSweeper(ctx context.Context) error {
+	return a.diContainer.ReservationService(ctx).RunExpirySweeper(ctx)
+}
+
 func (a *App) initDeps(ctx context.Context) error {
 	inits := []func(ctx context.Context) error{
 		a.initLogger,
@@ -12,8 +12,13 @@ import (
 	"github.com/ZanDattSu/star-factory/inventory/internal/config"
 	"github.com/ZanDattSu/star-factory/inventory/internal/repository"
 	inventoryRepository "github.com/ZanDattSu/star-factory/inventory/internal/repository/part/mongodb"
+	reservationRepository "github.com/ZanDattSu/star-factory/inventory/internal/repository/reservation/mongodb"
+	stockRepository "github.com/ZanDattSu/star-factory/inventory/internal/repository/stock/mongodb"
 	"github.com/ZanDattSu/star-factory/inventory/internal/service"
 	inventoryService "github.com/ZanDattSu/star-factory/inventory/internal/service/part"
+	reservationService "github.com/ZanDattSu/star-factory/inventory/internal/service/reservation"
+	stockService "github.com/ZanDattSu/star-factory/inventory/internal/service/stock"
+	"github.com/ZanDattSu/star-factory/platform/pkg/admin"
 	"github.com/ZanDattSu/star-factory/platform/pkg/closer"
 	grpcclient "github.com/ZanDattSu/star-factory/platform/pkg/grpc"
 	"github.com/ZanDattSu/star-factory/platform/pkg/grpc/interceptor"
@@ -26,10 +31,17 @@ type diContainer struct {
 
 	authClient      authV1.AuthServiceClient
 	authInterceptor *interceptor.AuthInterceptor
+	adminChecker    *admin.Checker
 
 	partService    service.PartService
 	partRepository repository.PartRepository
 
+	reservationService    service.ReservationService
+	reservationRepository repository.ReservationRepository
+
+	stockService    service.StockService
+	stockRepository repository.StockRepository
+
 	mongoDBClient   *mongo.Client
 	mongoDBDatabase *mongo.Database
 }
@@ -40,12 +52,20 @@ func NewDIContainer() *diContainer {
 
 func (d *diContainer) InventoryV1Api(ctx context.Context) inventoryV1.InventoryServiceServer {
 	if d.inventoryV1Api == nil {
-		d.inventoryV1Api = inventoryV1Api.NewApi(d.PartService(ctx))
+		d.inventoryV1Api = inventoryV1Api.NewApi(d.PartService(ctx), d.ReservationService(ctx), d.StockService(ctx))
 	}
 
 	return d.inventoryV1Api
 }
 
+func (d *diContainer) AdminChecker() *admin.Checker {
+	if d.adminChecker == nil {
+		d.adminChecker = admin.NewChecker(config.AppConfig().Admin.UserUUIDs())
+	}
+
+	return d.adminChecker
+}
+
 func (d *diContainer) AuthClient(_ context.Context) authV1.AuthServiceClient {
 	if d.authClient == nil {
 		authConn, err := grpcclient.NewGRPCConnectWithoutSecure(config.AppConfig().Auth.AuthServiceAddress())
@@ -69,7 +89,16 @@ func (d *diContainer) AuthClient(_ context.Context) authV1.AuthServiceClient {
 
 func (d *diContainer) AuthInterceptor(ctx context.Context) *interceptor.AuthInterceptor {
 	if d.authInterceptor == nil {
-		d.authInterceptor = interceptor.NewAuthInterceptor(d.AuthClient(ctx))
+		d.authInterceptor = interceptor.NewAuthInterceptor(
+			d.AuthClient(ctx),
+			// Резервы двигает только order, пользователям они напрямую недоступны
+			interceptor.WithServiceMethods(
+				config.AppConfig().Auth.ServiceToken(),
+				inventoryV1.InventoryService_ReserveParts_FullMethodName,
+				inventoryV1.InventoryService_ReleaseReservation_FullMethodName,
+				inventoryV1.InventoryService_CommitReservation_FullMethodName,
+			),
+		)
 	}
 
 	return d.authInterceptor
@@ -77,7 +106,7 @@ func (d *diContainer) AuthInterceptor(ctx context.Context) *interceptor.AuthInte
 
 func (d *diContainer) PartService(ctx context.Context) service.PartService {
 	if d.partService == nil {
-		d.partService = inventoryService.NewService(d.PartRepository(ctx))
+		d.partService = inventoryService.NewService(d.PartRepository(ctx), d.AdminChecker())
 	}
 
 	return d.partService
@@ -91,6 +120,47 @@ func (d *diContainer) PartRepository(ctx context.Context) repository.PartReposit
 	return d.partRepository
 }
 
+func (d *diContainer) ReservationService(ctx context.Context) service.ReservationService {
+	if d.reservationService == nil {
+		d.reservationService = reservationService.NewService(
+			d.ReservationRepository(ctx),
+			config.AppConfig().Reservation.TTL(),
+			config.AppConfig().Reservation.SweepInterval(),
+		)
+	}
+
+	return d.reservationService
+}
+
+func (d *diContainer) ReservationRepository(ctx context.Context) repository.ReservationRepository {
+	if d.reservationRepository == nil {
+		repo, err := reservationRepository.NewRepository(d.MongoDBDatabase(ctx)) //nolint:contextcheck
+		if err != nil {
+			panic(fmt.Sprintf("failed to init reservation repository: %v", err))
+		}
+
+		d.reservationRepository = repo
+	}
+
+	return d.reservationRepository
+}
+
+func (d *diContainer) StockService(ctx context.Context) service.StockService {
+	if d.stockService == nil {
+		d.stockService = stockService.NewService(d.StockRepository(ctx), d.AdminChecker())
+	}
+
+	return d.stockService
+}
+
+func (d *diContainer) StockRepository(ctx context.Context) repository.StockRepository {
+	if d.stockRepository == nil {
+		d.stockRepository = stockRepository.NewRepository(d.MongoDBDatabase(ctx)) //nolint:contextcheck
+	}
+
+	return d.stockRepository
+}
+
 func (d *diContainer) MongoDBDatabase(ctx context.Context) *mongo.Database {
 	if d.mongoDBDatabase == nil {
 		d.mongoDBDatabase = d.MongoDBClient(ctx).Database(config.AppConfig().Mongo.DatabaseName())
@@ -4,6 +4,7 @@ import (
 	"github.com/joho/godotenv"
 
 	"github.com/ZanDattSu/star-factory/inventory/internal/config/env"
+	"github.com/ZanDattSu/star-factory/platform/pkg/admin"
 )
 
 var appConfig *config
@@ -15,6 +16,8 @@ type config struct {
 	InventoryHTTP InventoryHTTPConfig
 	Auth          AuthGRPCService
 	Mongo         MongoConfig
+	Reservation   ReservationConfig
+	Admin         AdminConfig
 }
 
 func Load(path ...string) error {
@@ -43,6 +46,16 @@ func Load(path ...string) error {
 		return err
 	}
 
+	reservation, err := env.NewReservationConfig()
+	if err != nil {
+		return err
+	}
+
+	adminCfg, err := admin.NewConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		App:           app,
 		Logger:        logger,
@@ -50,6 +63,8 @@ func Load(path ...string) error {
 		InventoryHTTP: inventory,
 		Auth:          inventory,
 		Mongo:         mongo,
+		Reservation:   reservation,
+		Admin:         adminCfg,
 	}
 
 	return nil
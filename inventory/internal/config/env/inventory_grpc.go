@@ -12,6 +12,8 @@ type inventoryGRPCEnvConfig struct {
 	HTTPPort     string `env:"HTTP_GATEWAY_PORT,required"`
 	AuthGRPCHost string `env:"AUTH_GRPC_HOST,required"`
 	AuthGRPCPort string `env:"AUTH_GRPC_PORT,required"`
+	// ServiceToken токен, с которым другие сервисы вызывают внутренние методы (резервы деталей)
+	ServiceToken string `env:"INTERNAL_SERVICE_TOKEN,required"`
 }
 
 type inventoryGRPCConfig struct {
@@ -49,3 +51,7 @@ func (cfg *inventoryGRPCConfig) AuthServicePort() string {
 func (cfg *inventoryGRPCConfig) AuthServiceAddress() string {
 	return net.JoinHostPort(cfg.raw.AuthGRPCHost, cfg.raw.AuthGRPCPort)
 }
+
+func (cfg *inventoryGRPCConfig) ServiceToken() string {
+	return cfg.raw.ServiceToken
+}
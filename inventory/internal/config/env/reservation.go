@@ -0,0 +1,33 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type reservationEnvConfig struct {
+	TTL           time.Duration `env:"RESERVATION_TTL" envDefault:"15m"`
+	SweepInterval time.Duration `env:"RESERVATION_SWEEP_INTERVAL" envDefault:"1m"`
+}
+
+type reservationConfig struct {
+	raw reservationEnvConfig
+}
+
+func NewReservationConfig() (*reservationConfig, error) {
+	var raw reservationEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &reservationConfig{raw: raw}, nil
+}
+
+func (cfg *reservationConfig) TTL() time.Duration {
+	return cfg.raw.TTL
+}
+
+func (cfg *reservationConfig) SweepInterval() time.Duration {
+	return cfg.raw.SweepInterval
+}
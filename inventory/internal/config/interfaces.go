@@ -24,6 +24,8 @@ type InventoryHTTPConfig interface {
 type AuthGRPCService interface {
 	AuthServiceAddress() string
 	AuthServicePort() string
+	// ServiceToken токен внутренних вызовов от других сервисов.
+	ServiceToken() string
 }
 
 type MongoConfig interface {
@@ -32,3 +34,13 @@ type MongoConfig interface {
 	ConnectTimeout() time.Duration
 	ShutdownTimeout() time.Duration
 }
+
+type ReservationConfig interface {
+	TTL() time.Duration
+	SweepInterval() time.Duration
+}
+
+type AdminConfig interface {
+	// UserUUIDs пользователи, которым доступно управление каталогом деталей и остатками.
+	UserUUIDs() []string
+}
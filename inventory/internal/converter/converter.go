@@ -4,6 +4,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 	inventoryV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/inventory/v1"
 )
 
@@ -19,13 +20,14 @@ func PartToProto(part *model.Part) *inventoryV1.Part {
 		Uuid:          part.Uuid,
 		Name:          part.Name,
 		Description:   part.Description,
-		Price:         part.Price,
+		Price:         money.ToProto(part.Price),
 		StockQuantity: part.StockQuantity,
 		Category:      CategoryToProto(part.Category),
 		Dimensions:    DimensionsToProto(part.Dimensions),
 		Manufacturer:  ManufacturerToProto(part.Manufacturer),
 		Tags:          part.Tags,
 		Metadata:      MetadataToProto(part.Metadata),
+		Discontinued:  part.Discontinued,
 		CreatedAt:     timestamppb.New(part.CreatedAt),
 		UpdatedAt:     timestamppb.New(part.UpdatedAt),
 	}
@@ -41,13 +43,14 @@ func PartToModel(part *inventoryV1.Part) *model.Part {
 		Uuid:          part.Uuid,
 		Name:          part.Name,
 		Description:   part.Description,
-		Price:         part.Price,
+		Price:         money.FromProto(part.Price),
 		StockQuantity: part.StockQuantity,
 		Category:      CategoryToModel(part.Category),
 		Dimensions:    DimensionsToModel(part.Dimensions),
 		Manufacturer:  ManufacturerToModel(part.Manufacturer),
 		Tags:          part.Tags,
 		Metadata:      MetadataToModel(part.Metadata),
+		Discontinued:  part.Discontinued,
 		CreatedAt:     part.CreatedAt.AsTime(),
 		UpdatedAt:     part.UpdatedAt.AsTime(),
 	}
@@ -235,6 +238,7 @@ func PartsFilterToProto(filter model.PartsFilter) *inventoryV1.PartsFilter {
 		Categories:            categoriesToProto(filter.Categories),
 		ManufacturerCountries: filter.ManufacturerCountries,
 		Tags:                  filter.Tags,
+		IncludeDiscontinued:   filter.IncludeDiscontinued,
 	}
 }
 
@@ -250,6 +254,93 @@ func PartsFilterToModel(filter *inventoryV1.PartsFilter) *model.PartsFilter {
 		Categories:            categoriesFromProto(filter.Categories),
 		ManufacturerCountries: filter.ManufacturerCountries,
 		Tags:                  filter.Tags,
+		IncludeDiscontinued:   filter.IncludeDiscontinued,
+	}
+}
+
+// === Catalog ===
+
+// PartInfoToModel конвертирует protobuf PartInfo в model.Part без UUID и дат
+func PartInfoToModel(info *inventoryV1.PartInfo) *model.Part {
+	if info == nil {
+		return &model.Part{}
+	}
+
+	return &model.Part{
+		Name:          info.Name,
+		Description:   info.Description,
+		Price:         money.FromProto(info.Price),
+		StockQuantity: info.StockQuantity,
+		Category:      CategoryToModel(info.Category),
+		Dimensions:    DimensionsToModel(info.Dimensions),
+		Manufacturer:  ManufacturerToModel(info.Manufacturer),
+		Tags:          info.Tags,
+		Metadata:      MetadataToModel(info.Metadata),
+		Discontinued:  info.Discontinued,
+	}
+}
+
+// UpsertPartsToModel конвертирует []*inventoryV1.UpsertPart → []*model.Part
+func UpsertPartsToModel(parts []*inventoryV1.UpsertPart) []*model.Part {
+	out := make([]*model.Part, 0, len(parts))
+	for _, p := range parts {
+		part := PartInfoToModel(p.Info)
+		part.Uuid = p.Uuid
+		out = append(out, part)
+	}
+	return out
+}
+
+// === Search ===
+
+// PartSearchHitsToProto конвертирует []*model.PartSearchHit → []*inventoryV1.PartSearchHit
+func PartSearchHitsToProto(hits []*model.PartSearchHit) []*inventoryV1.PartSearchHit {
+	out := make([]*inventoryV1.PartSearchHit, 0, len(hits))
+	for _, hit := range hits {
+		highlights := make([]*inventoryV1.SearchHighlight, 0, len(hit.Highlights))
+		for _, h := range hit.Highlights {
+			highlights = append(highlights, &inventoryV1.SearchHighlight{
+				Field:   h.Field,
+				Snippet: h.Snippet,
+			})
+		}
+
+		out = append(out, &inventoryV1.PartSearchHit{
+			Part:       PartToProto(hit.Part),
+			Score:      hit.Score,
+			Highlights: highlights,
+		})
+	}
+	return out
+}
+
+// === Pagination ===
+
+// PartsPageRequestToModel конвертирует параметры страницы ListPartsRequest в model.PartsPageRequest
+func PartsPageRequestToModel(req *inventoryV1.ListPartsRequest) model.PartsPageRequest {
+	return model.PartsPageRequest{
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+		OrderBy: model.PartsOrderBy{
+			Field: PartsOrderFieldToModel(req.GetOrderBy().GetField()),
+			Desc:  req.GetOrderBy().GetDesc(),
+		},
+	}
+}
+
+// PartsOrderFieldToModel конвертирует protobuf PartsOrderField в model.PartsOrderField
+func PartsOrderFieldToModel(field inventoryV1.PartsOrderField) model.PartsOrderField {
+	switch field {
+	case inventoryV1.PartsOrderField_PARTS_ORDER_FIELD_PRICE:
+		return model.PartsOrderFieldPrice
+	case inventoryV1.PartsOrderField_PARTS_ORDER_FIELD_NAME:
+		return model.PartsOrderFieldName
+	case inventoryV1.PartsOrderField_PARTS_ORDER_FIELD_CREATED_AT:
+		return model.PartsOrderFieldCreatedAt
+	case inventoryV1.PartsOrderField_PARTS_ORDER_FIELD_STOCK_QUANTITY:
+		return model.PartsOrderFieldStockQuantity
+	default:
+		return model.PartsOrderFieldUnspecified
 	}
 }
 
@@ -270,3 +361,109 @@ func categoriesFromProto(cats []inventoryV1.Category) []model.Category {
 	}
 	return out
 }
+
+// === Reservation ===
+
+// ReservationItemsToModel конвертирует позиции резерва из protobuf в модель
+func ReservationItemsToModel(items []*inventoryV1.ReservationItem) []model.ReservationItem {
+	result := make([]model.ReservationItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, model.ReservationItem{
+			PartUuid: item.GetPartUuid(),
+			Quantity: item.GetQuantity(),
+		})
+	}
+
+	return result
+}
+
+// InsufficientStockToProto конвертирует ошибку нехватки остатков в детали gRPC-статуса
+func InsufficientStockToProto(err *model.InsufficientStockError) *inventoryV1.InsufficientStock {
+	shortages := make([]*inventoryV1.StockShortage, 0, len(err.Shortages))
+	for _, s := range err.Shortages {
+		shortages = append(shortages, &inventoryV1.StockShortage{
+			PartUuid:  s.PartUuid,
+			Requested: s.Requested,
+			Available: s.Available,
+		})
+	}
+
+	return &inventoryV1.InsufficientStock{Shortages: shortages}
+}
+
+// === StockMovement ===
+
+// StockMovementToProto конвертирует движение остатка в protobuf
+func StockMovementToProto(m *model.StockMovement) *inventoryV1.StockMovement {
+	if m == nil {
+		return nil
+	}
+
+	return &inventoryV1.StockMovement{
+		Uuid:            m.Uuid,
+		PartUuid:        m.PartUuid,
+		Sequence:        m.Sequence,
+		Type:            StockMovementTypeToProto(m.Type),
+		Delta:           m.Delta,
+		QuantityAfter:   m.QuantityAfter,
+		Reason:          m.Reason,
+		Actor:           m.Actor,
+		ReservationUuid: m.ReservationUuid,
+		CreatedAt:       timestamppb.New(m.CreatedAt),
+	}
+}
+
+func StockMovementsToProto(movements []*model.StockMovement) []*inventoryV1.StockMovement {
+	result := make([]*inventoryV1.StockMovement, 0, len(movements))
+	for _, m := range movements {
+		result = append(result, StockMovementToProto(m))
+	}
+
+	return result
+}
+
+// AdjustStockRequestToModel конвертирует запрос ручного движения в модель
+func AdjustStockRequestToModel(req *inventoryV1.AdjustStockRequest) *model.StockMovement {
+	return &model.StockMovement{
+		PartUuid: req.GetPartUuid(),
+		Type:     StockMovementTypeToModel(req.GetType()),
+		Delta:    req.GetDelta(),
+		Reason:   req.GetReason(),
+	}
+}
+
+// StockMovementTypeToProto конвертирует model.StockMovementType в protobuf StockMovementType
+func StockMovementTypeToProto(t model.StockMovementType) inventoryV1.StockMovementType {
+	switch t {
+	case model.StockMovementTypeReceipt:
+		return inventoryV1.StockMovementType_STOCK_MOVEMENT_TYPE_RECEIPT
+	case model.StockMovementTypeReservation:
+		return inventoryV1.StockMovementType_STOCK_MOVEMENT_TYPE_RESERVATION
+	case model.StockMovementTypeSale:
+		return inventoryV1.StockMovementType_STOCK_MOVEMENT_TYPE_SALE
+	case model.StockMovementTypeReturn:
+		return inventoryV1.StockMovementType_STOCK_MOVEMENT_TYPE_RETURN
+	case model.StockMovementTypeCorrection:
+		return inventoryV1.StockMovementType_STOCK_MOVEMENT_TYPE_CORRECTION
+	default:
+		return inventoryV1.StockMovementType_STOCK_MOVEMENT_TYPE_UNSPECIFIED
+	}
+}
+
+// StockMovementTypeToModel конвертирует protobuf StockMovementType в model.StockMovementType
+func StockMovementTypeToModel(t inventoryV1.StockMovementType) model.StockMovementType {
+	switch t {
+	case inventoryV1.StockMovementType_STOCK_MOVEMENT_TYPE_RECEIPT:
+		return model.StockMovementTypeReceipt
+	case inventoryV1.StockMovementType_STOCK_MOVEMENT_TYPE_RESERVATION:
+		return model.StockMovementTypeReservation
+	case inventoryV1.StockMovementType_STOCK_MOVEMENT_TYPE_SALE:
+		return model.StockMovementTypeSale
+	case inventoryV1.StockMovementType_STOCK_MOVEMENT_TYPE_RETURN:
+		return model.StockMovementTypeReturn
+	case inventoryV1.StockMovementType_STOCK_MOVEMENT_TYPE_CORRECTION:
+		return model.StockMovementTypeCorrection
+	default:
+		return ""
+	}
+}
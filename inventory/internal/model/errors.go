@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"strings"
 )
 
 type PartNotFoundError struct {
@@ -11,3 +12,78 @@ type PartNotFoundError struct {
 func (e *PartNotFoundError) Error() string {
 	return fmt.Sprintf("part with UUID %q not found", e.PartUUID)
 }
+
+type InsufficientStockError struct {
+	Shortages []StockShortage
+}
+
+func (e *InsufficientStockError) Error() string {
+	uuids := make([]string, 0, len(e.Shortages))
+	for _, s := range e.Shortages {
+		uuids = append(uuids, s.PartUuid)
+	}
+	return fmt.Sprintf("insufficient stock for parts: %s", strings.Join(uuids, ", "))
+}
+
+type ReservationNotFoundError struct {
+	ReservationUUID string
+}
+
+func (e *ReservationNotFoundError) Error() string {
+	return fmt.Sprintf("reservation with UUID %q not found", e.ReservationUUID)
+}
+
+type ReservationStateError struct {
+	ReservationUUID string
+	Status          ReservationStatus
+}
+
+func (e *ReservationStateError) Error() string {
+	return fmt.Sprintf("reservation %q is %s", e.ReservationUUID, e.Status)
+}
+
+// ReservationConflictError у заказа уже есть активный резерв с другим составом.
+type ReservationConflictError struct {
+	OrderUUID       string
+	ReservationUUID string
+}
+
+func (e *ReservationConflictError) Error() string {
+	return fmt.Sprintf("order %q already has active reservation %q with different items", e.OrderUUID, e.ReservationUUID)
+}
+
+// InvalidPageTokenError токен страницы повреждён или выдан для другой сортировки.
+type InvalidPageTokenError struct {
+	Reason string
+}
+
+func (e *InvalidPageTokenError) Error() string {
+	return fmt.Sprintf("invalid page token: %s", e.Reason)
+}
+
+// ForbiddenError пользователю не разрешено управлять каталогом.
+type ForbiddenError struct {
+	Message string
+}
+
+func (e *ForbiddenError) Error() string {
+	return e.Message
+}
+
+// InvalidPartUpdateError запрос на изменение детали нельзя применить.
+type InvalidPartUpdateError struct {
+	Reason string
+}
+
+func (e *InvalidPartUpdateError) Error() string {
+	return fmt.Sprintf("invalid part update: %s", e.Reason)
+}
+
+// InvalidStockMovementError движение остатка нельзя провести вручную.
+type InvalidStockMovementError struct {
+	Reason string
+}
+
+func (e *InvalidStockMovementError) Error() string {
+	return fmt.Sprintf("invalid stock movement: %s", e.Reason)
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+// PartsOrderField поле сортировки списка деталей
+type PartsOrderField string
+
+const (
+	// PartsOrderFieldUnspecified сортировка только по UUID
+	PartsOrderFieldUnspecified   PartsOrderField = ""
+	PartsOrderFieldPrice         PartsOrderField = "price"
+	PartsOrderFieldName          PartsOrderField = "name"
+	PartsOrderFieldCreatedAt     PartsOrderField = "created_at"
+	PartsOrderFieldStockQuantity PartsOrderField = "stock_quantity"
+)
+
+// PartsOrderBy порядок выдачи деталей. При равных значениях поля детали упорядочиваются по UUID
+// в том же направлении, поэтому порядок всегда однозначен.
+type PartsOrderBy struct {
+	Field PartsOrderField `json:"field"`
+	Desc  bool            `json:"desc"`
+}
+
+// PartsPageRequest параметры страницы из запроса клиента.
+type PartsPageRequest struct {
+	// PageSize размер страницы; 0 — все найденные детали одной страницей
+	PageSize  int
+	PageToken string
+	OrderBy   PartsOrderBy
+}
+
+// PartsPage параметры страницы для хранилища.
+type PartsPage struct {
+	// Limit максимальное число деталей; 0 — без ограничения
+	Limit int
+	// After курсор последней детали предыдущей страницы; nil — с начала выдачи
+	After   *PartsCursor
+	OrderBy PartsOrderBy
+}
+
+// PartsList страница списка деталей.
+type PartsList struct {
+	Parts         []*Part
+	NextPageToken string
+	// TotalCount число деталей под фильтром на всех страницах
+	TotalCount int64
+}
+
+// PartsCursor позиция в выдаче деталей: ключ сортировки последней отданной детали.
+type PartsCursor struct {
+	OrderBy       PartsOrderBy `json:"order_by"`
+	Uuid          string       `json:"uuid"`
+	Name          string       `json:"name,omitempty"`
+	PriceAmount   int64        `json:"price_amount,omitempty"`
+	StockQuantity int64        `json:"stock_quantity,omitempty"`
+	CreatedAt     time.Time    `json:"created_at,omitzero"`
+}
+
+// NewPartsCursor строит курсор, указывающий на следующую после part деталь выдачи.
+func NewPartsCursor(part *Part, orderBy PartsOrderBy) *PartsCursor {
+	cursor := &PartsCursor{OrderBy: orderBy, Uuid: part.Uuid}
+
+	switch orderBy.Field {
+	case PartsOrderFieldPrice:
+		cursor.PriceAmount = part.Price.Amount
+	case PartsOrderFieldName:
+		cursor.Name = part.Name
+	case PartsOrderFieldCreatedAt:
+		cursor.CreatedAt = part.CreatedAt
+	case PartsOrderFieldStockQuantity:
+		cursor.StockQuantity = part.StockQuantity
+	}
+
+	return cursor
+}
+
+// Encode сериализует курсор в непрозрачный для клиента токен страницы.
+func (c *PartsCursor) Encode() string {
+	// Курсор состоит из простых полей и всегда сериализуется
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodePartsCursor разбирает токен, полученный из Encode, и проверяет, что он выдан для той же сортировки.
+func DecodePartsCursor(token string, orderBy PartsOrderBy) (*PartsCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, &InvalidPageTokenError{Reason: "malformed token"}
+	}
+
+	cursor := &PartsCursor{}
+	if err = json.Unmarshal(raw, cursor); err != nil || cursor.Uuid == "" {
+		return nil, &InvalidPageTokenError{Reason: "malformed token"}
+	}
+
+	if cursor.OrderBy != orderBy {
+		return nil, &InvalidPageTokenError{Reason: "token was issued for a different order_by"}
+	}
+
+	return cursor, nil
+}
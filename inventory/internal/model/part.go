@@ -1,18 +1,23 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
 
 type Part struct {
 	Uuid          string            `json:"uuid"`
 	Name          string            `json:"name"`
 	Description   string            `json:"description"`
-	Price         float64           `json:"price"`
+	Price         money.Money       `json:"price"`
 	StockQuantity int64             `json:"stock_quantity"`
 	Category      Category          `json:"category"`
 	Dimensions    *Dimensions       `json:"dimensions"`
 	Manufacturer  *Manufacturer     `json:"manufacturer"`
 	Tags          []string          `json:"tags"`
 	Metadata      map[string]*Value `json:"metadata"`
+	Discontinued  bool              `json:"discontinued"`
 	CreatedAt     time.Time         `json:"created_at"`
 	UpdatedAt     time.Time         `json:"updated_at"`
 }
@@ -46,4 +51,6 @@ type PartsFilter struct {
 	Categories            []Category `json:"categories"`
 	ManufacturerCountries []string   `json:"manufacturer_countries"`
 	Tags                  []string   `json:"tags"`
+	// IncludeDiscontinued вернуть и снятые с продажи детали; по умолчанию они скрыты
+	IncludeDiscontinued bool `json:"include_discontinued"`
 }
@@ -0,0 +1,57 @@
+package model
+
+import "time"
+
+// ReservationStatus состояние резерва деталей
+type ReservationStatus string
+
+const (
+	ReservationStatusActive    ReservationStatus = "ACTIVE"
+	ReservationStatusCommitted ReservationStatus = "COMMITTED"
+	ReservationStatusReleased  ReservationStatus = "RELEASED"
+	ReservationStatusExpired   ReservationStatus = "EXPIRED"
+)
+
+// Reservation резерв остатков под заказ
+type Reservation struct {
+	Uuid      string            `json:"uuid"`
+	OrderUuid string            `json:"order_uuid"`
+	Items     []ReservationItem `json:"items"`
+	Status    ReservationStatus `json:"status"`
+	ExpiresAt time.Time         `json:"expires_at"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// ReservationItem позиция резерва
+type ReservationItem struct {
+	PartUuid string `json:"part_uuid"`
+	Quantity int64  `json:"quantity"`
+}
+
+// StockShortage нехватка остатка по одной детали
+type StockShortage struct {
+	PartUuid  string `json:"part_uuid"`
+	Requested int64  `json:"requested"`
+	Available int64  `json:"available"`
+}
+
+// HasItems сообщает, совпадает ли состав резерва с items без учёта порядка позиций.
+func (r *Reservation) HasItems(items []ReservationItem) bool {
+	if len(r.Items) != len(items) {
+		return false
+	}
+
+	quantities := make(map[string]int64, len(r.Items))
+	for _, item := range r.Items {
+		quantities[item.PartUuid] = item.Quantity
+	}
+
+	for _, item := range items {
+		if quantities[item.PartUuid] != item.Quantity {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Веса полей при полнотекстовом поиске: совпадение в названии важнее совпадения в описании.
+const (
+	SearchWeightName         = 10
+	SearchWeightTags         = 5
+	SearchWeightManufacturer = 3
+	SearchWeightDescription  = 1
+)
+
+// Поля детали, по которым идёт полнотекстовый поиск.
+const (
+	SearchFieldName         = "name"
+	SearchFieldDescription  = "description"
+	SearchFieldTags         = "tags"
+	SearchFieldManufacturer = "manufacturer.name"
+)
+
+// PartSearchHit найденная деталь с релевантностью и подсвеченными совпадениями.
+type PartSearchHit struct {
+	Part       *Part
+	Score      float64
+	Highlights []SearchHighlight
+}
+
+// SearchHighlight фрагмент поля детали, в котором совпавшие слова обёрнуты в <em></em>.
+type SearchHighlight struct {
+	Field   string
+	Snippet string
+}
+
+// SearchTerms разбивает текст на слова в нижнем регистре без повторов.
+// Словом считается последовательность букв и цифр, остальные символы — разделители.
+func SearchTerms(text string) []string {
+	words := SplitWords(text)
+
+	seen := make(map[string]struct{}, len(words))
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		term := strings.ToLower(word)
+		if _, ok := seen[term]; ok {
+			continue
+		}
+		seen[term] = struct{}{}
+		terms = append(terms, term)
+	}
+
+	return terms
+}
+
+// SplitWords возвращает слова текста в исходном регистре.
+func SplitWords(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool { return !IsWordRune(r) })
+}
+
+// IsWordRune сообщает, может ли символ входить в слово.
+func IsWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
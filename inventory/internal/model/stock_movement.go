@@ -0,0 +1,101 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+// StockMovementType причина изменения остатка детали
+type StockMovementType string
+
+const (
+	// StockMovementTypeReceipt поступление на склад
+	StockMovementTypeReceipt StockMovementType = "RECEIPT"
+	// StockMovementTypeReservation списание под резерв заказа или возврат снятого резерва
+	StockMovementTypeReservation StockMovementType = "RESERVATION"
+	// StockMovementTypeSale продажа зарезервированных деталей; остаток уже списан резервом
+	StockMovementTypeSale StockMovementType = "SALE"
+	// StockMovementTypeReturn возврат деталей покупателем
+	StockMovementTypeReturn StockMovementType = "RETURN"
+	// StockMovementTypeCorrection ручная корректировка по итогам инвентаризации
+	StockMovementTypeCorrection StockMovementType = "CORRECTION"
+)
+
+// StockActorSystem автор движений, которые сервис делает сам: резервы, продажи, откаты.
+const StockActorSystem = "system"
+
+// DefaultStockMovementsPageSize размер страницы журнала, если клиент его не задал.
+const DefaultStockMovementsPageSize = 100
+
+// StockMovement запись журнала движений остатка. Журнал только дополняется.
+type StockMovement struct {
+	Uuid     string `json:"uuid"`
+	PartUuid string `json:"part_uuid"`
+	// Sequence порядковый номер движения в истории детали, начиная с 1
+	Sequence int64             `json:"sequence"`
+	Type     StockMovementType `json:"type"`
+	// Delta изменение остатка; 0 у продаж, остаток которых списан резервом
+	Delta int64 `json:"delta"`
+	// QuantityAfter остаток детали сразу после движения
+	QuantityAfter int64  `json:"quantity_after"`
+	Reason        string `json:"reason"`
+	// Actor UUID пользователя или StockActorSystem
+	Actor string `json:"actor"`
+	// ReservationUuid резерв, по которому сделано движение; пусто у ручных движений
+	ReservationUuid string    `json:"reservation_uuid"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// StockMovementsPageRequest параметры страницы журнала из запроса клиента.
+type StockMovementsPageRequest struct {
+	// PageSize размер страницы; 0 — DefaultStockMovementsPageSize
+	PageSize  int
+	PageToken string
+}
+
+// StockMovementsPage параметры страницы журнала для хранилища.
+type StockMovementsPage struct {
+	// Limit максимальное число движений; 0 — без ограничения
+	Limit int
+	// BeforeSequence отдаются движения с меньшим номером; 0 — с последнего движения
+	BeforeSequence int64
+}
+
+// StockMovementsList страница журнала движений от новых к старым.
+type StockMovementsList struct {
+	Movements     []*StockMovement
+	NextPageToken string
+}
+
+// StockMovementsCursor позиция в журнале детали: номер последнего отданного движения.
+type StockMovementsCursor struct {
+	PartUuid string `json:"part_uuid"`
+	Sequence int64  `json:"sequence"`
+}
+
+// Encode сериализует курсор в непрозрачный для клиента токен страницы.
+func (c *StockMovementsCursor) Encode() string {
+	// Курсор состоит из простых полей и всегда сериализуется
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeStockMovementsCursor разбирает токен, полученный из Encode, и проверяет, что он выдан для той же детали.
+func DecodeStockMovementsCursor(token, partUUID string) (*StockMovementsCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, &InvalidPageTokenError{Reason: "malformed token"}
+	}
+
+	cursor := &StockMovementsCursor{}
+	if err = json.Unmarshal(raw, cursor); err != nil || cursor.Sequence <= 0 {
+		return nil, &InvalidPageTokenError{Reason: "malformed token"}
+	}
+
+	if cursor.PartUuid != partUUID {
+		return nil, &InvalidPageTokenError{Reason: "token was issued for a different part"}
+	}
+
+	return cursor, nil
+}
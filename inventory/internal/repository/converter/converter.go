@@ -3,6 +3,7 @@ package converter
 import (
 	"github.com/ZanDattSu/star-factory/inventory/internal/model"
 	repoModel "github.com/ZanDattSu/star-factory/inventory/internal/repository/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 )
 
 // === Part ===
@@ -16,13 +17,14 @@ func PartToRepoModel(p *model.Part) *repoModel.Part {
 		Uuid:          p.Uuid,
 		Name:          p.Name,
 		Description:   p.Description,
-		Price:         p.Price,
+		Price:         repoModel.Money(p.Price),
 		StockQuantity: p.StockQuantity,
 		Category:      repoModel.Category(p.Category),
 		Dimensions:    DimensionsToRepoModel(p.Dimensions),
 		Manufacturer:  ManufacturerToRepoModel(p.Manufacturer),
 		Tags:          p.Tags,
 		Metadata:      MetadataToRepoModel(p.Metadata),
+		Discontinued:  p.Discontinued,
 		CreatedAt:     p.CreatedAt,
 		UpdatedAt:     p.UpdatedAt,
 	}
@@ -37,13 +39,14 @@ func PartToModel(p *repoModel.Part) *model.Part {
 		Uuid:          p.Uuid,
 		Name:          p.Name,
 		Description:   p.Description,
-		Price:         p.Price,
+		Price:         money.Money(p.Price),
 		StockQuantity: p.StockQuantity,
 		Category:      model.Category(p.Category),
 		Dimensions:    DimensionsToModel(p.Dimensions),
 		Manufacturer:  ManufacturerToModel(p.Manufacturer),
 		Tags:          p.Tags,
 		Metadata:      MetadataToModel(p.Metadata),
+		Discontinued:  p.Discontinued,
 		CreatedAt:     p.CreatedAt,
 		UpdatedAt:     p.UpdatedAt,
 	}
@@ -130,6 +133,7 @@ func PartsFilterToRepoModel(f model.PartsFilter) repoModel.PartsFilter {
 		Categories:            CategoriesToRepo(f.Categories),
 		ManufacturerCountries: f.ManufacturerCountries,
 		Tags:                  f.Tags,
+		IncludeDiscontinued:   f.IncludeDiscontinued,
 	}
 }
 
@@ -140,6 +144,7 @@ func PartsFilterToModel(f repoModel.PartsFilter) model.PartsFilter {
 		Categories:            CategoriesFromRepo(f.Categories),
 		ManufacturerCountries: f.ManufacturerCountries,
 		Tags:                  f.Tags,
+		IncludeDiscontinued:   f.IncludeDiscontinued,
 	}
 }
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ZanDattSu/star-factory/inventory/internal/model"
 	"github.com/ZanDattSu/star-factory/inventory/internal/repository/converter"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 )
 
 func TestPartConvertersAllCases(t *testing.T) {
@@ -17,7 +18,7 @@ func TestPartConvertersAllCases(t *testing.T) {
 		Uuid:          "uuid-1",
 		Name:          "Engine Core",
 		Description:   "Main propulsion system",
-		Price:         9999.99,
+		Price:         money.New(999999, money.DefaultCurrency),
 		StockQuantity: 3,
 		Category:      model.CategoryEngine,
 		Dimensions: &model.Dimensions{
@@ -0,0 +1,56 @@
+package converter
+
+import (
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	repoModel "github.com/ZanDattSu/star-factory/inventory/internal/repository/model"
+)
+
+// === Reservation ===
+
+func ReservationToRepoModel(r *model.Reservation) *repoModel.Reservation {
+	if r == nil {
+		return nil
+	}
+
+	items := make([]repoModel.ReservationItem, 0, len(r.Items))
+	for _, item := range r.Items {
+		items = append(items, repoModel.ReservationItem{
+			PartUuid: item.PartUuid,
+			Quantity: item.Quantity,
+		})
+	}
+
+	return &repoModel.Reservation{
+		Uuid:      r.Uuid,
+		OrderUuid: r.OrderUuid,
+		Items:     items,
+		Status:    string(r.Status),
+		ExpiresAt: r.ExpiresAt,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func ReservationToModel(r *repoModel.Reservation) *model.Reservation {
+	if r == nil {
+		return nil
+	}
+
+	items := make([]model.ReservationItem, 0, len(r.Items))
+	for _, item := range r.Items {
+		items = append(items, model.ReservationItem{
+			PartUuid: item.PartUuid,
+			Quantity: item.Quantity,
+		})
+	}
+
+	return &model.Reservation{
+		Uuid:      r.Uuid,
+		OrderUuid: r.OrderUuid,
+		Items:     items,
+		Status:    model.ReservationStatus(r.Status),
+		ExpiresAt: r.ExpiresAt,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
@@ -0,0 +1,46 @@
+package converter
+
+import (
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	repoModel "github.com/ZanDattSu/star-factory/inventory/internal/repository/model"
+)
+
+// === StockMovement ===
+
+func StockMovementToRepoModel(m *model.StockMovement) *repoModel.StockMovement {
+	if m == nil {
+		return nil
+	}
+
+	return &repoModel.StockMovement{
+		Uuid:            m.Uuid,
+		PartUuid:        m.PartUuid,
+		Sequence:        m.Sequence,
+		Type:            string(m.Type),
+		Delta:           m.Delta,
+		QuantityAfter:   m.QuantityAfter,
+		Reason:          m.Reason,
+		Actor:           m.Actor,
+		ReservationUuid: m.ReservationUuid,
+		CreatedAt:       m.CreatedAt,
+	}
+}
+
+func StockMovementToModel(m *repoModel.StockMovement) *model.StockMovement {
+	if m == nil {
+		return nil
+	}
+
+	return &model.StockMovement{
+		Uuid:            m.Uuid,
+		PartUuid:        m.PartUuid,
+		Sequence:        m.Sequence,
+		Type:            model.StockMovementType(m.Type),
+		Delta:           m.Delta,
+		QuantityAfter:   m.QuantityAfter,
+		Reason:          m.Reason,
+		Actor:           m.Actor,
+		ReservationUuid: m.ReservationUuid,
+		CreatedAt:       m.CreatedAt,
+	}
+}
@@ -22,6 +22,63 @@ func (_m *PartRepository) EXPECT() *PartRepository_Expecter {
 	return &PartRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountParts provides a mock function with given fields: ctx, filter
+func (_m *PartRepository) CountParts(ctx context.Context, filter *model.PartsFilter) (int64, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountParts")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter) (int64, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter) int64); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PartsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartRepository_CountParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountParts'
+type PartRepository_CountParts_Call struct {
+	*mock.Call
+}
+
+// CountParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *model.PartsFilter
+func (_e *PartRepository_Expecter) CountParts(ctx interface{}, filter interface{}) *PartRepository_CountParts_Call {
+	return &PartRepository_CountParts_Call{Call: _e.mock.On("CountParts", ctx, filter)}
+}
+
+func (_c *PartRepository_CountParts_Call) Run(run func(ctx context.Context, filter *model.PartsFilter)) *PartRepository_CountParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PartsFilter))
+	})
+	return _c
+}
+
+func (_c *PartRepository_CountParts_Call) Return(_a0 int64, _a1 error) *PartRepository_CountParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartRepository_CountParts_Call) RunAndReturn(run func(context.Context, *model.PartsFilter) (int64, error)) *PartRepository_CountParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPart provides a mock function with given fields: ctx, uuid
 func (_m *PartRepository) GetPart(ctx context.Context, uuid string) (*model.Part, error) {
 	ret := _m.Called(ctx, uuid)
@@ -81,9 +138,9 @@ func (_c *PartRepository_GetPart_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
-// ListParts provides a mock function with given fields: ctx
-func (_m *PartRepository) ListParts(ctx context.Context) ([]*model.Part, error) {
-	ret := _m.Called(ctx)
+// ListParts provides a mock function with given fields: ctx, filter, page
+func (_m *PartRepository) ListParts(ctx context.Context, filter *model.PartsFilter, page model.PartsPage) ([]*model.Part, error) {
+	ret := _m.Called(ctx, filter, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListParts")
@@ -91,19 +148,19 @@ func (_m *PartRepository) ListParts(ctx context.Context) ([]*model.Part, error)
 
 	var r0 []*model.Part
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.Part, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter, model.PartsPage) ([]*model.Part, error)); ok {
+		return rf(ctx, filter, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*model.Part); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter, model.PartsPage) []*model.Part); ok {
+		r0 = rf(ctx, filter, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Part)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PartsFilter, model.PartsPage) error); ok {
+		r1 = rf(ctx, filter, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -118,13 +175,15 @@ type PartRepository_ListParts_Call struct {
 
 // ListParts is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *PartRepository_Expecter) ListParts(ctx interface{}) *PartRepository_ListParts_Call {
-	return &PartRepository_ListParts_Call{Call: _e.mock.On("ListParts", ctx)}
+//   - filter *model.PartsFilter
+//   - page model.PartsPage
+func (_e *PartRepository_Expecter) ListParts(ctx interface{}, filter interface{}, page interface{}) *PartRepository_ListParts_Call {
+	return &PartRepository_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter, page)}
 }
 
-func (_c *PartRepository_ListParts_Call) Run(run func(ctx context.Context)) *PartRepository_ListParts_Call {
+func (_c *PartRepository_ListParts_Call) Run(run func(ctx context.Context, filter *model.PartsFilter, page model.PartsPage)) *PartRepository_ListParts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*model.PartsFilter), args[2].(model.PartsPage))
 	})
 	return _c
 }
@@ -134,7 +193,7 @@ func (_c *PartRepository_ListParts_Call) Return(_a0 []*model.Part, _a1 error) *P
 	return _c
 }
 
-func (_c *PartRepository_ListParts_Call) RunAndReturn(run func(context.Context) ([]*model.Part, error)) *PartRepository_ListParts_Call {
+func (_c *PartRepository_ListParts_Call) RunAndReturn(run func(context.Context, *model.PartsFilter, model.PartsPage) ([]*model.Part, error)) *PartRepository_ListParts_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -187,6 +246,175 @@ func (_c *PartRepository_PutPart_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
+// SearchParts provides a mock function with given fields: ctx, query, filter, limit
+func (_m *PartRepository) SearchParts(ctx context.Context, query string, filter *model.PartsFilter, limit int) ([]*model.PartSearchHit, error) {
+	ret := _m.Called(ctx, query, filter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchParts")
+	}
+
+	var r0 []*model.PartSearchHit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.PartsFilter, int) ([]*model.PartSearchHit, error)); ok {
+		return rf(ctx, query, filter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.PartsFilter, int) []*model.PartSearchHit); ok {
+		r0 = rf(ctx, query, filter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PartSearchHit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *model.PartsFilter, int) error); ok {
+		r1 = rf(ctx, query, filter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartRepository_SearchParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchParts'
+type PartRepository_SearchParts_Call struct {
+	*mock.Call
+}
+
+// SearchParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - filter *model.PartsFilter
+//   - limit int
+func (_e *PartRepository_Expecter) SearchParts(ctx interface{}, query interface{}, filter interface{}, limit interface{}) *PartRepository_SearchParts_Call {
+	return &PartRepository_SearchParts_Call{Call: _e.mock.On("SearchParts", ctx, query, filter, limit)}
+}
+
+func (_c *PartRepository_SearchParts_Call) Run(run func(ctx context.Context, query string, filter *model.PartsFilter, limit int)) *PartRepository_SearchParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*model.PartsFilter), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *PartRepository_SearchParts_Call) Return(_a0 []*model.PartSearchHit, _a1 error) *PartRepository_SearchParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartRepository_SearchParts_Call) RunAndReturn(run func(context.Context, string, *model.PartsFilter, int) ([]*model.PartSearchHit, error)) *PartRepository_SearchParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePartFields provides a mock function with given fields: ctx, uuid, part, fields
+func (_m *PartRepository) UpdatePartFields(ctx context.Context, uuid string, part *model.Part, fields []string) (*model.Part, error) {
+	ret := _m.Called(ctx, uuid, part, fields)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePartFields")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.Part, []string) (*model.Part, error)); ok {
+		return rf(ctx, uuid, part, fields)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.Part, []string) *model.Part); ok {
+		r0 = rf(ctx, uuid, part, fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *model.Part, []string) error); ok {
+		r1 = rf(ctx, uuid, part, fields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartRepository_UpdatePartFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePartFields'
+type PartRepository_UpdatePartFields_Call struct {
+	*mock.Call
+}
+
+// UpdatePartFields is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+//   - part *model.Part
+//   - fields []string
+func (_e *PartRepository_Expecter) UpdatePartFields(ctx interface{}, uuid interface{}, part interface{}, fields interface{}) *PartRepository_UpdatePartFields_Call {
+	return &PartRepository_UpdatePartFields_Call{Call: _e.mock.On("UpdatePartFields", ctx, uuid, part, fields)}
+}
+
+func (_c *PartRepository_UpdatePartFields_Call) Run(run func(ctx context.Context, uuid string, part *model.Part, fields []string)) *PartRepository_UpdatePartFields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*model.Part), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *PartRepository_UpdatePartFields_Call) Return(_a0 *model.Part, _a1 error) *PartRepository_UpdatePartFields_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartRepository_UpdatePartFields_Call) RunAndReturn(run func(context.Context, string, *model.Part, []string) (*model.Part, error)) *PartRepository_UpdatePartFields_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertParts provides a mock function with given fields: ctx, parts
+func (_m *PartRepository) UpsertParts(ctx context.Context, parts []*model.Part) error {
+	ret := _m.Called(ctx, parts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertParts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.Part) error); ok {
+		r0 = rf(ctx, parts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartRepository_UpsertParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertParts'
+type PartRepository_UpsertParts_Call struct {
+	*mock.Call
+}
+
+// UpsertParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parts []*model.Part
+func (_e *PartRepository_Expecter) UpsertParts(ctx interface{}, parts interface{}) *PartRepository_UpsertParts_Call {
+	return &PartRepository_UpsertParts_Call{Call: _e.mock.On("UpsertParts", ctx, parts)}
+}
+
+func (_c *PartRepository_UpsertParts_Call) Run(run func(ctx context.Context, parts []*model.Part)) *PartRepository_UpsertParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*model.Part))
+	})
+	return _c
+}
+
+func (_c *PartRepository_UpsertParts_Call) Return(_a0 error) *PartRepository_UpsertParts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartRepository_UpsertParts_Call) RunAndReturn(run func(context.Context, []*model.Part) error) *PartRepository_UpsertParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPartRepository creates a new instance of PartRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPartRepository(t interface {
@@ -0,0 +1,277 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/ZanDattSu/star-factory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ReservationRepository is an autogenerated mock type for the ReservationRepository type
+type ReservationRepository struct {
+	mock.Mock
+}
+
+type ReservationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReservationRepository) EXPECT() *ReservationRepository_Expecter {
+	return &ReservationRepository_Expecter{mock: &_m.Mock}
+}
+
+// CommitReservation provides a mock function with given fields: ctx, uuid
+func (_m *ReservationRepository) CommitReservation(ctx context.Context, uuid string) (*model.Reservation, error) {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 *model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Reservation, error)); ok {
+		return rf(ctx, uuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Reservation); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, uuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReservationRepository_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type ReservationRepository_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *ReservationRepository_Expecter) CommitReservation(ctx interface{}, uuid interface{}) *ReservationRepository_CommitReservation_Call {
+	return &ReservationRepository_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, uuid)}
+}
+
+func (_c *ReservationRepository_CommitReservation_Call) Run(run func(ctx context.Context, uuid string)) *ReservationRepository_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ReservationRepository_CommitReservation_Call) Return(_a0 *model.Reservation, _a1 error) *ReservationRepository_CommitReservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReservationRepository_CommitReservation_Call) RunAndReturn(run func(context.Context, string) (*model.Reservation, error)) *ReservationRepository_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReservation provides a mock function with given fields: ctx, reservation
+func (_m *ReservationRepository) CreateReservation(ctx context.Context, reservation *model.Reservation) (*model.Reservation, error) {
+	ret := _m.Called(ctx, reservation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReservation")
+	}
+
+	var r0 *model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Reservation) (*model.Reservation, error)); ok {
+		return rf(ctx, reservation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Reservation) *model.Reservation); ok {
+		r0 = rf(ctx, reservation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Reservation) error); ok {
+		r1 = rf(ctx, reservation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReservationRepository_CreateReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReservation'
+type ReservationRepository_CreateReservation_Call struct {
+	*mock.Call
+}
+
+// CreateReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservation *model.Reservation
+func (_e *ReservationRepository_Expecter) CreateReservation(ctx interface{}, reservation interface{}) *ReservationRepository_CreateReservation_Call {
+	return &ReservationRepository_CreateReservation_Call{Call: _e.mock.On("CreateReservation", ctx, reservation)}
+}
+
+func (_c *ReservationRepository_CreateReservation_Call) Run(run func(ctx context.Context, reservation *model.Reservation)) *ReservationRepository_CreateReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Reservation))
+	})
+	return _c
+}
+
+func (_c *ReservationRepository_CreateReservation_Call) Return(_a0 *model.Reservation, _a1 error) *ReservationRepository_CreateReservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReservationRepository_CreateReservation_Call) RunAndReturn(run func(context.Context, *model.Reservation) (*model.Reservation, error)) *ReservationRepository_CreateReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExpiredReservations provides a mock function with given fields: ctx, now, limit
+func (_m *ReservationRepository) ListExpiredReservations(ctx context.Context, now time.Time, limit int) ([]*model.Reservation, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExpiredReservations")
+	}
+
+	var r0 []*model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*model.Reservation, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*model.Reservation); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReservationRepository_ListExpiredReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExpiredReservations'
+type ReservationRepository_ListExpiredReservations_Call struct {
+	*mock.Call
+}
+
+// ListExpiredReservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *ReservationRepository_Expecter) ListExpiredReservations(ctx interface{}, now interface{}, limit interface{}) *ReservationRepository_ListExpiredReservations_Call {
+	return &ReservationRepository_ListExpiredReservations_Call{Call: _e.mock.On("ListExpiredReservations", ctx, now, limit)}
+}
+
+func (_c *ReservationRepository_ListExpiredReservations_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *ReservationRepository_ListExpiredReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ReservationRepository_ListExpiredReservations_Call) Return(_a0 []*model.Reservation, _a1 error) *ReservationRepository_ListExpiredReservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReservationRepository_ListExpiredReservations_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*model.Reservation, error)) *ReservationRepository_ListExpiredReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReservation provides a mock function with given fields: ctx, uuid, status
+func (_m *ReservationRepository) ReleaseReservation(ctx context.Context, uuid string, status model.ReservationStatus) (*model.Reservation, error) {
+	ret := _m.Called(ctx, uuid, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 *model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ReservationStatus) (*model.Reservation, error)); ok {
+		return rf(ctx, uuid, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ReservationStatus) *model.Reservation); ok {
+		r0 = rf(ctx, uuid, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.ReservationStatus) error); ok {
+		r1 = rf(ctx, uuid, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReservationRepository_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type ReservationRepository_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+//   - status model.ReservationStatus
+func (_e *ReservationRepository_Expecter) ReleaseReservation(ctx interface{}, uuid interface{}, status interface{}) *ReservationRepository_ReleaseReservation_Call {
+	return &ReservationRepository_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, uuid, status)}
+}
+
+func (_c *ReservationRepository_ReleaseReservation_Call) Run(run func(ctx context.Context, uuid string, status model.ReservationStatus)) *ReservationRepository_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.ReservationStatus))
+	})
+	return _c
+}
+
+func (_c *ReservationRepository_ReleaseReservation_Call) Return(_a0 *model.Reservation, _a1 error) *ReservationRepository_ReleaseReservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReservationRepository_ReleaseReservation_Call) RunAndReturn(run func(context.Context, string, model.ReservationStatus) (*model.Reservation, error)) *ReservationRepository_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReservationRepository creates a new instance of ReservationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReservationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReservationRepository {
+	mock := &ReservationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,156 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/ZanDattSu/star-factory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StockRepository is an autogenerated mock type for the StockRepository type
+type StockRepository struct {
+	mock.Mock
+}
+
+type StockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StockRepository) EXPECT() *StockRepository_Expecter {
+	return &StockRepository_Expecter{mock: &_m.Mock}
+}
+
+// AdjustStock provides a mock function with given fields: ctx, movement
+func (_m *StockRepository) AdjustStock(ctx context.Context, movement *model.StockMovement) (*model.StockMovement, error) {
+	ret := _m.Called(ctx, movement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustStock")
+	}
+
+	var r0 *model.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockMovement) (*model.StockMovement, error)); ok {
+		return rf(ctx, movement)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockMovement) *model.StockMovement); ok {
+		r0 = rf(ctx, movement)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.StockMovement) error); ok {
+		r1 = rf(ctx, movement)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockRepository_AdjustStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustStock'
+type StockRepository_AdjustStock_Call struct {
+	*mock.Call
+}
+
+// AdjustStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - movement *model.StockMovement
+func (_e *StockRepository_Expecter) AdjustStock(ctx interface{}, movement interface{}) *StockRepository_AdjustStock_Call {
+	return &StockRepository_AdjustStock_Call{Call: _e.mock.On("AdjustStock", ctx, movement)}
+}
+
+func (_c *StockRepository_AdjustStock_Call) Run(run func(ctx context.Context, movement *model.StockMovement)) *StockRepository_AdjustStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.StockMovement))
+	})
+	return _c
+}
+
+func (_c *StockRepository_AdjustStock_Call) Return(_a0 *model.StockMovement, _a1 error) *StockRepository_AdjustStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockRepository_AdjustStock_Call) RunAndReturn(run func(context.Context, *model.StockMovement) (*model.StockMovement, error)) *StockRepository_AdjustStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStockMovements provides a mock function with given fields: ctx, partUUID, page
+func (_m *StockRepository) ListStockMovements(ctx context.Context, partUUID string, page model.StockMovementsPage) ([]*model.StockMovement, error) {
+	ret := _m.Called(ctx, partUUID, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStockMovements")
+	}
+
+	var r0 []*model.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.StockMovementsPage) ([]*model.StockMovement, error)); ok {
+		return rf(ctx, partUUID, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.StockMovementsPage) []*model.StockMovement); ok {
+		r0 = rf(ctx, partUUID, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.StockMovementsPage) error); ok {
+		r1 = rf(ctx, partUUID, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockRepository_ListStockMovements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStockMovements'
+type StockRepository_ListStockMovements_Call struct {
+	*mock.Call
+}
+
+// ListStockMovements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUUID string
+//   - page model.StockMovementsPage
+func (_e *StockRepository_Expecter) ListStockMovements(ctx interface{}, partUUID interface{}, page interface{}) *StockRepository_ListStockMovements_Call {
+	return &StockRepository_ListStockMovements_Call{Call: _e.mock.On("ListStockMovements", ctx, partUUID, page)}
+}
+
+func (_c *StockRepository_ListStockMovements_Call) Run(run func(ctx context.Context, partUUID string, page model.StockMovementsPage)) *StockRepository_ListStockMovements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.StockMovementsPage))
+	})
+	return _c
+}
+
+func (_c *StockRepository_ListStockMovements_Call) Return(_a0 []*model.StockMovement, _a1 error) *StockRepository_ListStockMovements_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockRepository_ListStockMovements_Call) RunAndReturn(run func(context.Context, string, model.StockMovementsPage) ([]*model.StockMovement, error)) *StockRepository_ListStockMovements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStockRepository creates a new instance of StockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StockRepository {
+	mock := &StockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -11,17 +11,24 @@ type Part struct {
 	Uuid          string            `json:"uuid" bson:"uuid"`
 	Name          string            `json:"name" bson:"name"`
 	Description   string            `json:"description" bson:"description"`
-	Price         float64           `json:"price" bson:"price"`
+	Price         Money             `json:"price" bson:"price"`
 	StockQuantity int64             `json:"stock_quantity" bson:"stock_quantity"`
 	Category      Category          `json:"category" bson:"category"`
 	Dimensions    *Dimensions       `json:"dimensions" bson:"dimensions, omitempty"`
 	Manufacturer  *Manufacturer     `json:"manufacturer" bson:"manufacturer, omitempty"`
 	Tags          []string          `json:"tags" bson:"tags"`
 	Metadata      map[string]*Value `json:"metadata" bson:"metadata, omitempty"`
+	Discontinued  bool              `json:"discontinued" bson:"discontinued"`
 	CreatedAt     time.Time         `json:"created_at" bson:"created_at"`
 	UpdatedAt     time.Time         `json:"updated_at" bson:"updated_at"`
 }
 
+// Money - цена в минимальных единицах валюты
+type Money struct {
+	Amount   int64  `json:"amount" bson:"amount"`
+	Currency string `json:"currency" bson:"currency"`
+}
+
 type Dimensions struct {
 	Length float64 `json:"length" bson:"length"`
 	Width  float64 `json:"width" bson:"width"`
@@ -51,4 +58,5 @@ type PartsFilter struct {
 	Categories            []Category `json:"categories" bson:"categories"`
 	ManufacturerCountries []string   `json:"manufacturer_countries" bson:"manufacturer_countries"`
 	Tags                  []string   `json:"tags" bson:"tags"`
+	IncludeDiscontinued   bool       `json:"include_discontinued" bson:"include_discontinued"`
 }
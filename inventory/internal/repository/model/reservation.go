@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// Reservation - модель резерва деталей в MongoDB
+type Reservation struct {
+	Uuid      string            `json:"uuid" bson:"uuid"`
+	OrderUuid string            `json:"order_uuid" bson:"order_uuid"`
+	Items     []ReservationItem `json:"items" bson:"items"`
+	Status    string            `json:"status" bson:"status"`
+	ExpiresAt time.Time         `json:"expires_at" bson:"expires_at"`
+	CreatedAt time.Time         `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at" bson:"updated_at"`
+}
+
+type ReservationItem struct {
+	PartUuid string `json:"part_uuid" bson:"part_uuid"`
+	Quantity int64  `json:"quantity" bson:"quantity"`
+}
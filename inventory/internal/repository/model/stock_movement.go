@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// StockMovement - модель записи журнала движений остатка в MongoDB
+type StockMovement struct {
+	Uuid            string    `json:"uuid" bson:"uuid"`
+	PartUuid        string    `json:"part_uuid" bson:"part_uuid"`
+	Sequence        int64     `json:"sequence" bson:"sequence"`
+	Type            string    `json:"type" bson:"type"`
+	Delta           int64     `json:"delta" bson:"delta"`
+	QuantityAfter   int64     `json:"quantity_after" bson:"quantity_after"`
+	Reason          string    `json:"reason" bson:"reason"`
+	Actor           string    `json:"actor" bson:"actor"`
+	ReservationUuid string    `json:"reservation_uuid" bson:"reservation_uuid,omitempty"`
+	CreatedAt       time.Time `json:"created_at" bson:"created_at"`
+}
@@ -0,0 +1,99 @@
+package inmemory
+
+import (
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+)
+
+// partsMatcher проверяет детали на соответствие фильтру.
+// Логика: AND между полями фильтра, OR внутри каждого поля.
+//
+// Значения фильтра разложены по set'ам на основе map, поэтому проверка детали
+// стоит O(1) на поле, а общая сложность — O(n + m),
+// где n — количество деталей, m — количество элементов фильтра.
+type partsMatcher struct {
+	uuids      map[string]struct{}
+	names      map[string]struct{}
+	categories map[model.Category]struct{}
+	countries  map[string]struct{}
+	tags       map[string]struct{}
+
+	includeDiscontinued bool
+}
+
+func newPartsMatcher(filter *model.PartsFilter) partsMatcher {
+	if filter == nil {
+		return partsMatcher{}
+	}
+
+	return partsMatcher{
+		uuids:      toSet(filter.Uuids),
+		names:      toSet(filter.Names),
+		categories: toSet(filter.Categories),
+		countries:  toSet(filter.ManufacturerCountries),
+		tags:       toSet(filter.Tags),
+
+		includeDiscontinued: filter.IncludeDiscontinued,
+	}
+}
+
+// matches проверяет, соответствует ли деталь всем критериям фильтра.
+// Пустое поле фильтра не ограничивает выборку; снятые с продажи детали отбрасываются, если фильтр не просит их вернуть.
+func (m partsMatcher) matches(part *model.Part) bool {
+	if part.Discontinued && !m.includeDiscontinued {
+		return false
+	}
+
+	if len(m.uuids) > 0 {
+		if _, found := m.uuids[part.Uuid]; !found {
+			return false
+		}
+	}
+
+	if len(m.names) > 0 {
+		if _, found := m.names[part.Name]; !found {
+			return false
+		}
+	}
+
+	if len(m.categories) > 0 {
+		if _, found := m.categories[part.Category]; !found {
+			return false
+		}
+	}
+
+	if len(m.countries) > 0 {
+		if part.Manufacturer == nil {
+			return false
+		}
+		if _, found := m.countries[part.Manufacturer.Country]; !found {
+			return false
+		}
+	}
+
+	if len(m.tags) > 0 {
+		if !hasAnyTag(m.tags, part.Tags) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasAnyTag проверяет наличие хотя бы одного тега из set'а.
+func hasAnyTag(tagSet map[string]struct{}, partTags []string) bool {
+	for _, tag := range partTags {
+		if _, exists := tagSet[tag]; exists {
+			return true
+		}
+	}
+	return false
+}
+
+// toSet преобразует slice в set для O(1) поиска.
+func toSet[T comparable](values []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return set
+}
@@ -2,19 +2,57 @@ package inmemory
 
 import (
 	"context"
+	"slices"
 
 	"github.com/ZanDattSu/star-factory/inventory/internal/model"
 	"github.com/ZanDattSu/star-factory/inventory/internal/repository/converter"
-	repoModel "github.com/ZanDattSu/star-factory/inventory/internal/repository/model"
 )
 
-func (r *repository) ListParts(_ context.Context) ([]*model.Part, error) {
+func (r *repository) ListParts(_ context.Context, filter *model.PartsFilter, page model.PartsPage) ([]*model.Part, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	parts := make([]*repoModel.Part, 0, len(r.parts))
-	for _, part := range r.parts {
-		parts = append(parts, part)
+	parts := r.matchingParts(filter)
+
+	slices.SortFunc(parts, func(a, b *model.Part) int {
+		return compareParts(a, b, page.OrderBy)
+	})
+
+	if page.After != nil {
+		after := cursorPart(page.After)
+		start := slices.IndexFunc(parts, func(part *model.Part) bool {
+			return compareParts(part, after, page.OrderBy) > 0
+		})
+		if start < 0 {
+			start = len(parts)
+		}
+		parts = parts[start:]
+	}
+
+	if page.Limit > 0 && len(parts) > page.Limit {
+		parts = parts[:page.Limit]
+	}
+
+	return parts, nil
+}
+
+func (r *repository) CountParts(_ context.Context, filter *model.PartsFilter) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return int64(len(r.matchingParts(filter))), nil
+}
+
+// matchingParts возвращает детали, подходящие под фильтр. Вызывается под блокировкой.
+func (r *repository) matchingParts(filter *model.PartsFilter) []*model.Part {
+	matcher := newPartsMatcher(filter)
+
+	parts := make([]*model.Part, 0, len(r.parts))
+	for _, repoPart := range r.parts {
+		part := converter.PartToModel(repoPart)
+		if matcher.matches(part) {
+			parts = append(parts, part)
+		}
 	}
-	return converter.PartsToModel(parts), nil
+	return parts
 }
@@ -0,0 +1,45 @@
+package inmemory
+
+import (
+	"cmp"
+	"strings"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+// compareParts сравнивает детали по полю сортировки, при равенстве — по UUID.
+// Для сортировки по убыванию результат инвертируется целиком, как и в MongoDB.
+func compareParts(a, b *model.Part, orderBy model.PartsOrderBy) int {
+	var c int
+	switch orderBy.Field {
+	case model.PartsOrderFieldPrice:
+		c = cmp.Compare(a.Price.Amount, b.Price.Amount)
+	case model.PartsOrderFieldName:
+		c = strings.Compare(a.Name, b.Name)
+	case model.PartsOrderFieldCreatedAt:
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	case model.PartsOrderFieldStockQuantity:
+		c = cmp.Compare(a.StockQuantity, b.StockQuantity)
+	}
+
+	if c == 0 {
+		c = strings.Compare(a.Uuid, b.Uuid)
+	}
+
+	if orderBy.Desc {
+		return -c
+	}
+	return c
+}
+
+// cursorPart восстанавливает из курсора ключ сортировки последней отданной детали.
+func cursorPart(cursor *model.PartsCursor) *model.Part {
+	return &model.Part{
+		Uuid:          cursor.Uuid,
+		Name:          cursor.Name,
+		Price:         money.Money{Amount: cursor.PriceAmount},
+		StockQuantity: cursor.StockQuantity,
+		CreatedAt:     cursor.CreatedAt,
+	}
+}
@@ -0,0 +1,53 @@
+package inmemory
+
+import (
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+func (s *SuiteRepository) TestListPartsPagesInOrder() {
+	parts := []*model.Part{
+		{Uuid: "uuid-1", Name: "Engine", Category: model.CategoryEngine, Price: money.New(300, money.DefaultCurrency)},
+		{Uuid: "uuid-2", Name: "Wing", Category: model.CategoryWing, Price: money.New(100, money.DefaultCurrency)},
+		{Uuid: "uuid-3", Name: "Fuel", Category: model.CategoryFuel, Price: money.New(300, money.DefaultCurrency)},
+		{Uuid: "uuid-4", Name: "Window", Category: model.CategoryPorthole, Price: money.New(200, money.DefaultCurrency)},
+		{Uuid: "uuid-5", Name: "Wing", Category: model.CategoryWing, Price: money.New(500, money.DefaultCurrency)},
+	}
+	for _, p := range parts {
+		s.Require().NoError(s.repo.PutPart(s.ctx, p.Uuid, p))
+	}
+
+	orderBy := model.PartsOrderBy{Field: model.PartsOrderFieldPrice, Desc: true}
+	page := model.PartsPage{Limit: 2, OrderBy: orderBy}
+
+	var got []string
+	for range len(parts) {
+		result, err := s.repo.ListParts(s.ctx, nil, page)
+		s.Require().NoError(err)
+		if len(result) == 0 {
+			break
+		}
+		for _, p := range result {
+			got = append(got, p.Uuid)
+		}
+		page.After = model.NewPartsCursor(result[len(result)-1], orderBy)
+	}
+
+	// При равной цене детали идут по UUID в том же направлении
+	s.Require().Equal([]string{"uuid-5", "uuid-3", "uuid-1", "uuid-4", "uuid-2"}, got)
+}
+
+func (s *SuiteRepository) TestCountPartsFilter() {
+	parts := []*model.Part{
+		{Uuid: "uuid-1", Category: model.CategoryWing},
+		{Uuid: "uuid-2", Category: model.CategoryWing},
+		{Uuid: "uuid-3", Category: model.CategoryEngine},
+	}
+	for _, p := range parts {
+		s.Require().NoError(s.repo.PutPart(s.ctx, p.Uuid, p))
+	}
+
+	count, err := s.repo.CountParts(s.ctx, &model.PartsFilter{Categories: []model.Category{model.CategoryWing}})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(2), count)
+}
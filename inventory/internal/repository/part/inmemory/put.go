@@ -7,11 +7,33 @@ import (
 	"github.com/ZanDattSu/star-factory/inventory/internal/repository/converter"
 )
 
-// PutPart сохраняет деталь по UUID. Потокобезопасно.
+// PutPart создаёт деталь или заменяет существующую, сохраняя её остаток и дату создания. Потокобезопасно.
 func (r *repository) PutPart(_ context.Context, uuid string, part *model.Part) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	r.parts[uuid] = converter.PartToRepoModel(part)
+	r.put(uuid, part)
 	return nil
 }
+
+// UpsertParts сохраняет детали так же, как PutPart, одной операцией. Потокобезопасно.
+func (r *repository) UpsertParts(_ context.Context, parts []*model.Part) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, part := range parts {
+		r.put(part.Uuid, part)
+	}
+	return nil
+}
+
+// put сохраняет деталь. Вызывается под блокировкой.
+func (r *repository) put(uuid string, part *model.Part) {
+	stored := converter.PartToRepoModel(part)
+	if existing, ok := r.parts[uuid]; ok {
+		stored.StockQuantity = existing.StockQuantity
+		stored.CreatedAt = existing.CreatedAt
+	}
+
+	r.parts[uuid] = stored
+}
@@ -1,7 +1,10 @@
 package inmemory
 
 import (
+	"time"
+
 	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/inventory/internal/service/part"
 )
 
 func (s *SuiteRepository) TestPutPartOverridesExisting() {
@@ -20,3 +23,20 @@ func (s *SuiteRepository) TestPutPartOverridesExisting() {
 	s.Require().NoError(err)
 	s.Equal("Engine A v2", updated.Name)
 }
+
+func (s *SuiteRepository) TestPutPartKeepsStockAndCreationDate() {
+	original := part.RandomPart()
+	s.Require().NoError(s.repo.PutPart(s.ctx, original.Uuid, original))
+
+	replacement := *original
+	replacement.Name = "Renamed"
+	replacement.StockQuantity = original.StockQuantity + 100
+	replacement.CreatedAt = original.CreatedAt.Add(time.Hour)
+	s.Require().NoError(s.repo.UpsertParts(s.ctx, []*model.Part{&replacement}))
+
+	stored, err := s.repo.GetPart(s.ctx, original.Uuid)
+	s.Require().NoError(err)
+	s.Equal("Renamed", stored.Name)
+	s.Equal(original.StockQuantity, stored.StockQuantity)
+	s.True(original.CreatedAt.Equal(stored.CreatedAt))
+}
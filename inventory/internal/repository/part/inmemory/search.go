@@ -0,0 +1,71 @@
+package inmemory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+)
+
+// SearchParts упрощённая замена текстового индекса MongoDB: запрос и поля детали разбиваются на слова,
+// релевантность — сумма весов полей по всем совпавшим словам.
+func (r *repository) SearchParts(_ context.Context, query string, filter *model.PartsFilter, limit int) ([]*model.PartSearchHit, error) {
+	terms := toSet(model.SearchTerms(query))
+	if len(terms) == 0 {
+		return []*model.PartSearchHit{}, nil
+	}
+
+	r.mu.RLock()
+	parts := r.matchingParts(filter)
+	r.mu.RUnlock()
+
+	hits := make([]*model.PartSearchHit, 0, len(parts))
+	for _, part := range parts {
+		if score := searchScore(part, terms); score > 0 {
+			hits = append(hits, &model.PartSearchHit{Part: part, Score: score})
+		}
+	}
+
+	slices.SortFunc(hits, func(a, b *model.PartSearchHit) int {
+		if a.Score != b.Score {
+			if a.Score > b.Score {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Part.Uuid, b.Part.Uuid)
+	})
+
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+
+	return hits, nil
+}
+
+func searchScore(part *model.Part, terms map[string]struct{}) float64 {
+	score := model.SearchWeightName*countMatches(part.Name, terms) +
+		model.SearchWeightDescription*countMatches(part.Description, terms)
+
+	for _, tag := range part.Tags {
+		score += model.SearchWeightTags * countMatches(tag, terms)
+	}
+
+	if part.Manufacturer != nil {
+		score += model.SearchWeightManufacturer * countMatches(part.Manufacturer.Name, terms)
+	}
+
+	return float64(score)
+}
+
+// countMatches считает слова текста, совпавшие с одним из слов запроса.
+func countMatches(text string, terms map[string]struct{}) int {
+	count := 0
+	for _, word := range model.SplitWords(text) {
+		if _, ok := terms[strings.ToLower(word)]; ok {
+			count++
+		}
+	}
+	return count
+}
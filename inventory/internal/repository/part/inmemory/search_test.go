@@ -0,0 +1,64 @@
+package inmemory
+
+import (
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+)
+
+func (s *SuiteRepository) TestSearchPartsRanksByRelevance() {
+	parts := []*model.Part{
+		{
+			Uuid:         "uuid-1",
+			Name:         "Ion engine",
+			Description:  "Quiet engine for long flights",
+			Category:     model.CategoryEngine,
+			Manufacturer: &model.Manufacturer{Name: "Orbital", Country: "USA"},
+		},
+		{
+			Uuid:         "uuid-2",
+			Name:         "Fuel tank",
+			Description:  "Feeds the engine",
+			Category:     model.CategoryFuel,
+			Manufacturer: &model.Manufacturer{Name: "Orbital", Country: "Germany"},
+		},
+		{
+			Uuid:        "uuid-3",
+			Name:        "Wing",
+			Description: "Carbon wing",
+			Category:    model.CategoryWing,
+			Tags:        []string{"engine-mount"},
+		},
+		{
+			Uuid:     "uuid-4",
+			Name:     "Porthole",
+			Category: model.CategoryPorthole,
+		},
+	}
+	for _, p := range parts {
+		s.Require().NoError(s.repo.PutPart(s.ctx, p.Uuid, p))
+	}
+
+	hits, err := s.repo.SearchParts(s.ctx, "ENGINE!", nil, 0)
+	s.Require().NoError(err)
+
+	got := make([]string, 0, len(hits))
+	for _, hit := range hits {
+		got = append(got, hit.Part.Uuid)
+	}
+	// Название весит больше тега, тег — больше описания
+	s.Require().Equal([]string{"uuid-1", "uuid-3", "uuid-2"}, got)
+	s.Require().Equal(float64(model.SearchWeightName+model.SearchWeightDescription), hits[0].Score)
+
+	hits, err = s.repo.SearchParts(s.ctx, "engine", &model.PartsFilter{ManufacturerCountries: []string{"Germany"}}, 0)
+	s.Require().NoError(err)
+	s.Require().Len(hits, 1)
+	s.Require().Equal("uuid-2", hits[0].Part.Uuid)
+
+	hits, err = s.repo.SearchParts(s.ctx, "engine orbital", nil, 1)
+	s.Require().NoError(err)
+	s.Require().Len(hits, 1)
+	s.Require().Equal("uuid-1", hits[0].Part.Uuid)
+
+	hits, err = s.repo.SearchParts(s.ctx, "--", nil, 0)
+	s.Require().NoError(err)
+	s.Require().Empty(hits)
+}
@@ -0,0 +1,52 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/inventory/internal/repository/converter"
+)
+
+// UpdatePartFields меняет у детали только поля fields. Потокобезопасно.
+func (r *repository) UpdatePartFields(_ context.Context, uuid string, part *model.Part, fields []string) (*model.Part, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.parts[uuid]
+	if !ok {
+		return nil, &model.PartNotFoundError{PartUUID: uuid}
+	}
+
+	patch := converter.PartToRepoModel(part)
+	stored := *existing
+	for _, field := range fields {
+		switch field {
+		case "name":
+			stored.Name = patch.Name
+		case "description":
+			stored.Description = patch.Description
+		case "price":
+			stored.Price = patch.Price
+		case "category":
+			stored.Category = patch.Category
+		case "dimensions":
+			stored.Dimensions = patch.Dimensions
+		case "manufacturer":
+			stored.Manufacturer = patch.Manufacturer
+		case "tags":
+			stored.Tags = patch.Tags
+		case "metadata":
+			stored.Metadata = patch.Metadata
+		case "discontinued":
+			stored.Discontinued = patch.Discontinued
+		case "updated_at":
+			stored.UpdatedAt = patch.UpdatedAt
+		default:
+			return nil, fmt.Errorf("field %q of part %s cannot be updated", field, uuid)
+		}
+	}
+
+	r.parts[uuid] = &stored
+	return converter.PartToModel(&stored), nil
+}
@@ -0,0 +1,29 @@
+package inmemory
+
+import (
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/inventory/internal/service/part"
+)
+
+func (s *SuiteRepository) TestUpdatePartFieldsKeepsOtherFields() {
+	original := part.RandomPart()
+	s.Require().NoError(s.repo.PutPart(s.ctx, original.Uuid, original))
+
+	// Параллельная правка описания не должна потеряться при смене названия
+	_, err := s.repo.UpdatePartFields(s.ctx, original.Uuid, &model.Part{Description: "New description"}, []string{"description"})
+	s.Require().NoError(err)
+
+	updated, err := s.repo.UpdatePartFields(s.ctx, original.Uuid, &model.Part{Name: "Renamed"}, []string{"name"})
+	s.Require().NoError(err)
+	s.Equal("Renamed", updated.Name)
+	s.Equal("New description", updated.Description)
+	s.Equal(original.Price, updated.Price)
+	s.Equal(original.StockQuantity, updated.StockQuantity)
+}
+
+func (s *SuiteRepository) TestUpdatePartFieldsNotFound() {
+	_, err := s.repo.UpdatePartFields(s.ctx, "missing", &model.Part{Name: "Renamed"}, []string{"name"})
+
+	var notFound *model.PartNotFoundError
+	s.Require().ErrorAs(err, &notFound)
+}
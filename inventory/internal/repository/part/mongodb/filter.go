@@ -0,0 +1,40 @@
+package mongodb
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+)
+
+// partsFilterQuery строит запрос по фильтру деталей: $in внутри поля (OR), условия полей объединяются через AND.
+// Пустые поля фильтра в запрос не попадают, поэтому пустой фильтр выбирает все детали в продаже.
+func partsFilterQuery(filter *model.PartsFilter) bson.M {
+	if filter == nil {
+		filter = &model.PartsFilter{}
+	}
+
+	query := bson.M{}
+	if !filter.IncludeDiscontinued {
+		// $ne, а не false: у документов, созданных до появления флага, поля нет
+		query["discontinued"] = bson.M{"$ne": true}
+	}
+
+	if len(filter.Uuids) > 0 {
+		query["uuid"] = bson.M{"$in": filter.Uuids}
+	}
+	if len(filter.Names) > 0 {
+		query["name"] = bson.M{"$in": filter.Names}
+	}
+	if len(filter.Categories) > 0 {
+		query["category"] = bson.M{"$in": filter.Categories}
+	}
+	if len(filter.ManufacturerCountries) > 0 {
+		query["manufacturer.country"] = bson.M{"$in": filter.ManufacturerCountries}
+	}
+	if len(filter.Tags) > 0 {
+		// Для массива $in срабатывает, если совпал хотя бы один тег
+		query["tags"] = bson.M{"$in": filter.Tags}
+	}
+
+	return query
+}
@@ -7,14 +7,16 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 
 	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/inventory/internal/repository/converter"
+	repoModel "github.com/ZanDattSu/star-factory/inventory/internal/repository/model"
 )
 
 func (r *repository) GetPart(ctx context.Context, uuid string) (*model.Part, error) {
-	part := &model.Part{}
+	part := &repoModel.Part{}
 	err := r.collection.FindOne(ctx, bson.M{"uuid": uuid}).Decode(part)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find part with uuid %s: %w", uuid, err)
 	}
 
-	return part, nil
+	return converter.PartToModel(part), nil
 }
@@ -6,12 +6,25 @@ import (
 	"log"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/inventory/internal/repository/converter"
+	repoModel "github.com/ZanDattSu/star-factory/inventory/internal/repository/model"
 )
 
-func (r *repository) ListParts(ctx context.Context) ([]*model.Part, error) {
-	cursor, err := r.collection.Find(ctx, bson.M{})
+func (r *repository) ListParts(ctx context.Context, filter *model.PartsFilter, page model.PartsPage) ([]*model.Part, error) {
+	query := partsFilterQuery(filter)
+	if page.After != nil {
+		query = bson.M{"$and": bson.A{query, afterCursorQuery(page.After)}}
+	}
+
+	opts := options.Find().SetSort(partsSort(page.OrderBy))
+	if page.Limit > 0 {
+		opts.SetLimit(int64(page.Limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, query, opts)
 	if err != nil {
 		return []*model.Part{}, fmt.Errorf("error finding cursor: %w", err)
 	}
@@ -24,12 +37,24 @@ func (r *repository) ListParts(ctx context.Context) ([]*model.Part, error) {
 
 	var parts []*model.Part
 	for cursor.Next(ctx) {
-		var p model.Part
+		var p repoModel.Part
 		if err := cursor.Decode(&p); err != nil {
 			return nil, fmt.Errorf("decode part: %w", err)
 		}
-		parts = append(parts, &p)
+		parts = append(parts, converter.PartToModel(&p))
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate parts: %w", err)
 	}
 
 	return parts, nil
 }
+
+func (r *repository) CountParts(ctx context.Context, filter *model.PartsFilter) (int64, error) {
+	count, err := r.collection.CountDocuments(ctx, partsFilterQuery(filter))
+	if err != nil {
+		return 0, fmt.Errorf("failed to count parts: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,34 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+// migratePrices переводит цены, сохранённые числом с плавающей точкой, в {amount, currency}
+// с суммой в минимальных единицах. Повторный запуск ничего не меняет.
+func migratePrices(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.UpdateMany(ctx,
+		bson.M{"price": bson.M{"$type": "double"}},
+		mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{
+				"price": bson.M{
+					"amount": bson.M{"$toLong": bson.M{
+						"$round": bson.A{bson.M{"$multiply": bson.A{"$price", 100}}, 0},
+					}},
+					"currency": money.DefaultCurrency,
+				},
+			}}},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to migrate part prices: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package mongodb
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+)
+
+// orderFields пути полей сортировки в документе детали.
+var orderFields = map[model.PartsOrderField]string{
+	model.PartsOrderFieldPrice:         "price.amount",
+	model.PartsOrderFieldName:          "name",
+	model.PartsOrderFieldCreatedAt:     "created_at",
+	model.PartsOrderFieldStockQuantity: "stock_quantity",
+}
+
+// partsSort строит сортировку по полю с UUID в качестве второго ключа.
+func partsSort(orderBy model.PartsOrderBy) bson.D {
+	direction := 1
+	if orderBy.Desc {
+		direction = -1
+	}
+
+	sort := bson.D{}
+	if field, ok := orderFields[orderBy.Field]; ok {
+		sort = append(sort, bson.E{Key: field, Value: direction})
+	}
+	return append(sort, bson.E{Key: "uuid", Value: direction})
+}
+
+// afterCursorQuery выбирает детали, идущие в выдаче после курсора.
+func afterCursorQuery(cursor *model.PartsCursor) bson.M {
+	op := "$gt"
+	if cursor.OrderBy.Desc {
+		op = "$lt"
+	}
+
+	field, ok := orderFields[cursor.OrderBy.Field]
+	if !ok {
+		return bson.M{"uuid": bson.M{op: cursor.Uuid}}
+	}
+
+	value := cursorValue(cursor)
+	return bson.M{"$or": bson.A{
+		bson.M{field: bson.M{op: value}},
+		bson.M{field: value, "uuid": bson.M{op: cursor.Uuid}},
+	}}
+}
+
+func cursorValue(cursor *model.PartsCursor) any {
+	switch cursor.OrderBy.Field {
+	case model.PartsOrderFieldPrice:
+		return cursor.PriceAmount
+	case model.PartsOrderFieldName:
+		return cursor.Name
+	case model.PartsOrderFieldCreatedAt:
+		return cursor.CreatedAt
+	default:
+		return cursor.StockQuantity
+	}
+}
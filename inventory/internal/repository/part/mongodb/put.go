@@ -3,9 +3,15 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/ZanDattSu/star-factory/inventory/internal/model"
 	"github.com/ZanDattSu/star-factory/inventory/internal/repository/converter"
+	stockRepository "github.com/ZanDattSu/star-factory/inventory/internal/repository/stock/mongodb"
 )
 
 func (r *repository) PutPart(ctx context.Context, uuid string, part *model.Part) error {
@@ -13,10 +19,78 @@ func (r *repository) PutPart(ctx context.Context, uuid string, part *model.Part)
 		return fmt.Errorf("part is nil")
 	}
 
-	_, err := r.collection.InsertOne(ctx, converter.PartToRepoModel(part))
+	update, err := partUpsert(part)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.collection.UpdateOne(ctx, bson.M{"uuid": uuid}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to upsert part %s: %w", uuid, err)
+	}
+
+	if res.UpsertedCount > 0 {
+		r.flushInitialStock(ctx, uuid)
+	}
+
+	return nil
+}
+
+func (r *repository) UpsertParts(ctx context.Context, parts []*model.Part) error {
+	writes := make([]mongo.WriteModel, 0, len(parts))
+	for _, part := range parts {
+		update, err := partUpsert(part)
+		if err != nil {
+			return err
+		}
+
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"uuid": part.Uuid}).
+			SetUpdate(update).
+			SetUpsert(true))
+	}
+
+	res, err := r.collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
 	if err != nil {
-		return fmt.Errorf("failed to insert part %s: %w", uuid, err)
+		return fmt.Errorf("failed to upsert %d parts: %w", len(parts), err)
+	}
+
+	for i := range res.UpsertedIDs {
+		r.flushInitialStock(ctx, parts[i].Uuid)
 	}
 
 	return nil
 }
+
+// flushInitialStock переносит в журнал движение с начальным остатком только что созданной детали.
+// Деталь уже сохранена, поэтому ошибка только логируется: журнал догонит при следующем Flush.
+func (r *repository) flushInitialStock(ctx context.Context, uuid string) {
+	if err := r.ledger.Flush(ctx, uuid); err != nil {
+		log.Printf("flush initial stock of part %s: %v\n", uuid, err)
+	}
+}
+
+// partUpsert строит обновление, которое заменяет деталь целиком, кроме остатка и даты создания:
+// их задаёт только вставка, чтобы не затереть списания резервов и историю детали.
+// Начальный остаток вставка записывает в журнал движением RECEIPT.
+func partUpsert(part *model.Part) (bson.M, error) {
+	raw, err := bson.Marshal(converter.PartToRepoModel(part))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal part %s: %w", part.Uuid, err)
+	}
+
+	var set bson.M
+	if err = bson.Unmarshal(raw, &set); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal part %s: %w", part.Uuid, err)
+	}
+	delete(set, "stock_quantity")
+	delete(set, "created_at")
+
+	insert := stockRepository.InsertFields(part.Uuid, part.StockQuantity, part.CreatedAt)
+	insert["created_at"] = part.CreatedAt
+
+	return bson.M{
+		"$set":         set,
+		"$setOnInsert": insert,
+	}, nil
+}
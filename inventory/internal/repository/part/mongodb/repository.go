@@ -9,13 +9,16 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
 	repo "github.com/ZanDattSu/star-factory/inventory/internal/repository"
+	stockRepository "github.com/ZanDattSu/star-factory/inventory/internal/repository/stock/mongodb"
 )
 
 var _ repo.PartRepository = (*repository)(nil)
 
 type repository struct {
 	collection *mongo.Collection
+	ledger     *stockRepository.Ledger
 }
 
 func NewRepository(db *mongo.Database) *repository {
@@ -26,6 +29,34 @@ func NewRepository(db *mongo.Database) *repository {
 			Keys:    bson.D{{Key: "uuid", Value: 1}},
 			Options: options.Index().SetUnique(true),
 		},
+		// Индексы под поля фильтра ListParts
+		{Keys: bson.D{{Key: "category", Value: 1}}},
+		{Keys: bson.D{{Key: "manufacturer.country", Value: 1}}},
+		{Keys: bson.D{{Key: "tags", Value: 1}}},
+		// Индексы под сортировку ListParts; UUID — второй ключ для однозначного порядка.
+		// Индекс по name заодно обслуживает фильтр по имени
+		{Keys: bson.D{{Key: "price.amount", Value: 1}, {Key: "uuid", Value: 1}}},
+		{Keys: bson.D{{Key: "name", Value: 1}, {Key: "uuid", Value: 1}}},
+		{Keys: bson.D{{Key: "created_at", Value: 1}, {Key: "uuid", Value: 1}}},
+		{Keys: bson.D{{Key: "stock_quantity", Value: 1}, {Key: "uuid", Value: 1}}},
+		// Текстовый индекс для SearchParts. Язык none: без стемминга слова совпадают так же, как в подсветке
+		{
+			Keys: bson.D{
+				{Key: model.SearchFieldName, Value: "text"},
+				{Key: model.SearchFieldDescription, Value: "text"},
+				{Key: model.SearchFieldTags, Value: "text"},
+				{Key: model.SearchFieldManufacturer, Value: "text"},
+			},
+			Options: options.Index().
+				SetName("parts_text").
+				SetDefaultLanguage("none").
+				SetWeights(bson.D{
+					{Key: model.SearchFieldName, Value: model.SearchWeightName},
+					{Key: model.SearchFieldDescription, Value: model.SearchWeightDescription},
+					{Key: model.SearchFieldTags, Value: model.SearchWeightTags},
+					{Key: model.SearchFieldManufacturer, Value: model.SearchWeightManufacturer},
+				}),
+		},
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -36,7 +67,15 @@ func NewRepository(db *mongo.Database) *repository {
 		panic(fmt.Sprintf("Failed to create index %s: %s", indexUUID, err))
 	}
 
-	r := &repository{collection: partsCollection}
+	err = migratePrices(ctx, partsCollection)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	r := &repository{
+		collection: partsCollection,
+		ledger:     stockRepository.NewLedger(db),
+	}
 	r.InitTestData()
 	return r
 }
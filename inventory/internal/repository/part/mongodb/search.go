@@ -0,0 +1,71 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/inventory/internal/repository/converter"
+	repoModel "github.com/ZanDattSu/star-factory/inventory/internal/repository/model"
+)
+
+// textScore мета-поле MongoDB с релевантностью документа по текстовому индексу.
+var textScore = bson.M{"$meta": "textScore"}
+
+type searchResult struct {
+	repoModel.Part `bson:",inline"`
+	Score          float64 `bson:"score"`
+}
+
+func (r *repository) SearchParts(ctx context.Context, query string, filter *model.PartsFilter, limit int) ([]*model.PartSearchHit, error) {
+	// Передаём в $search только слова: кавычки и минус в запросе MongoDB трактует как фразы и исключения
+	terms := model.SearchTerms(query)
+	if len(terms) == 0 {
+		return []*model.PartSearchHit{}, nil
+	}
+
+	textQuery := bson.M{"$and": bson.A{
+		partsFilterQuery(filter),
+		bson.M{"$text": bson.M{"$search": strings.Join(terms, " ")}},
+	}}
+
+	opts := options.Find().
+		SetProjection(bson.M{"score": textScore}).
+		SetSort(bson.D{{Key: "score", Value: textScore}, {Key: "uuid", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, textQuery, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search parts: %w", err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			log.Printf("closing cursor error: %v\n", cerr)
+		}
+	}()
+
+	var hits []*model.PartSearchHit
+	for cursor.Next(ctx) {
+		var res searchResult
+		if err := cursor.Decode(&res); err != nil {
+			return nil, fmt.Errorf("decode part: %w", err)
+		}
+		hits = append(hits, &model.PartSearchHit{
+			Part:  converter.PartToModel(&res.Part),
+			Score: res.Score,
+		})
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate search results: %w", err)
+	}
+
+	return hits, nil
+}
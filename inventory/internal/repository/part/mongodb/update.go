@@ -0,0 +1,59 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/inventory/internal/repository/converter"
+	repoModel "github.com/ZanDattSu/star-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) UpdatePartFields(ctx context.Context, uuid string, part *model.Part, fields []string) (*model.Part, error) {
+	if part == nil {
+		return nil, fmt.Errorf("part is nil")
+	}
+
+	raw, err := bson.Marshal(converter.PartToRepoModel(part))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal part %s: %w", uuid, err)
+	}
+
+	var doc bson.M
+	if err = bson.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal part %s: %w", uuid, err)
+	}
+
+	// Пустые поля, которые не попали в документ, удаляем, чтобы маска могла их сбросить
+	set, unset := bson.M{}, bson.M{}
+	for _, field := range fields {
+		if value, ok := doc[field]; ok {
+			set[field] = value
+		} else {
+			unset[field] = ""
+		}
+	}
+
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	stored := &repoModel.Part{}
+	err = r.collection.FindOneAndUpdate(ctx, bson.M{"uuid": uuid}, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(stored)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, &model.PartNotFoundError{PartUUID: uuid}
+		}
+		return nil, fmt.Errorf("failed to update part %s: %w", uuid, err)
+	}
+
+	return converter.PartToModel(stored), nil
+}
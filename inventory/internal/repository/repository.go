@@ -2,12 +2,50 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/ZanDattSu/star-factory/inventory/internal/model"
 )
 
 type PartRepository interface {
 	GetPart(ctx context.Context, uuid string) (*model.Part, error)
+	// PutPart создаёт деталь или заменяет существующую целиком, кроме остатка и даты создания:
+	// остаток меняется только движениями через журнал, дата создания остаётся от первой записи.
 	PutPart(ctx context.Context, uuid string, part *model.Part) error
-	ListParts(ctx context.Context) ([]*model.Part, error)
+	// UpdatePartFields меняет у детали только поля fields (имена полей хранилища), беря значения из part,
+	// и возвращает деталь после изменения. Остальные поля не трогает, поэтому параллельные правки других полей не теряются.
+	UpdatePartFields(ctx context.Context, uuid string, part *model.Part, fields []string) (*model.Part, error)
+	// UpsertParts сохраняет несколько деталей по правилам PutPart.
+	UpsertParts(ctx context.Context, parts []*model.Part) error
+	// ListParts возвращает страницу деталей, подходящих под фильтр: OR внутри поля, AND между полями.
+	// Пустой или nil фильтр возвращает все детали.
+	ListParts(ctx context.Context, filter *model.PartsFilter, page model.PartsPage) ([]*model.Part, error)
+	// CountParts возвращает число деталей, подходящих под фильтр.
+	CountParts(ctx context.Context, filter *model.PartsFilter) (int64, error)
+	// SearchParts ищет детали под фильтром по словам запроса в названии, описании, тегах и производителе.
+	// Результаты отсортированы по убыванию релевантности; limit 0 — без ограничения.
+	SearchParts(ctx context.Context, query string, filter *model.PartsFilter, limit int) ([]*model.PartSearchHit, error)
+}
+
+// ReservationRepository хранит резервы и атомарно меняет остатки деталей, записывая движения в журнал.
+type ReservationRepository interface {
+	// CreateReservation списывает остатки по позициям резерва и сохраняет его.
+	// Если резерв для заказа уже есть, возвращает существующий.
+	CreateReservation(ctx context.Context, reservation *model.Reservation) (*model.Reservation, error)
+	// ReleaseReservation переводит активный резерв в status и возвращает остатки на склад.
+	// В RELEASED переводится и подтверждённый резерв, если заказ так и не оплатили.
+	ReleaseReservation(ctx context.Context, uuid string, status model.ReservationStatus) (*model.Reservation, error)
+	// CommitReservation подтверждает активный неистёкший резерв.
+	CommitReservation(ctx context.Context, uuid string) (*model.Reservation, error)
+	// ListExpiredReservations возвращает активные резервы с истёкшим сроком.
+	ListExpiredReservations(ctx context.Context, now time.Time, limit int) ([]*model.Reservation, error)
+}
+
+// StockRepository меняет остатки деталей вручную и ведёт журнал их движений.
+type StockRepository interface {
+	// AdjustStock применяет движение к остатку детали и записывает его в журнал.
+	// Списание, после которого остаток стал бы отрицательным, не применяется и возвращает InsufficientStockError.
+	AdjustStock(ctx context.Context, movement *model.StockMovement) (*model.StockMovement, error)
+	// ListStockMovements возвращает страницу журнала детали от новых движений к старым.
+	ListStockMovements(ctx context.Context, partUUID string, page model.StockMovementsPage) ([]*model.StockMovement, error)
 }
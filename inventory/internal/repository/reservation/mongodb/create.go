@@ -0,0 +1,120 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/inventory/internal/repository/converter"
+	repoModel "github.com/ZanDattSu/star-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) CreateReservation(ctx context.Context, reservation *model.Reservation) (*model.Reservation, error) {
+	if reservation == nil {
+		return nil, fmt.Errorf("reservation is nil")
+	}
+
+	existing, err := r.findActiveByOrder(ctx, reservation.OrderUuid)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return sameReservation(existing, reservation)
+	}
+
+	taken := make([]model.ReservationItem, 0, len(reservation.Items))
+	var shortages []model.StockShortage
+
+	for _, item := range reservation.Items {
+		ok, err := r.takeStock(ctx, reservation, item)
+		if err != nil {
+			return nil, r.rollback(ctx, reservation.Uuid, taken, err)
+		}
+
+		if ok {
+			taken = append(taken, item)
+			continue
+		}
+
+		available, err := r.availableStock(ctx, item.PartUuid)
+		if err != nil {
+			return nil, r.rollback(ctx, reservation.Uuid, taken, err)
+		}
+
+		shortages = append(shortages, model.StockShortage{
+			PartUuid:  item.PartUuid,
+			Requested: item.Quantity,
+			Available: available,
+		})
+	}
+
+	if len(shortages) > 0 {
+		return nil, r.rollback(ctx, reservation.Uuid, taken, &model.InsufficientStockError{Shortages: shortages})
+	}
+
+	_, err = r.reservations.InsertOne(ctx, converter.ReservationToRepoModel(reservation))
+	if err != nil {
+		// Параллельный запрос уже создал резерв под этот заказ — отдаём его
+		if mongo.IsDuplicateKeyError(err) {
+			if rbErr := r.returnStock(ctx, reservation.Uuid, taken, rollbackReason); rbErr != nil {
+				return nil, rbErr
+			}
+			existing, err = r.findActiveByOrder(ctx, reservation.OrderUuid)
+			if err != nil {
+				return nil, err
+			}
+			if existing == nil {
+				return nil, fmt.Errorf("active reservation for order %s disappeared after duplicate insert", reservation.OrderUuid)
+			}
+			return sameReservation(existing, reservation)
+		}
+		return nil, r.rollback(ctx, reservation.Uuid, taken, fmt.Errorf("failed to insert reservation %s: %w", reservation.Uuid, err))
+	}
+
+	return reservation, nil
+}
+
+// rollbackReason причина в журнале для остатков, возвращённых несостоявшимся резервом.
+const rollbackReason = "reservation rolled back"
+
+// rollback возвращает уже списанные остатки и пробрасывает исходную ошибку.
+func (r *repository) rollback(ctx context.Context, reservationUUID string, taken []model.ReservationItem, cause error) error {
+	if err := r.returnStock(ctx, reservationUUID, taken, rollbackReason); err != nil {
+		return errors.Join(cause, err)
+	}
+
+	return cause
+}
+
+// sameReservation делает повтор резервирования идемпотентным: тот же состав возвращает уже созданный резерв.
+// Резерв с другим составом означает, что заказ параллельно редактируют, и возвращает ReservationConflictError.
+func sameReservation(existing, requested *model.Reservation) (*model.Reservation, error) {
+	if !existing.HasItems(requested.Items) {
+		return nil, &model.ReservationConflictError{
+			OrderUUID:       requested.OrderUuid,
+			ReservationUUID: existing.Uuid,
+		}
+	}
+
+	return existing, nil
+}
+
+// findActiveByOrder ищет активный резерв заказа. Снятые и истёкшие резервы не мешают зарезервировать заказ заново.
+func (r *repository) findActiveByOrder(ctx context.Context, orderUUID string) (*model.Reservation, error) {
+	var reservation repoModel.Reservation
+
+	filter := bson.M{"order_uuid": orderUUID, "status": string(model.ReservationStatusActive)}
+	err := r.reservations.FindOne(ctx, filter).Decode(&reservation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find reservation for order %s: %w", orderUUID, err)
+	}
+
+	return converter.ReservationToModel(&reservation), nil
+}
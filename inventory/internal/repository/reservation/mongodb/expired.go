@@ -0,0 +1,39 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/inventory/internal/repository/converter"
+	repoModel "github.com/ZanDattSu/star-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) ListExpiredReservations(ctx context.Context, now time.Time, limit int) ([]*model.Reservation, error) {
+	cursor, err := r.reservations.Find(ctx,
+		bson.M{
+			"status":     string(model.ReservationStatusActive),
+			"expires_at": bson.M{"$lte": now},
+		},
+		options.Find().SetSort(bson.D{{Key: "expires_at", Value: 1}}).SetLimit(int64(limit)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find expired reservations: %w", err)
+	}
+
+	var reservations []repoModel.Reservation
+	if err = cursor.All(ctx, &reservations); err != nil {
+		return nil, fmt.Errorf("decode reservations: %w", err)
+	}
+
+	result := make([]*model.Reservation, 0, len(reservations))
+	for i := range reservations {
+		result = append(result, converter.ReservationToModel(&reservations[i]))
+	}
+
+	return result, nil
+}
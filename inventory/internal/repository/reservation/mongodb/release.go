@@ -0,0 +1,112 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/inventory/internal/repository/converter"
+	repoModel "github.com/ZanDattSu/star-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) ReleaseReservation(ctx context.Context, uuid string, status model.ReservationStatus) (*model.Reservation, error) {
+	from := []string{string(model.ReservationStatusActive)}
+	// Order подтверждает резерв до списания денег: если заказ так и не оплатили, резерв снимают вместе с продажей
+	if status == model.ReservationStatusReleased {
+		from = append(from, string(model.ReservationStatusCommitted))
+	}
+
+	reservation, err := r.transition(ctx,
+		bson.M{"uuid": uuid, "status": bson.M{"$in": from}},
+		status,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil {
+		return nil, r.stateError(ctx, uuid)
+	}
+
+	// Статус уже сменён, поэтому повторный release не вернёт остатки дважды
+	err = r.returnStock(ctx, uuid, reservation.Items, fmt.Sprintf("reservation %s", strings.ToLower(string(status))))
+	if err != nil {
+		return nil, err
+	}
+
+	reservation.Status = status
+	return reservation, nil
+}
+
+func (r *repository) CommitReservation(ctx context.Context, uuid string) (*model.Reservation, error) {
+	reservation, err := r.transition(ctx,
+		bson.M{
+			"uuid":       uuid,
+			"status":     string(model.ReservationStatusActive),
+			"expires_at": bson.M{"$gt": time.Now()},
+		},
+		model.ReservationStatusCommitted,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil {
+		return nil, r.stateError(ctx, uuid)
+	}
+
+	// Статус уже сменён, поэтому повторный commit не запишет продажу дважды
+	err = r.sellStock(ctx, reservation)
+	if err != nil {
+		return nil, err
+	}
+
+	reservation.Status = model.ReservationStatusCommitted
+	return reservation, nil
+}
+
+// transition атомарно меняет статус резерва, подходящего под filter.
+// Возвращает резерв в состоянии до изменения или nil, если под filter ничего не подошло.
+func (r *repository) transition(ctx context.Context, filter bson.M, status model.ReservationStatus) (*model.Reservation, error) {
+	var reservation repoModel.Reservation
+
+	err := r.reservations.FindOneAndUpdate(ctx,
+		filter,
+		bson.M{"$set": bson.M{"status": string(status), "updated_at": time.Now()}},
+		options.FindOneAndUpdate().SetReturnDocument(options.Before),
+	).Decode(&reservation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to update reservation: %w", err)
+	}
+
+	return converter.ReservationToModel(&reservation), nil
+}
+
+// stateError объясняет, почему резерв не удалось перевести: его нет или он уже не активен.
+func (r *repository) stateError(ctx context.Context, uuid string) error {
+	var reservation repoModel.Reservation
+
+	err := r.reservations.FindOne(ctx, bson.M{"uuid": uuid}).Decode(&reservation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &model.ReservationNotFoundError{ReservationUUID: uuid}
+		}
+		return fmt.Errorf("failed to find reservation %s: %w", uuid, err)
+	}
+
+	status := model.ReservationStatus(reservation.Status)
+	// Активный, но просроченный резерв ещё не подобран sweeper'ом
+	if status == model.ReservationStatusActive {
+		status = model.ReservationStatusExpired
+	}
+
+	return &model.ReservationStateError{ReservationUUID: uuid, Status: status}
+}
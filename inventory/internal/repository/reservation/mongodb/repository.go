@@ -0,0 +1,97 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	repo "github.com/ZanDattSu/star-factory/inventory/internal/repository"
+	stockRepository "github.com/ZanDattSu/star-factory/inventory/internal/repository/stock/mongodb"
+)
+
+var _ repo.ReservationRepository = (*repository)(nil)
+
+const (
+	codeNamespaceNotFound = 26
+	codeIndexNotFound     = 27
+
+	activeOrderIndex = "order_uuid_active"
+	legacyOrderIndex = "order_uuid_1"
+)
+
+// repository работает с резервами и остатками деталей.
+// MongoDB развёрнута без replica set, поэтому транзакции недоступны:
+// остатки меняются атомарными обновлениями по каждой детали через журнал движений,
+// а частичные изменения откатываются компенсацией.
+type repository struct {
+	reservations *mongo.Collection
+	parts        *mongo.Collection
+	ledger       *stockRepository.Ledger
+}
+
+func NewRepository(db *mongo.Database) (*repository, error) {
+	reservationsCollection := db.Collection("reservations")
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "uuid", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			// У заказа может быть только один активный резерв; после снятия заказ можно зарезервировать заново
+			Keys: bson.D{{Key: "order_uuid", Value: 1}},
+			Options: options.Index().
+				SetName(activeOrderIndex).
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": string(model.ReservationStatusActive)}),
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "expires_at", Value: 1}},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := dropLegacyOrderIndex(ctx, reservationsCollection); err != nil {
+		return nil, err
+	}
+
+	indexes, err := reservationsCollection.Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create indexes %s: %w", indexes, err)
+	}
+
+	return &repository{
+		reservations: reservationsCollection,
+		parts:        db.Collection("parts"),
+		ledger:       stockRepository.NewLedger(db),
+	}, nil
+}
+
+// dropLegacyOrderIndex удаляет прежний индекс, который делал order_uuid уникальным среди всех резервов,
+// включая снятые, и не давал зарезервировать заказ заново. Если индекса уже нет, удалять нечего.
+func dropLegacyOrderIndex(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().DropOne(ctx, legacyOrderIndex)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("failed to drop index %s: %w", legacyOrderIndex, err)
+	}
+
+	return nil
+}
+
+// isNotFound сообщает, что удаляемого индекса или самой коллекции ещё нет.
+func isNotFound(err error) bool {
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) {
+		return cmdErr.Code == codeNamespaceNotFound || cmdErr.Code == codeIndexNotFound
+	}
+
+	return false
+}
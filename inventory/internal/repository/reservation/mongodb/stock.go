@@ -0,0 +1,94 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+)
+
+// takeStock списывает позицию резерва с остатка детали, только если его хватает и деталь в продаже.
+// Возвращает false, если остатка недостаточно, детали нет или она снята с продажи.
+func (r *repository) takeStock(ctx context.Context, reservation *model.Reservation, item model.ReservationItem) (bool, error) {
+	movement, err := r.ledger.Apply(ctx,
+		reservationMovement(reservation.Uuid, item.PartUuid, model.StockMovementTypeReservation, -item.Quantity,
+			fmt.Sprintf("reserved for order %s", reservation.OrderUuid)),
+		bson.M{
+			"stock_quantity": bson.M{"$gte": item.Quantity},
+			"discontinued":   bson.M{"$ne": true},
+		},
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to take stock of part %s: %w", item.PartUuid, err)
+	}
+
+	return movement != nil, nil
+}
+
+// returnStock возвращает на склад остатки по позициям резерва; reason попадает в журнал движений.
+func (r *repository) returnStock(ctx context.Context, reservationUUID string, items []model.ReservationItem, reason string) error {
+	for _, item := range items {
+		_, err := r.ledger.Apply(ctx,
+			reservationMovement(reservationUUID, item.PartUuid, model.StockMovementTypeReservation, item.Quantity, reason),
+			nil,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to return stock of part %s: %w", item.PartUuid, err)
+		}
+	}
+
+	return nil
+}
+
+// sellStock записывает в журнал продажу позиций подтверждённого резерва.
+// Остаток не меняется: он списан при резервировании.
+func (r *repository) sellStock(ctx context.Context, reservation *model.Reservation) error {
+	for _, item := range reservation.Items {
+		_, err := r.ledger.Apply(ctx,
+			reservationMovement(reservation.Uuid, item.PartUuid, model.StockMovementTypeSale, 0,
+				fmt.Sprintf("sold %d reserved for order %s", item.Quantity, reservation.OrderUuid)),
+			nil,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record sale of part %s: %w", item.PartUuid, err)
+		}
+	}
+
+	return nil
+}
+
+func reservationMovement(reservationUUID, partUUID string, movementType model.StockMovementType, delta int64, reason string) *model.StockMovement {
+	return &model.StockMovement{
+		Uuid:            uuid.NewString(),
+		PartUuid:        partUUID,
+		Type:            movementType,
+		Delta:           delta,
+		Reason:          reason,
+		Actor:           model.StockActorSystem,
+		ReservationUuid: reservationUUID,
+		CreatedAt:       time.Now(),
+	}
+}
+
+// availableStock возвращает текущий остаток детали или 0, если детали нет или она снята с продажи.
+func (r *repository) availableStock(ctx context.Context, partUUID string) (int64, error) {
+	var part struct {
+		StockQuantity int64 `bson:"stock_quantity"`
+	}
+
+	err := r.parts.FindOne(ctx, bson.M{"uuid": partUUID, "discontinued": bson.M{"$ne": true}}).Decode(&part)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to find part %s: %w", partUUID, err)
+	}
+
+	return part.StockQuantity, nil
+}
@@ -0,0 +1,54 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+)
+
+func (r *repository) AdjustStock(ctx context.Context, movement *model.StockMovement) (*model.StockMovement, error) {
+	if movement == nil {
+		return nil, fmt.Errorf("stock movement is nil")
+	}
+
+	guard := bson.M{}
+	if movement.Delta < 0 {
+		guard["stock_quantity"] = bson.M{"$gte": -movement.Delta}
+	}
+
+	applied, err := r.ledger.Apply(ctx, movement, guard)
+	if err != nil {
+		return nil, err
+	}
+	if applied == nil {
+		return nil, r.adjustError(ctx, movement)
+	}
+
+	return applied, nil
+}
+
+// adjustError объясняет, почему движение не применилось: детали нет или остатка не хватает на списание.
+func (r *repository) adjustError(ctx context.Context, movement *model.StockMovement) error {
+	var part struct {
+		StockQuantity int64 `bson:"stock_quantity"`
+	}
+
+	err := r.ledger.parts.FindOne(ctx, bson.M{"uuid": movement.PartUuid}).Decode(&part)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &model.PartNotFoundError{PartUUID: movement.PartUuid}
+		}
+		return fmt.Errorf("failed to find part %s: %w", movement.PartUuid, err)
+	}
+
+	return &model.InsufficientStockError{Shortages: []model.StockShortage{{
+		PartUuid:  movement.PartUuid,
+		Requested: -movement.Delta,
+		Available: part.StockQuantity,
+	}}}
+}
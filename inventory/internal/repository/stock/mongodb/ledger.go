@@ -0,0 +1,197 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/inventory/internal/repository/converter"
+	repoModel "github.com/ZanDattSu/star-factory/inventory/internal/repository/model"
+)
+
+const (
+	movementsCollection = "stock_movements"
+
+	// pendingField движения, уже применённые к остатку детали, но ещё не перенесённые в журнал
+	pendingField = "pending_stock_movements"
+	// sequenceField номер последнего движения детали
+	sequenceField = "stock_sequence"
+)
+
+// Ledger меняет остатки деталей вместе с журналом движений.
+//
+// MongoDB развёрнута без replica set, поэтому транзакции недоступны. Движение применяется одним
+// условным обновлением документа детали: остаток, номер движения и сама запись в pending_stock_movements
+// меняются атомарно. Затем запись переносится в коллекцию stock_movements. Если перенос прервался,
+// его доделает следующий Flush этой детали. Повторная вставка отсекается уникальным индексом.
+type Ledger struct {
+	parts     *mongo.Collection
+	movements *mongo.Collection
+}
+
+func NewLedger(db *mongo.Database) *Ledger {
+	collection := db.Collection(movementsCollection)
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "uuid", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			// Номер движения уникален в истории детали и задаёт порядок выдачи журнала
+			Keys:    bson.D{{Key: "part_uuid", Value: 1}, {Key: "sequence", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	indexes, err := collection.Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create indexes %s: %s", indexes, err))
+	}
+
+	return &Ledger{
+		parts:     db.Collection("parts"),
+		movements: collection,
+	}
+}
+
+// Apply применяет движение к остатку детали movement.PartUuid, если деталь подходит под guard.
+// Возвращает движение с номером и остатком после него или nil, если под условие ничего не подошло.
+func (l *Ledger) Apply(ctx context.Context, movement *model.StockMovement, guard bson.M) (*model.StockMovement, error) {
+	filter := bson.M{"uuid": movement.PartUuid}
+	maps.Copy(filter, guard)
+
+	var part struct {
+		StockQuantity int64 `bson:"stock_quantity"`
+		StockSequence int64 `bson:"stock_sequence"`
+	}
+
+	err := l.parts.FindOneAndUpdate(ctx,
+		filter,
+		movementUpdate(converter.StockMovementToRepoModel(movement)),
+		options.FindOneAndUpdate().
+			SetReturnDocument(options.After).
+			SetProjection(bson.M{"stock_quantity": 1, sequenceField: 1}),
+	).Decode(&part)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to apply stock movement to part %s: %w", movement.PartUuid, err)
+	}
+
+	applied := *movement
+	applied.Sequence = part.StockSequence
+	applied.QuantityAfter = part.StockQuantity
+
+	// Движение уже сохранено в детали: ошибка переноса не отменяет его, журнал догонит при следующем Flush
+	if err = l.Flush(ctx, movement.PartUuid); err != nil {
+		log.Printf("flush stock movements of part %s: %v\n", movement.PartUuid, err)
+	}
+
+	return &applied, nil
+}
+
+// Flush переносит в журнал движения, которые остались в документе детали.
+func (l *Ledger) Flush(ctx context.Context, partUUID string) error {
+	var part struct {
+		Pending []repoModel.StockMovement `bson:"pending_stock_movements"`
+	}
+
+	err := l.parts.FindOne(ctx,
+		bson.M{"uuid": partUUID},
+		options.FindOne().SetProjection(bson.M{pendingField: 1}),
+	).Decode(&part)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &model.PartNotFoundError{PartUUID: partUUID}
+		}
+		return fmt.Errorf("failed to find part %s: %w", partUUID, err)
+	}
+
+	if len(part.Pending) == 0 {
+		return nil
+	}
+
+	uuids := make([]string, 0, len(part.Pending))
+	for _, movement := range part.Pending {
+		_, err = l.movements.InsertOne(ctx, movement)
+		// Запись уже перенёс параллельный Flush
+		if err != nil && !mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("failed to insert stock movement %s: %w", movement.Uuid, err)
+		}
+		uuids = append(uuids, movement.Uuid)
+	}
+
+	_, err = l.parts.UpdateOne(ctx,
+		bson.M{"uuid": partUUID},
+		bson.M{"$pull": bson.M{pendingField: bson.M{"uuid": bson.M{"$in": uuids}}}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to clear pending stock movements of part %s: %w", partUUID, err)
+	}
+
+	return nil
+}
+
+// InsertFields возвращает поля для $setOnInsert новой детали. Ненулевой начальный остаток записывается
+// первым движением RECEIPT в том же обновлении, что создаёт деталь, поэтому журнал сходится с остатком.
+// После вставки движение переносится в журнал через Flush.
+func InsertFields(partUUID string, quantity int64, createdAt time.Time) bson.M {
+	fields := bson.M{"stock_quantity": quantity}
+	if quantity == 0 {
+		return fields
+	}
+
+	fields[sequenceField] = int64(1)
+	fields[pendingField] = bson.A{converter.StockMovementToRepoModel(&model.StockMovement{
+		Uuid:          uuid.NewString(),
+		PartUuid:      partUUID,
+		Sequence:      1,
+		Type:          model.StockMovementTypeReceipt,
+		Delta:         quantity,
+		QuantityAfter: quantity,
+		Reason:        "initial stock of a new part",
+		Actor:         model.StockActorSystem,
+		CreatedAt:     createdAt,
+	})}
+
+	return fields
+}
+
+// movementUpdate строит обновление-конвейер: выражения в одном $set видят документ до изменения,
+// поэтому номер и остаток в записи журнала совпадают с теми, что получает деталь.
+func movementUpdate(movement *repoModel.StockMovement) mongo.Pipeline {
+	sequence := bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$" + sequenceField, 0}}, 1}}
+	quantityAfter := bson.M{"$add": bson.A{"$stock_quantity", movement.Delta}}
+
+	set := bson.M{
+		"stock_quantity": quantityAfter,
+		sequenceField:    sequence,
+		pendingField: bson.M{"$concatArrays": bson.A{
+			bson.M{"$ifNull": bson.A{"$" + pendingField, bson.A{}}},
+			bson.A{bson.M{"$mergeObjects": bson.A{
+				// $literal не даёт разобрать строки вида "$..." в причине как ссылки на поля
+				bson.M{"$literal": movement},
+				bson.M{"sequence": sequence, "quantity_after": quantityAfter},
+			}}},
+		}},
+	}
+	if movement.Delta != 0 {
+		set["updated_at"] = movement.CreatedAt
+	}
+
+	return mongo.Pipeline{{{Key: "$set", Value: set}}}
+}
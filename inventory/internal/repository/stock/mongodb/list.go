@@ -0,0 +1,56 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/inventory/internal/repository/converter"
+	repoModel "github.com/ZanDattSu/star-factory/inventory/internal/repository/model"
+)
+
+func (r *repository) ListStockMovements(ctx context.Context, partUUID string, page model.StockMovementsPage) ([]*model.StockMovement, error) {
+	// Сначала дописываем в журнал движения, перенос которых прервался
+	if err := r.ledger.Flush(ctx, partUUID); err != nil {
+		return nil, err
+	}
+
+	query := bson.M{"part_uuid": partUUID}
+	if page.BeforeSequence > 0 {
+		query["sequence"] = bson.M{"$lt": page.BeforeSequence}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "sequence", Value: -1}})
+	if page.Limit > 0 {
+		opts.SetLimit(int64(page.Limit))
+	}
+
+	cursor, err := r.ledger.movements.Find(ctx, query, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find stock movements of part %s: %w", partUUID, err)
+	}
+
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			log.Printf("closing cursor error: %v\n", cerr)
+		}
+	}()
+
+	var movements []*model.StockMovement
+	for cursor.Next(ctx) {
+		var m repoModel.StockMovement
+		if err := cursor.Decode(&m); err != nil {
+			return nil, fmt.Errorf("decode stock movement: %w", err)
+		}
+		movements = append(movements, converter.StockMovementToModel(&m))
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate stock movements of part %s: %w", partUUID, err)
+	}
+
+	return movements, nil
+}
@@ -0,0 +1,17 @@
+package mongodb
+
+import (
+	"go.mongodb.org/mongo-driver/mongo"
+
+	repo "github.com/ZanDattSu/star-factory/inventory/internal/repository"
+)
+
+var _ repo.StockRepository = (*repository)(nil)
+
+type repository struct {
+	ledger *Ledger
+}
+
+func NewRepository(db *mongo.Database) *repository {
+	return &repository{ledger: NewLedger(db)}
+}
@@ -22,6 +22,174 @@ func (_m *PartService) EXPECT() *PartService_Expecter {
 	return &PartService_Expecter{mock: &_m.Mock}
 }
 
+// BatchUpsertParts provides a mock function with given fields: ctx, userUUID, parts
+func (_m *PartService) BatchUpsertParts(ctx context.Context, userUUID string, parts []*model.Part) ([]*model.Part, error) {
+	ret := _m.Called(ctx, userUUID, parts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchUpsertParts")
+	}
+
+	var r0 []*model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*model.Part) ([]*model.Part, error)); ok {
+		return rf(ctx, userUUID, parts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*model.Part) []*model.Part); ok {
+		r0 = rf(ctx, userUUID, parts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*model.Part) error); ok {
+		r1 = rf(ctx, userUUID, parts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_BatchUpsertParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchUpsertParts'
+type PartService_BatchUpsertParts_Call struct {
+	*mock.Call
+}
+
+// BatchUpsertParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - parts []*model.Part
+func (_e *PartService_Expecter) BatchUpsertParts(ctx interface{}, userUUID interface{}, parts interface{}) *PartService_BatchUpsertParts_Call {
+	return &PartService_BatchUpsertParts_Call{Call: _e.mock.On("BatchUpsertParts", ctx, userUUID, parts)}
+}
+
+func (_c *PartService_BatchUpsertParts_Call) Run(run func(ctx context.Context, userUUID string, parts []*model.Part)) *PartService_BatchUpsertParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]*model.Part))
+	})
+	return _c
+}
+
+func (_c *PartService_BatchUpsertParts_Call) Return(_a0 []*model.Part, _a1 error) *PartService_BatchUpsertParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_BatchUpsertParts_Call) RunAndReturn(run func(context.Context, string, []*model.Part) ([]*model.Part, error)) *PartService_BatchUpsertParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePart provides a mock function with given fields: ctx, userUUID, part
+func (_m *PartService) CreatePart(ctx context.Context, userUUID string, part *model.Part) (*model.Part, error) {
+	ret := _m.Called(ctx, userUUID, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePart")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.Part) (*model.Part, error)); ok {
+		return rf(ctx, userUUID, part)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.Part) *model.Part); ok {
+		r0 = rf(ctx, userUUID, part)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *model.Part) error); ok {
+		r1 = rf(ctx, userUUID, part)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_CreatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePart'
+type PartService_CreatePart_Call struct {
+	*mock.Call
+}
+
+// CreatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - part *model.Part
+func (_e *PartService_Expecter) CreatePart(ctx interface{}, userUUID interface{}, part interface{}) *PartService_CreatePart_Call {
+	return &PartService_CreatePart_Call{Call: _e.mock.On("CreatePart", ctx, userUUID, part)}
+}
+
+func (_c *PartService_CreatePart_Call) Run(run func(ctx context.Context, userUUID string, part *model.Part)) *PartService_CreatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*model.Part))
+	})
+	return _c
+}
+
+func (_c *PartService_CreatePart_Call) Return(_a0 *model.Part, _a1 error) *PartService_CreatePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_CreatePart_Call) RunAndReturn(run func(context.Context, string, *model.Part) (*model.Part, error)) *PartService_CreatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePart provides a mock function with given fields: ctx, userUUID, partUUID
+func (_m *PartService) DeletePart(ctx context.Context, userUUID string, partUUID string) error {
+	ret := _m.Called(ctx, userUUID, partUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userUUID, partUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartService_DeletePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePart'
+type PartService_DeletePart_Call struct {
+	*mock.Call
+}
+
+// DeletePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - partUUID string
+func (_e *PartService_Expecter) DeletePart(ctx interface{}, userUUID interface{}, partUUID interface{}) *PartService_DeletePart_Call {
+	return &PartService_DeletePart_Call{Call: _e.mock.On("DeletePart", ctx, userUUID, partUUID)}
+}
+
+func (_c *PartService_DeletePart_Call) Run(run func(ctx context.Context, userUUID string, partUUID string)) *PartService_DeletePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PartService_DeletePart_Call) Return(_a0 error) *PartService_DeletePart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartService_DeletePart_Call) RunAndReturn(run func(context.Context, string, string) error) *PartService_DeletePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPart provides a mock function with given fields: ctx, uuid
 func (_m *PartService) GetPart(ctx context.Context, uuid string) (*model.Part, error) {
 	ret := _m.Called(ctx, uuid)
@@ -81,29 +249,29 @@ func (_c *PartService_GetPart_Call) RunAndReturn(run func(context.Context, strin
 	return _c
 }
 
-// ListParts provides a mock function with given fields: ctx, filter
-func (_m *PartService) ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error) {
-	ret := _m.Called(ctx, filter)
+// ListParts provides a mock function with given fields: ctx, filter, page
+func (_m *PartService) ListParts(ctx context.Context, filter *model.PartsFilter, page model.PartsPageRequest) (*model.PartsList, error) {
+	ret := _m.Called(ctx, filter, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListParts")
 	}
 
-	var r0 []*model.Part
+	var r0 *model.PartsList
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter) ([]*model.Part, error)); ok {
-		return rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter, model.PartsPageRequest) (*model.PartsList, error)); ok {
+		return rf(ctx, filter, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter) []*model.Part); ok {
-		r0 = rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter, model.PartsPageRequest) *model.PartsList); ok {
+		r0 = rf(ctx, filter, page)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Part)
+			r0 = ret.Get(0).(*model.PartsList)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.PartsFilter) error); ok {
-		r1 = rf(ctx, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PartsFilter, model.PartsPageRequest) error); ok {
+		r1 = rf(ctx, filter, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -119,23 +287,147 @@ type PartService_ListParts_Call struct {
 // ListParts is a helper method to define mock.On call
 //   - ctx context.Context
 //   - filter *model.PartsFilter
-func (_e *PartService_Expecter) ListParts(ctx interface{}, filter interface{}) *PartService_ListParts_Call {
-	return &PartService_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter)}
+//   - page model.PartsPageRequest
+func (_e *PartService_Expecter) ListParts(ctx interface{}, filter interface{}, page interface{}) *PartService_ListParts_Call {
+	return &PartService_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter, page)}
+}
+
+func (_c *PartService_ListParts_Call) Run(run func(ctx context.Context, filter *model.PartsFilter, page model.PartsPageRequest)) *PartService_ListParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PartsFilter), args[2].(model.PartsPageRequest))
+	})
+	return _c
+}
+
+func (_c *PartService_ListParts_Call) Return(_a0 *model.PartsList, _a1 error) *PartService_ListParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_ListParts_Call) RunAndReturn(run func(context.Context, *model.PartsFilter, model.PartsPageRequest) (*model.PartsList, error)) *PartService_ListParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchParts provides a mock function with given fields: ctx, query, filter, limit
+func (_m *PartService) SearchParts(ctx context.Context, query string, filter *model.PartsFilter, limit int) ([]*model.PartSearchHit, error) {
+	ret := _m.Called(ctx, query, filter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchParts")
+	}
+
+	var r0 []*model.PartSearchHit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.PartsFilter, int) ([]*model.PartSearchHit, error)); ok {
+		return rf(ctx, query, filter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.PartsFilter, int) []*model.PartSearchHit); ok {
+		r0 = rf(ctx, query, filter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PartSearchHit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *model.PartsFilter, int) error); ok {
+		r1 = rf(ctx, query, filter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_SearchParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchParts'
+type PartService_SearchParts_Call struct {
+	*mock.Call
+}
+
+// SearchParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - filter *model.PartsFilter
+//   - limit int
+func (_e *PartService_Expecter) SearchParts(ctx interface{}, query interface{}, filter interface{}, limit interface{}) *PartService_SearchParts_Call {
+	return &PartService_SearchParts_Call{Call: _e.mock.On("SearchParts", ctx, query, filter, limit)}
+}
+
+func (_c *PartService_SearchParts_Call) Run(run func(ctx context.Context, query string, filter *model.PartsFilter, limit int)) *PartService_SearchParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*model.PartsFilter), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *PartService_SearchParts_Call) Return(_a0 []*model.PartSearchHit, _a1 error) *PartService_SearchParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_SearchParts_Call) RunAndReturn(run func(context.Context, string, *model.PartsFilter, int) ([]*model.PartSearchHit, error)) *PartService_SearchParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePart provides a mock function with given fields: ctx, userUUID, partUUID, patch, paths
+func (_m *PartService) UpdatePart(ctx context.Context, userUUID string, partUUID string, patch *model.Part, paths []string) (*model.Part, error) {
+	ret := _m.Called(ctx, userUUID, partUUID, patch, paths)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePart")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *model.Part, []string) (*model.Part, error)); ok {
+		return rf(ctx, userUUID, partUUID, patch, paths)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *model.Part, []string) *model.Part); ok {
+		r0 = rf(ctx, userUUID, partUUID, patch, paths)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *model.Part, []string) error); ok {
+		r1 = rf(ctx, userUUID, partUUID, patch, paths)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_UpdatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePart'
+type PartService_UpdatePart_Call struct {
+	*mock.Call
+}
+
+// UpdatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - partUUID string
+//   - patch *model.Part
+//   - paths []string
+func (_e *PartService_Expecter) UpdatePart(ctx interface{}, userUUID interface{}, partUUID interface{}, patch interface{}, paths interface{}) *PartService_UpdatePart_Call {
+	return &PartService_UpdatePart_Call{Call: _e.mock.On("UpdatePart", ctx, userUUID, partUUID, patch, paths)}
 }
 
-func (_c *PartService_ListParts_Call) Run(run func(ctx context.Context, filter *model.PartsFilter)) *PartService_ListParts_Call {
+func (_c *PartService_UpdatePart_Call) Run(run func(ctx context.Context, userUUID string, partUUID string, patch *model.Part, paths []string)) *PartService_UpdatePart_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.PartsFilter))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*model.Part), args[4].([]string))
 	})
 	return _c
 }
 
-func (_c *PartService_ListParts_Call) Return(_a0 []*model.Part, _a1 error) *PartService_ListParts_Call {
+func (_c *PartService_UpdatePart_Call) Return(_a0 *model.Part, _a1 error) *PartService_UpdatePart_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *PartService_ListParts_Call) RunAndReturn(run func(context.Context, *model.PartsFilter) ([]*model.Part, error)) *PartService_ListParts_Call {
+func (_c *PartService_UpdatePart_Call) RunAndReturn(run func(context.Context, string, string, *model.Part, []string) (*model.Part, error)) *PartService_UpdatePart_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,296 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/ZanDattSu/star-factory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ReservationService is an autogenerated mock type for the ReservationService type
+type ReservationService struct {
+	mock.Mock
+}
+
+type ReservationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReservationService) EXPECT() *ReservationService_Expecter {
+	return &ReservationService_Expecter{mock: &_m.Mock}
+}
+
+// CommitReservation provides a mock function with given fields: ctx, uuid
+func (_m *ReservationService) CommitReservation(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReservationService_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type ReservationService_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *ReservationService_Expecter) CommitReservation(ctx interface{}, uuid interface{}) *ReservationService_CommitReservation_Call {
+	return &ReservationService_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, uuid)}
+}
+
+func (_c *ReservationService_CommitReservation_Call) Run(run func(ctx context.Context, uuid string)) *ReservationService_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ReservationService_CommitReservation_Call) Return(_a0 error) *ReservationService_CommitReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReservationService_CommitReservation_Call) RunAndReturn(run func(context.Context, string) error) *ReservationService_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseExpired provides a mock function with given fields: ctx
+func (_m *ReservationService) ReleaseExpired(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseExpired")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReservationService_ReleaseExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseExpired'
+type ReservationService_ReleaseExpired_Call struct {
+	*mock.Call
+}
+
+// ReleaseExpired is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ReservationService_Expecter) ReleaseExpired(ctx interface{}) *ReservationService_ReleaseExpired_Call {
+	return &ReservationService_ReleaseExpired_Call{Call: _e.mock.On("ReleaseExpired", ctx)}
+}
+
+func (_c *ReservationService_ReleaseExpired_Call) Run(run func(ctx context.Context)) *ReservationService_ReleaseExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ReservationService_ReleaseExpired_Call) Return(_a0 int, _a1 error) *ReservationService_ReleaseExpired_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReservationService_ReleaseExpired_Call) RunAndReturn(run func(context.Context) (int, error)) *ReservationService_ReleaseExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReservation provides a mock function with given fields: ctx, uuid
+func (_m *ReservationService) ReleaseReservation(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReservationService_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type ReservationService_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *ReservationService_Expecter) ReleaseReservation(ctx interface{}, uuid interface{}) *ReservationService_ReleaseReservation_Call {
+	return &ReservationService_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, uuid)}
+}
+
+func (_c *ReservationService_ReleaseReservation_Call) Run(run func(ctx context.Context, uuid string)) *ReservationService_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ReservationService_ReleaseReservation_Call) Return(_a0 error) *ReservationService_ReleaseReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReservationService_ReleaseReservation_Call) RunAndReturn(run func(context.Context, string) error) *ReservationService_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveParts provides a mock function with given fields: ctx, orderUUID, items, ttl
+func (_m *ReservationService) ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem, ttl time.Duration) (*model.Reservation, error) {
+	ret := _m.Called(ctx, orderUUID, items, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveParts")
+	}
+
+	var r0 *model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.ReservationItem, time.Duration) (*model.Reservation, error)); ok {
+		return rf(ctx, orderUUID, items, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.ReservationItem, time.Duration) *model.Reservation); ok {
+		r0 = rf(ctx, orderUUID, items, ttl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []model.ReservationItem, time.Duration) error); ok {
+		r1 = rf(ctx, orderUUID, items, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReservationService_ReserveParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveParts'
+type ReservationService_ReserveParts_Call struct {
+	*mock.Call
+}
+
+// ReserveParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - items []model.ReservationItem
+//   - ttl time.Duration
+func (_e *ReservationService_Expecter) ReserveParts(ctx interface{}, orderUUID interface{}, items interface{}, ttl interface{}) *ReservationService_ReserveParts_Call {
+	return &ReservationService_ReserveParts_Call{Call: _e.mock.On("ReserveParts", ctx, orderUUID, items, ttl)}
+}
+
+func (_c *ReservationService_ReserveParts_Call) Run(run func(ctx context.Context, orderUUID string, items []model.ReservationItem, ttl time.Duration)) *ReservationService_ReserveParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]model.ReservationItem), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ReservationService_ReserveParts_Call) Return(_a0 *model.Reservation, _a1 error) *ReservationService_ReserveParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReservationService_ReserveParts_Call) RunAndReturn(run func(context.Context, string, []model.ReservationItem, time.Duration) (*model.Reservation, error)) *ReservationService_ReserveParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunExpirySweeper provides a mock function with given fields: ctx
+func (_m *ReservationService) RunExpirySweeper(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunExpirySweeper")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReservationService_RunExpirySweeper_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunExpirySweeper'
+type ReservationService_RunExpirySweeper_Call struct {
+	*mock.Call
+}
+
+// RunExpirySweeper is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ReservationService_Expecter) RunExpirySweeper(ctx interface{}) *ReservationService_RunExpirySweeper_Call {
+	return &ReservationService_RunExpirySweeper_Call{Call: _e.mock.On("RunExpirySweeper", ctx)}
+}
+
+func (_c *ReservationService_RunExpirySweeper_Call) Run(run func(ctx context.Context)) *ReservationService_RunExpirySweeper_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ReservationService_RunExpirySweeper_Call) Return(_a0 error) *ReservationService_RunExpirySweeper_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReservationService_RunExpirySweeper_Call) RunAndReturn(run func(context.Context) error) *ReservationService_RunExpirySweeper_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReservationService creates a new instance of ReservationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReservationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReservationService {
+	mock := &ReservationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
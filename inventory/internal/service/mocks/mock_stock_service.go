@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/ZanDattSu/star-factory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StockService is an autogenerated mock type for the StockService type
+type StockService struct {
+	mock.Mock
+}
+
+type StockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StockService) EXPECT() *StockService_Expecter {
+	return &StockService_Expecter{mock: &_m.Mock}
+}
+
+// AdjustStock provides a mock function with given fields: ctx, userUUID, movement
+func (_m *StockService) AdjustStock(ctx context.Context, userUUID string, movement *model.StockMovement) (*model.StockMovement, error) {
+	ret := _m.Called(ctx, userUUID, movement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustStock")
+	}
+
+	var r0 *model.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.StockMovement) (*model.StockMovement, error)); ok {
+		return rf(ctx, userUUID, movement)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.StockMovement) *model.StockMovement); ok {
+		r0 = rf(ctx, userUUID, movement)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *model.StockMovement) error); ok {
+		r1 = rf(ctx, userUUID, movement)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockService_AdjustStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustStock'
+type StockService_AdjustStock_Call struct {
+	*mock.Call
+}
+
+// AdjustStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - movement *model.StockMovement
+func (_e *StockService_Expecter) AdjustStock(ctx interface{}, userUUID interface{}, movement interface{}) *StockService_AdjustStock_Call {
+	return &StockService_AdjustStock_Call{Call: _e.mock.On("AdjustStock", ctx, userUUID, movement)}
+}
+
+func (_c *StockService_AdjustStock_Call) Run(run func(ctx context.Context, userUUID string, movement *model.StockMovement)) *StockService_AdjustStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*model.StockMovement))
+	})
+	return _c
+}
+
+func (_c *StockService_AdjustStock_Call) Return(_a0 *model.StockMovement, _a1 error) *StockService_AdjustStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockService_AdjustStock_Call) RunAndReturn(run func(context.Context, string, *model.StockMovement) (*model.StockMovement, error)) *StockService_AdjustStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStockMovements provides a mock function with given fields: ctx, userUUID, partUUID, page
+func (_m *StockService) ListStockMovements(ctx context.Context, userUUID string, partUUID string, page model.StockMovementsPageRequest) (*model.StockMovementsList, error) {
+	ret := _m.Called(ctx, userUUID, partUUID, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStockMovements")
+	}
+
+	var r0 *model.StockMovementsList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.StockMovementsPageRequest) (*model.StockMovementsList, error)); ok {
+		return rf(ctx, userUUID, partUUID, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.StockMovementsPageRequest) *model.StockMovementsList); ok {
+		r0 = rf(ctx, userUUID, partUUID, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockMovementsList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.StockMovementsPageRequest) error); ok {
+		r1 = rf(ctx, userUUID, partUUID, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockService_ListStockMovements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStockMovements'
+type StockService_ListStockMovements_Call struct {
+	*mock.Call
+}
+
+// ListStockMovements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - partUUID string
+//   - page model.StockMovementsPageRequest
+func (_e *StockService_Expecter) ListStockMovements(ctx interface{}, userUUID interface{}, partUUID interface{}, page interface{}) *StockService_ListStockMovements_Call {
+	return &StockService_ListStockMovements_Call{Call: _e.mock.On("ListStockMovements", ctx, userUUID, partUUID, page)}
+}
+
+func (_c *StockService_ListStockMovements_Call) Run(run func(ctx context.Context, userUUID string, partUUID string, page model.StockMovementsPageRequest)) *StockService_ListStockMovements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.StockMovementsPageRequest))
+	})
+	return _c
+}
+
+func (_c *StockService_ListStockMovements_Call) Return(_a0 *model.StockMovementsList, _a1 error) *StockService_ListStockMovements_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockService_ListStockMovements_Call) RunAndReturn(run func(context.Context, string, string, model.StockMovementsPageRequest) (*model.StockMovementsList, error)) *StockService_ListStockMovements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStockService creates a new instance of StockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StockService {
+	mock := &StockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,169 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+// Поля детали, которые можно менять через UpdatePart. Имена совпадают с полями детали в хранилище.
+// Остаток меняется только резервами и AdjustStock, поэтому stock_quantity в маске недопустим.
+var updatablePaths = []string{
+	"name",
+	"description",
+	"price",
+	"category",
+	"dimensions",
+	"manufacturer",
+	"tags",
+	"metadata",
+	"discontinued",
+}
+
+// updatedAtField поле с датой изменения детали, которое пишется вместе с любой правкой.
+const updatedAtField = "updated_at"
+
+// CreatePart добавляет деталь в каталог с новым UUID.
+func (s *service) CreatePart(ctx context.Context, userUUID string, part *model.Part) (*model.Part, error) {
+	if err := s.checkAdmin(ctx, userUUID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	part.Uuid = uuid.NewString()
+	part.CreatedAt = now
+	part.UpdatedAt = now
+
+	if err := s.repository.PutPart(ctx, part.Uuid, part); err != nil {
+		return nil, fmt.Errorf("error creating part: %w", err)
+	}
+
+	logger.Info(ctx, "Part created",
+		zap.String("part_uuid", part.Uuid),
+		zap.String("user_uuid", userUUID),
+	)
+
+	return part, nil
+}
+
+// UpdatePart меняет у детали поля из paths, беря значения из patch.
+// В хранилище пишутся только эти поля, так что параллельные правки других полей не теряются.
+func (s *service) UpdatePart(ctx context.Context, userUUID, partUUID string, patch *model.Part, paths []string) (*model.Part, error) {
+	if err := s.checkAdmin(ctx, userUUID); err != nil {
+		return nil, err
+	}
+
+	if len(paths) == 0 {
+		return nil, &model.InvalidPartUpdateError{Reason: "update mask is empty"}
+	}
+
+	for _, path := range paths {
+		if !slices.Contains(updatablePaths, path) {
+			return nil, &model.InvalidPartUpdateError{Reason: fmt.Sprintf("field %q cannot be updated", path)}
+		}
+	}
+	patch.UpdatedAt = time.Now()
+
+	part, err := s.repository.UpdatePartFields(ctx, partUUID, patch, append(slices.Clone(paths), updatedAtField))
+	if err != nil {
+		var notFound *model.PartNotFoundError
+		if errors.As(err, &notFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error updating part: %w", err)
+	}
+
+	logger.Info(ctx, "Part updated",
+		zap.String("part_uuid", part.Uuid),
+		zap.Strings("paths", paths),
+		zap.String("user_uuid", userUUID),
+	)
+
+	return part, nil
+}
+
+// DeletePart снимает деталь с продажи. Деталь остаётся в каталоге, её можно вернуть через UpdatePart.
+// Повторный вызов ничего не меняет.
+func (s *service) DeletePart(ctx context.Context, userUUID, partUUID string) error {
+	if err := s.checkAdmin(ctx, userUUID); err != nil {
+		return err
+	}
+
+	part, err := s.GetPart(ctx, partUUID)
+	if err != nil {
+		return err
+	}
+
+	if part.Discontinued {
+		return nil
+	}
+
+	patch := &model.Part{Discontinued: true, UpdatedAt: time.Now()}
+	if _, err = s.repository.UpdatePartFields(ctx, part.Uuid, patch, []string{"discontinued", updatedAtField}); err != nil {
+		return fmt.Errorf("error discontinuing part: %w", err)
+	}
+
+	logger.Info(ctx, "Part discontinued",
+		zap.String("part_uuid", part.Uuid),
+		zap.String("user_uuid", userUUID),
+	)
+
+	return nil
+}
+
+// BatchUpsertParts создаёт новые детали и заменяет существующие. У существующих деталей
+// остаток и дата создания не меняются.
+func (s *service) BatchUpsertParts(ctx context.Context, userUUID string, parts []*model.Part) ([]*model.Part, error) {
+	if err := s.checkAdmin(ctx, userUUID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	uuids := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if slices.Contains(uuids, part.Uuid) {
+			return nil, &model.InvalidPartUpdateError{Reason: fmt.Sprintf("part %s is listed more than once", part.Uuid)}
+		}
+		uuids = append(uuids, part.Uuid)
+
+		part.CreatedAt = now
+		part.UpdatedAt = now
+	}
+
+	if err := s.repository.UpsertParts(ctx, parts); err != nil {
+		return nil, fmt.Errorf("error upserting parts: %w", err)
+	}
+
+	logger.Info(ctx, "Parts upserted",
+		zap.Int("parts_count", len(parts)),
+		zap.String("user_uuid", userUUID),
+	)
+
+	// Перечитываем детали, чтобы вернуть сохранённые остатки и даты создания
+	stored, err := s.repository.ListParts(ctx,
+		&model.PartsFilter{Uuids: uuids, IncludeDiscontinued: true},
+		model.PartsPage{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error reading upserted parts: %w", err)
+	}
+
+	return stored, nil
+}
+
+// checkAdmin проверяет, что пользователь из сессии может управлять каталогом.
+func (s *service) checkAdmin(ctx context.Context, userUUID string) error {
+	if s.admins.Allow(ctx, userUUID, "catalog management") {
+		return nil
+	}
+
+	return &model.ForbiddenError{Message: "catalog can be managed by administrators only"}
+}
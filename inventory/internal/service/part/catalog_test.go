@@ -0,0 +1,132 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+func (s *SuiteService) TestCreatePartAssignsUUID() {
+	part := RandomPart()
+	part.Uuid = ""
+
+	s.partRepository.
+		On("PutPart", s.ctx, mock.AnythingOfType("string"), mock.MatchedBy(func(p *model.Part) bool {
+			return p.Uuid != "" && !p.CreatedAt.IsZero() && p.Name == part.Name
+		})).
+		Return(nil).
+		Once()
+
+	created, err := s.service.CreatePart(s.ctx, adminUUID, part)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(created.Uuid)
+}
+
+func (s *SuiteService) TestCatalogRequiresAdmin() {
+	userUUID := gofakeit.UUID()
+
+	_, err := s.service.CreatePart(s.ctx, userUUID, RandomPart())
+	var forbidden *model.ForbiddenError
+	s.Require().ErrorAs(err, &forbidden)
+
+	_, err = s.service.UpdatePart(s.ctx, userUUID, gofakeit.UUID(), &model.Part{}, []string{"name"})
+	s.Require().ErrorAs(err, &forbidden)
+
+	err = s.service.DeletePart(s.ctx, userUUID, gofakeit.UUID())
+	s.Require().ErrorAs(err, &forbidden)
+
+	_, err = s.service.BatchUpsertParts(s.ctx, userUUID, []*model.Part{RandomPart()})
+	s.Require().ErrorAs(err, &forbidden)
+}
+
+func (s *SuiteService) TestUpdatePartAppliesOnlyMaskedFields() {
+	part := RandomPart()
+	name := part.Name
+	patch := &model.Part{
+		Name:  "New name",
+		Price: money.New(4200, money.DefaultCurrency),
+	}
+
+	stored := *part
+	stored.Price = patch.Price
+
+	s.partRepository.
+		On("UpdatePartFields", s.ctx, part.Uuid, patch, []string{"price", "updated_at"}).
+		Return(&stored, nil).
+		Once()
+
+	updated, err := s.service.UpdatePart(s.ctx, adminUUID, part.Uuid, patch, []string{"price"})
+	s.Require().NoError(err)
+	s.Require().Equal(patch.Price, updated.Price)
+	s.Require().Equal(name, updated.Name)
+}
+
+func (s *SuiteService) TestUpdatePartRejectsStockQuantity() {
+	part := RandomPart()
+
+	_, err := s.service.UpdatePart(s.ctx, adminUUID, part.Uuid, &model.Part{StockQuantity: 100}, []string{"stock_quantity"})
+
+	var invalid *model.InvalidPartUpdateError
+	s.Require().ErrorAs(err, &invalid)
+	s.partRepository.AssertNotCalled(s.T(), "UpdatePartFields", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestUpdatePartNotFound() {
+	partUUID := gofakeit.UUID()
+
+	s.partRepository.
+		On("UpdatePartFields", s.ctx, partUUID, mock.Anything, []string{"name", "updated_at"}).
+		Return(nil, &model.PartNotFoundError{PartUUID: partUUID}).
+		Once()
+
+	_, err := s.service.UpdatePart(s.ctx, adminUUID, partUUID, &model.Part{Name: "New name"}, []string{"name"})
+
+	var notFound *model.PartNotFoundError
+	s.Require().ErrorAs(err, &notFound)
+}
+
+func (s *SuiteService) TestDeletePartDiscontinues() {
+	part := RandomPart()
+
+	s.partRepository.On("GetPart", s.ctx, part.Uuid).Return(part, nil).Twice()
+	s.partRepository.
+		On("UpdatePartFields", s.ctx, part.Uuid, mock.MatchedBy(func(p *model.Part) bool { return p.Discontinued }),
+			[]string{"discontinued", "updated_at"}).
+		Run(func(mock.Arguments) { part.Discontinued = true }).
+		Return(part, nil).
+		Once()
+
+	s.Require().NoError(s.service.DeletePart(s.ctx, adminUUID, part.Uuid))
+
+	// Повторное снятие с продажи ничего не пишет
+	s.Require().NoError(s.service.DeletePart(s.ctx, adminUUID, part.Uuid))
+}
+
+func (s *SuiteService) TestBatchUpsertPartsRejectsDuplicates() {
+	part := RandomPart()
+
+	_, err := s.service.BatchUpsertParts(s.ctx, adminUUID, []*model.Part{part, part})
+
+	var invalid *model.InvalidPartUpdateError
+	s.Require().ErrorAs(err, &invalid)
+}
+
+func (s *SuiteService) TestBatchUpsertPartsReturnsStoredParts() {
+	parts := []*model.Part{RandomPart(), RandomPart()}
+	stored := []*model.Part{RandomPart(), RandomPart()}
+
+	s.partRepository.On("UpsertParts", s.ctx, parts).Return(nil).Once()
+	s.partRepository.
+		On("ListParts", s.ctx, &model.PartsFilter{
+			Uuids:               []string{parts[0].Uuid, parts[1].Uuid},
+			IncludeDiscontinued: true,
+		}, model.PartsPage{}).
+		Return(stored, nil).
+		Once()
+
+	result, err := s.service.BatchUpsertParts(s.ctx, adminUUID, parts)
+	s.Require().NoError(err)
+	s.Require().Equal(stored, result)
+}
@@ -0,0 +1,120 @@
+package part
+
+import (
+	"html"
+	"strings"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+)
+
+const (
+	highlightOpen  = "<em>"
+	highlightClose = "</em>"
+
+	// snippetRunes длина фрагмента длинного поля вокруг первого совпадения
+	snippetRunes = 160
+	// snippetLead сколько символов оставить перед первым совпадением
+	snippetLead = 40
+)
+
+// highlightPart возвращает фрагменты полей детали, в которых встретились слова запроса.
+func highlightPart(part *model.Part, terms []string) []model.SearchHighlight {
+	termSet := make(map[string]struct{}, len(terms))
+	for _, term := range terms {
+		termSet[term] = struct{}{}
+	}
+
+	var highlights []model.SearchHighlight
+	add := func(field, text string) {
+		if snippet, ok := highlight(text, termSet); ok {
+			highlights = append(highlights, model.SearchHighlight{Field: field, Snippet: snippet})
+		}
+	}
+
+	add(model.SearchFieldName, part.Name)
+	add(model.SearchFieldDescription, part.Description)
+	for _, tag := range part.Tags {
+		add(model.SearchFieldTags, tag)
+	}
+	if part.Manufacturer != nil {
+		add(model.SearchFieldManufacturer, part.Manufacturer.Name)
+	}
+
+	return highlights
+}
+
+// highlight оборачивает совпавшие слова текста в <em></em>. Длинный текст обрезается
+// до фрагмента вокруг первого совпадения. Сам текст экранируется, чтобы разметка из названия
+// или описания не попала к клиенту живым HTML. Второй результат false, если совпадений нет.
+func highlight(text string, terms map[string]struct{}) (string, bool) {
+	runes := []rune(text)
+
+	var (
+		b     strings.Builder
+		first = -1
+		from  = 0
+	)
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && model.IsWordRune(runes[i]) {
+			continue
+		}
+		if word := runes[from:i]; len(word) > 0 {
+			if _, ok := terms[strings.ToLower(string(word))]; ok && first < 0 {
+				first = from
+			}
+		}
+		from = i + 1
+	}
+	if first < 0 {
+		return "", false
+	}
+
+	start, end := 0, len(runes)
+	if len(runes) > snippetRunes {
+		start = max(0, first-snippetLead)
+		end = min(len(runes), start+snippetRunes)
+		start = wordStart(runes, start)
+		end = wordEnd(runes, end)
+	}
+
+	if start > 0 {
+		b.WriteString("…")
+	}
+	from = start
+	for i := start; i <= end; i++ {
+		if i < end && model.IsWordRune(runes[i]) {
+			continue
+		}
+		word := string(runes[from:i])
+		if _, ok := terms[strings.ToLower(word)]; ok {
+			b.WriteString(highlightOpen + html.EscapeString(word) + highlightClose)
+		} else {
+			b.WriteString(html.EscapeString(word))
+		}
+		if i < end {
+			b.WriteString(html.EscapeString(string(runes[i])))
+		}
+		from = i + 1
+	}
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+
+	return b.String(), true
+}
+
+// wordStart сдвигает начало фрагмента вперёд, чтобы не резать слово.
+func wordStart(runes []rune, i int) int {
+	for i > 0 && i < len(runes) && model.IsWordRune(runes[i-1]) {
+		i++
+	}
+	return i
+}
+
+// wordEnd сдвигает конец фрагмента назад, чтобы не резать слово.
+func wordEnd(runes []rune, i int) int {
+	for i < len(runes) && i > 0 && model.IsWordRune(runes[i]) {
+		i--
+	}
+	return i
+}
@@ -10,8 +10,8 @@ import (
 	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
 )
 
-// ListParts возвращает отфильтрованный список деталей.
-func (s *service) ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error) {
+// ListParts возвращает страницу отфильтрованного списка деталей. Фильтрация и сортировка выполняются в хранилище.
+func (s *service) ListParts(ctx context.Context, filter *model.PartsFilter, page model.PartsPageRequest) (*model.PartsList, error) {
 	var filterFields []zap.Field
 	filterFields = append(filterFields, zap.Bool("filter_empty", filterIsEmpty(filter)))
 	if filter != nil {
@@ -31,97 +31,56 @@ func (s *service) ListParts(ctx context.Context, filter *model.PartsFilter) ([]*
 			zap.Int("filter_tags_count", 0),
 		)
 	}
+	filterFields = append(filterFields,
+		zap.Int("page_size", page.PageSize),
+		zap.Bool("has_page_token", page.PageToken != ""),
+		zap.String("order_by", string(page.OrderBy.Field)),
+		zap.Bool("order_desc", page.OrderBy.Desc),
+	)
 	logger.Debug(ctx, "Listing parts", filterFields...)
 
-	parts, err := s.repository.ListParts(ctx)
+	repoPage := model.PartsPage{OrderBy: page.OrderBy}
+	if page.PageToken != "" {
+		cursor, err := model.DecodePartsCursor(page.PageToken, page.OrderBy)
+		if err != nil {
+			return nil, err
+		}
+		repoPage.After = cursor
+	}
+	// Запрашиваем на одну деталь больше, чтобы узнать, есть ли следующая страница
+	if page.PageSize > 0 {
+		repoPage.Limit = page.PageSize + 1
+	}
+
+	parts, err := s.repository.ListParts(ctx, filter, repoPage)
 	if err != nil {
 		logger.Error(ctx, "Failed to list parts from repository",
 			zap.Error(err),
 		)
-		return []*model.Part{}, fmt.Errorf("error listing parts: %w", err)
+		return nil, fmt.Errorf("error listing parts: %w", err)
 	}
 
-	logger.Debug(ctx, "Parts retrieved from repository",
-		zap.Int("total_parts", len(parts)),
-	)
-
-	if filterIsEmpty(filter) {
-		logger.Debug(ctx, "Filter is empty, returning all parts",
-			zap.Int("parts_count", len(parts)),
+	total, err := s.repository.CountParts(ctx, filter)
+	if err != nil {
+		logger.Error(ctx, "Failed to count parts in repository",
+			zap.Error(err),
 		)
-		return parts, nil
+		return nil, fmt.Errorf("error counting parts: %w", err)
 	}
 
-	// Создаём set'ы для O(1) проверки
-	uuidSet := toSet(filter.Uuids)
-	nameSet := toSet(filter.Names)
-	countrySet := toSet(filter.ManufacturerCountries)
-	tagSet := toSet(filter.Tags)
-	categorySet := toSet(filter.Categories)
-
-	filteredParts := make([]*model.Part, 0, len(parts))
-	for _, part := range parts {
-		if matchesPart(part, filter, uuidSet, nameSet, countrySet, tagSet, categorySet) {
-			filteredParts = append(filteredParts, part)
-		}
+	list := &model.PartsList{Parts: parts, TotalCount: total}
+	if page.PageSize > 0 && len(parts) > page.PageSize {
+		list.Parts = parts[:page.PageSize]
+		list.NextPageToken = model.NewPartsCursor(list.Parts[page.PageSize-1], page.OrderBy).Encode()
 	}
 
-	logger.Info(ctx, "Parts filtered successfully",
-		zap.Int("total_parts", len(parts)),
-		zap.Int("filtered_parts", len(filteredParts)),
+	logger.Debug(ctx, "Parts retrieved from repository",
+		zap.Int("parts_count", len(list.Parts)),
+		zap.Int64("total_count", total),
+		zap.Bool("has_next_page", list.NextPageToken != ""),
 	)
 
-	return filteredParts, nil
-}
-
-// matchesPart проверяет, соответствует ли деталь всем критериям фильтра
-// Логика: AND между полями фильтра, OR внутри каждого поля
-//
-// В отличие от реализации через slices.Contains (O(n²)),
-// использует внутренний set на основе map для поиска за O(1),
-// что обеспечивает общую сложность O(n + m).
-//
-// n — количество деталей, m — количество элементов фильтра.
-func matchesPart(
-	part *model.Part,
-	filter *model.PartsFilter,
-	uuidSet, nameSet, countrySet, tagSet map[string]struct{},
-	categorySet map[model.Category]struct{},
-) bool {
-	if len(filter.Uuids) > 0 {
-		if _, found := uuidSet[part.Uuid]; !found {
-			return false
-		}
-	}
-
-	if len(filter.Names) > 0 {
-		if _, found := nameSet[part.Name]; !found {
-			return false
-		}
-	}
-
-	if len(filter.Categories) > 0 {
-		if _, found := categorySet[part.Category]; !found {
-			return false
-		}
-	}
-
-	if len(filter.ManufacturerCountries) > 0 {
-		if part.Manufacturer == nil {
-			return false
-		}
-		if _, found := countrySet[part.Manufacturer.Country]; !found {
-			return false
-		}
-	}
-
-	if len(filter.Tags) > 0 {
-		if !hasAnyTag(tagSet, part.Tags) {
-			return false
-		}
-	}
-
-	return true
+	return list, nil
 }
 
 // filterIsEmpty проверяет, пустой ли фильтр.
@@ -135,22 +94,3 @@ func filterIsEmpty(f *model.PartsFilter) bool {
 		len(f.ManufacturerCountries) == 0 &&
 		len(f.Tags) == 0
 }
-
-// hasAnyTag проверяет наличие хотя бы одного тега из set'а.
-func hasAnyTag(tagSet map[string]struct{}, partTags []string) bool {
-	for _, tag := range partTags {
-		if _, exists := tagSet[tag]; exists {
-			return true
-		}
-	}
-	return false
-}
-
-// toSet преобразует slice в set для O(1) поиска.
-func toSet[T comparable](values []T) map[T]struct{} {
-	set := make(map[T]struct{}, len(values))
-	for _, v := range values {
-		set[v] = struct{}{}
-	}
-	return set
-}
@@ -1,173 +1,104 @@
 package part
 
 import (
+	"errors"
+
+	"github.com/stretchr/testify/mock"
+
 	"github.com/ZanDattSu/star-factory/inventory/internal/model"
 )
 
-func (s *SuiteService) TestListPartsAllCases() {
-	parts := []*model.Part{
-		{
-			Uuid:         "uuid-1",
-			Name:         "Engine",
-			Category:     model.CategoryEngine,
-			Tags:         []string{"heavy", "metal"},
-			Manufacturer: &model.Manufacturer{Name: "SpaceX", Country: "USA"},
-		},
-		{
-			Uuid:         "uuid-2",
-			Name:         "Wing",
-			Category:     model.CategoryWing,
-			Tags:         []string{"light", "composite"},
-			Manufacturer: &model.Manufacturer{Name: "Airbus", Country: "France"},
-		},
-		{
-			Uuid:         "uuid-3",
-			Name:         "Fuel Pump",
-			Category:     model.CategoryFuel,
-			Tags:         []string{"liquid"},
-			Manufacturer: &model.Manufacturer{Name: "SpaceX", Country: "USA"},
-		},
-		{
-			Uuid:         "uuid-4",
-			Name:         "Window",
-			Category:     model.CategoryPorthole,
-			Tags:         nil,
-			Manufacturer: nil,
-		},
+func (s *SuiteService) TestListPartsPassesFilterToRepository() {
+	filter := &model.PartsFilter{
+		Categories:            []model.Category{model.CategoryFuel},
+		ManufacturerCountries: []string{"USA"},
 	}
+	parts := []*model.Part{RandomPart()}
 
 	s.partRepository.
-		On("ListParts", s.ctx).
-		Return(parts, nil)
-
-	tests := []struct {
-		name     string
-		filter   *model.PartsFilter
-		expected []string
-	}{
-		{
-			name:     "nil filter returns all",
-			filter:   nil,
-			expected: []string{"uuid-1", "uuid-2", "uuid-3", "uuid-4"},
-		},
-		{
-			name:     "empty filter returns all",
-			filter:   &model.PartsFilter{},
-			expected: []string{"uuid-1", "uuid-2", "uuid-3", "uuid-4"},
-		},
-		{
-			name: "filter by uuid",
-			filter: &model.PartsFilter{
-				Uuids: []string{"uuid-2"},
-			},
-			expected: []string{"uuid-2"},
-		},
-		{
-			name: "filter by name single",
-			filter: &model.PartsFilter{
-				Names: []string{"Engine"},
-			},
-			expected: []string{"uuid-1"},
-		},
-		{
-			name: "filter by name multiple (OR logic)",
-			filter: &model.PartsFilter{
-				Names: []string{"Engine", "Fuel Pump"},
-			},
-			expected: []string{"uuid-1", "uuid-3"},
-		},
-		{
-			name: "filter by category single",
-			filter: &model.PartsFilter{
-				Categories: []model.Category{model.CategoryFuel},
-			},
-			expected: []string{"uuid-3"},
-		},
-		{
-			name: "filter by category multiple (OR logic)",
-			filter: &model.PartsFilter{
-				Categories: []model.Category{model.CategoryWing, model.CategoryPorthole},
-			},
-			expected: []string{"uuid-2", "uuid-4"},
-		},
-		{
-			name: "filter by manufacturer country",
-			filter: &model.PartsFilter{
-				ManufacturerCountries: []string{"USA"},
-			},
-			expected: []string{"uuid-1", "uuid-3"},
-		},
-		{
-			name: "filter by manufacturer with nil manufacturers present",
-			filter: &model.PartsFilter{
-				ManufacturerCountries: []string{"France"},
-			},
-			expected: []string{"uuid-2"}, // не падает на uuid-4
-		},
-		{
-			name: "filter by tags single",
-			filter: &model.PartsFilter{
-				Tags: []string{"light"},
-			},
-			expected: []string{"uuid-2"},
-		},
-		{
-			name: "filter by multiple tags (OR logic)",
-			filter: &model.PartsFilter{
-				Tags: []string{"metal", "liquid"},
-			},
-			expected: []string{"uuid-1", "uuid-3"},
-		},
-		{
-			name: "filter with multiple fields (AND logic across fields)",
-			filter: &model.PartsFilter{
-				Categories:            []model.Category{model.CategoryFuel},
-				ManufacturerCountries: []string{"USA"},
-			},
-			expected: []string{"uuid-3"},
-		},
-		{
-			name: "filter with non-overlapping fields (returns empty)",
-			filter: &model.PartsFilter{
-				Categories:            []model.Category{model.CategoryFuel},
-				ManufacturerCountries: []string{"France"},
-			},
-			expected: []string{},
-		},
-		{
-			name: "filter with empty values inside fields (ignored safely)",
-			filter: &model.PartsFilter{
-				Uuids:      []string{},
-				Categories: []model.Category{},
-				Tags:       []string{},
-			},
-			expected: []string{"uuid-1", "uuid-2", "uuid-3", "uuid-4"},
-		},
-	}
-
-	for _, tc := range tests {
-		s.Run(tc.name, func() {
-			result, err := s.service.ListParts(s.ctx, tc.filter)
-			s.Require().NoError(err)
-			got := make([]string, 0, len(result))
-			for _, p := range result {
-				got = append(got, p.Uuid)
-			}
-			s.ElementsMatch(tc.expected, got)
-		})
-	}
+		On("ListParts", s.ctx, filter, model.PartsPage{}).
+		Return(parts, nil).
+		Once()
+	s.partRepository.
+		On("CountParts", s.ctx, filter).
+		Return(int64(1), nil).
+		Once()
 
-	s.partRepository.AssertExpectations(s.T())
+	result, err := s.service.ListParts(s.ctx, filter, model.PartsPageRequest{})
+	s.Require().NoError(err)
+	s.Equal(parts, result.Parts)
+	s.Equal(int64(1), result.TotalCount)
+	s.Empty(result.NextPageToken)
 }
 
-func (s *SuiteService) TestListPartsNoParts() {
+func (s *SuiteService) TestListPartsNextPageToken() {
+	orderBy := model.PartsOrderBy{Field: model.PartsOrderFieldName}
+	parts := []*model.Part{RandomPart(), RandomPart(), RandomPart()}
+
+	s.partRepository.
+		On("ListParts", s.ctx, (*model.PartsFilter)(nil), model.PartsPage{Limit: 3, OrderBy: orderBy}).
+		Return(parts, nil).
+		Once()
 	s.partRepository.
-		On("ListParts", s.ctx).
-		Return([]*model.Part{}, nil).
+		On("CountParts", s.ctx, (*model.PartsFilter)(nil)).
+		Return(int64(7), nil).
 		Once()
 
-	result, err := s.service.ListParts(s.ctx, nil)
+	result, err := s.service.ListParts(s.ctx, nil, model.PartsPageRequest{PageSize: 2, OrderBy: orderBy})
+	s.Require().NoError(err)
+	s.Require().Len(result.Parts, 2)
+	s.Equal(int64(7), result.TotalCount)
+
+	cursor, err := model.DecodePartsCursor(result.NextPageToken, orderBy)
 	s.Require().NoError(err)
-	s.Empty(result)
-	s.partRepository.AssertExpectations(s.T())
+	s.Equal(parts[1].Uuid, cursor.Uuid)
+	s.Equal(parts[1].Name, cursor.Name)
+
+	// Следующая страница начинается после последней отданной детали
+	s.partRepository.
+		On("ListParts", s.ctx, (*model.PartsFilter)(nil), mock.MatchedBy(func(page model.PartsPage) bool {
+			return page.After != nil && *page.After == *cursor
+		})).
+		Return(parts[2:], nil).
+		Once()
+	s.partRepository.
+		On("CountParts", s.ctx, (*model.PartsFilter)(nil)).
+		Return(int64(7), nil).
+		Once()
+
+	result, err = s.service.ListParts(s.ctx, nil, model.PartsPageRequest{PageSize: 2, PageToken: result.NextPageToken, OrderBy: orderBy})
+	s.Require().NoError(err)
+	s.Require().Len(result.Parts, 1)
+	s.Empty(result.NextPageToken)
+}
+
+func (s *SuiteService) TestListPartsTokenForOtherOrder() {
+	token := model.NewPartsCursor(RandomPart(), model.PartsOrderBy{Field: model.PartsOrderFieldPrice}).Encode()
+
+	_, err := s.service.ListParts(s.ctx, nil, model.PartsPageRequest{
+		PageSize:  10,
+		PageToken: token,
+		OrderBy:   model.PartsOrderBy{Field: model.PartsOrderFieldPrice, Desc: true},
+	})
+
+	var tokenErr *model.InvalidPageTokenError
+	s.Require().ErrorAs(err, &tokenErr)
+}
+
+func (s *SuiteService) TestListPartsMalformedToken() {
+	_, err := s.service.ListParts(s.ctx, nil, model.PartsPageRequest{PageToken: "not a token"})
+
+	var tokenErr *model.InvalidPageTokenError
+	s.Require().ErrorAs(err, &tokenErr)
+}
+
+func (s *SuiteService) TestListPartsRepositoryError() {
+	s.partRepository.
+		On("ListParts", s.ctx, (*model.PartsFilter)(nil), model.PartsPage{}).
+		Return(nil, errors.New("connection refused")).
+		Once()
+
+	result, err := s.service.ListParts(s.ctx, nil, model.PartsPageRequest{})
+	s.Require().Error(err)
+	s.Nil(result)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/brianvoe/gofakeit/v7"
 
 	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 )
 
 // RandomPartsFilter возвращает реалистичный фильтр для тестов ListParts.
@@ -45,7 +46,7 @@ func RandomPart() *model.Part {
 		Uuid:          gofakeit.UUID(),
 		Name:          gofakeit.ProductName(),
 		Description:   gofakeit.ProductDescription(),
-		Price:         gofakeit.Price(0, 10000),
+		Price:         money.FromMajor(gofakeit.Price(0, 10000), money.DefaultCurrency),
 		StockQuantity: int64(gofakeit.IntN(100)),
 		Category:      RandomCategory(),
 		Dimensions:    RandomDimensions(),
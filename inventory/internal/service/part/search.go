@@ -0,0 +1,51 @@
+package part
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+const defaultSearchLimit = 20
+
+// SearchParts ищет детали по ключевым словам и подсвечивает совпадения в найденных полях.
+func (s *service) SearchParts(ctx context.Context, query string, filter *model.PartsFilter, limit int) ([]*model.PartSearchHit, error) {
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	logger.Debug(ctx, "Searching parts",
+		zap.String("query", query),
+		zap.Bool("filter_empty", filterIsEmpty(filter)),
+		zap.Int("limit", limit),
+	)
+
+	terms := model.SearchTerms(query)
+	if len(terms) == 0 {
+		return []*model.PartSearchHit{}, nil
+	}
+
+	hits, err := s.repository.SearchParts(ctx, query, filter, limit)
+	if err != nil {
+		logger.Error(ctx, "Failed to search parts in repository",
+			zap.String("query", query),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("error searching parts: %w", err)
+	}
+
+	for _, hit := range hits {
+		hit.Highlights = highlightPart(hit.Part, terms)
+	}
+
+	logger.Debug(ctx, "Parts found",
+		zap.String("query", query),
+		zap.Int("hits_count", len(hits)),
+	)
+
+	return hits, nil
+}
@@ -0,0 +1,85 @@
+package part
+
+import (
+	"strings"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+)
+
+func (s *SuiteService) TestSearchPartsHighlightsMatches() {
+	filter := &model.PartsFilter{Categories: []model.Category{model.CategoryEngine}}
+	part := &model.Part{
+		Uuid:         "uuid-1",
+		Name:         "Ion Engine",
+		Description:  "Quiet engine, long range",
+		Tags:         []string{"ion", "heavy"},
+		Manufacturer: &model.Manufacturer{Name: "Orbital"},
+	}
+
+	s.partRepository.
+		On("SearchParts", s.ctx, "ion engine", filter, defaultSearchLimit).
+		Return([]*model.PartSearchHit{{Part: part, Score: 25}}, nil).
+		Once()
+
+	hits, err := s.service.SearchParts(s.ctx, "ion engine", filter, 0)
+	s.Require().NoError(err)
+	s.Require().Len(hits, 1)
+	s.Require().Equal([]model.SearchHighlight{
+		{Field: model.SearchFieldName, Snippet: "<em>Ion</em> <em>Engine</em>"},
+		{Field: model.SearchFieldDescription, Snippet: "Quiet <em>engine</em>, long range"},
+		{Field: model.SearchFieldTags, Snippet: "<em>ion</em>"},
+	}, hits[0].Highlights)
+}
+
+func (s *SuiteService) TestSearchPartsTrimsLongDescription() {
+	part := &model.Part{
+		Uuid:        "uuid-1",
+		Name:        "Wing",
+		Description: strings.Repeat("lorem ipsum ", 20) + "carbon" + strings.Repeat(" dolor sit", 20),
+	}
+
+	s.partRepository.
+		On("SearchParts", s.ctx, "carbon", (*model.PartsFilter)(nil), 5).
+		Return([]*model.PartSearchHit{{Part: part, Score: 1}}, nil).
+		Once()
+
+	hits, err := s.service.SearchParts(s.ctx, "carbon", nil, 5)
+	s.Require().NoError(err)
+	s.Require().Len(hits[0].Highlights, 1)
+
+	snippet := hits[0].Highlights[0].Snippet
+	s.Require().True(strings.HasPrefix(snippet, "…"))
+	s.Require().True(strings.HasSuffix(snippet, "…"))
+	s.Require().Contains(snippet, "<em>carbon</em>")
+	s.Require().Less(len([]rune(snippet)), len([]rune(part.Description)))
+}
+
+func (s *SuiteService) TestSearchPartsEscapesMarkup() {
+	part := &model.Part{
+		Uuid: "uuid-1",
+		Name: `Engine <script>alert("x")</script> & Co`,
+	}
+
+	s.partRepository.
+		On("SearchParts", s.ctx, "engine", (*model.PartsFilter)(nil), defaultSearchLimit).
+		Return([]*model.PartSearchHit{{Part: part, Score: 1}}, nil).
+		Once()
+
+	hits, err := s.service.SearchParts(s.ctx, "engine", nil, 0)
+	s.Require().NoError(err)
+	s.Require().Len(hits[0].Highlights, 1)
+	s.Require().Equal(
+		"<em>Engine</em> &lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt; &amp; Co",
+		hits[0].Highlights[0].Snippet,
+	)
+}
+
+func (s *SuiteService) TestSearchPartsQueryWithoutWords() {
+	hits, err := s.service.SearchParts(s.ctx, " ?! ", nil, 0)
+	s.Require().NoError(err)
+	s.Require().Empty(hits)
+
+	s.partRepository.AssertNotCalled(s.T(), "SearchParts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -3,6 +3,7 @@ package part
 import (
 	"github.com/ZanDattSu/star-factory/inventory/internal/repository"
 	srvc "github.com/ZanDattSu/star-factory/inventory/internal/service"
+	"github.com/ZanDattSu/star-factory/platform/pkg/admin"
 )
 
 // Компиляторная проверка: убеждаемся, что *service реализует интерфейс PartService.
@@ -10,10 +11,13 @@ var _ srvc.PartService = (*service)(nil)
 
 type service struct {
 	repository repository.PartRepository
+	// admins проверяет, что каталогом управляет администратор
+	admins *admin.Checker
 }
 
-func NewService(repository repository.PartRepository) *service {
+func NewService(repository repository.PartRepository, admins *admin.Checker) *service {
 	return &service{
 		repository: repository,
+		admins:     admins,
 	}
 }
@@ -7,9 +7,13 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/ZanDattSu/star-factory/inventory/internal/repository/mocks"
+	"github.com/ZanDattSu/star-factory/platform/pkg/admin"
 	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
 )
 
+// adminUUID пользователь, которому в тестах разрешено управлять каталогом.
+const adminUUID = "00000000-0000-0000-0000-00000000a11d"
+
 type SuiteService struct {
 	suite.Suite
 
@@ -25,7 +29,7 @@ func (s *SuiteService) SetupTest() {
 
 	s.partRepository = mocks.NewPartRepository(s.T())
 
-	s.service = NewService(s.partRepository)
+	s.service = NewService(s.partRepository, admin.NewChecker([]string{adminUUID}))
 	logger.SetNopLogger()
 }
 
@@ -0,0 +1,37 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+)
+
+// ReleaseReservation идемпотентен: уже снятый или истёкший резерв считается освобождённым.
+func (s *service) ReleaseReservation(ctx context.Context, uuid string) error {
+	_, err := s.repository.ReleaseReservation(ctx, uuid, model.ReservationStatusReleased)
+	if err != nil {
+		var stateErr *model.ReservationStateError
+		if errors.As(err, &stateErr) &&
+			(stateErr.Status == model.ReservationStatusReleased || stateErr.Status == model.ReservationStatusExpired) {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// CommitReservation идемпотентен: повторное подтверждение не является ошибкой.
+func (s *service) CommitReservation(ctx context.Context, uuid string) error {
+	_, err := s.repository.CommitReservation(ctx, uuid)
+	if err != nil {
+		var stateErr *model.ReservationStateError
+		if errors.As(err, &stateErr) && stateErr.Status == model.ReservationStatusCommitted {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
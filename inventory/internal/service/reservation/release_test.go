@@ -0,0 +1,112 @@
+package reservation
+
+import (
+	"errors"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+)
+
+func (s *SuiteService) TestReleaseReservationSuccess() {
+	uuid := gofakeit.UUID()
+
+	s.reservationRepository.
+		On("ReleaseReservation", s.ctx, uuid, model.ReservationStatusReleased).
+		Return(&model.Reservation{Uuid: uuid}, nil).
+		Once()
+
+	s.Require().NoError(s.service.ReleaseReservation(s.ctx, uuid))
+}
+
+func (s *SuiteService) TestReleaseReservationAlreadyReleased() {
+	for _, status := range []model.ReservationStatus{model.ReservationStatusReleased, model.ReservationStatusExpired} {
+		uuid := gofakeit.UUID()
+
+		s.reservationRepository.
+			On("ReleaseReservation", s.ctx, uuid, model.ReservationStatusReleased).
+			Return(nil, &model.ReservationStateError{ReservationUUID: uuid, Status: status}).
+			Once()
+
+		s.Require().NoError(s.service.ReleaseReservation(s.ctx, uuid))
+	}
+}
+
+func (s *SuiteService) TestReleaseReservationCommitted() {
+	uuid := gofakeit.UUID()
+
+	s.reservationRepository.
+		On("ReleaseReservation", s.ctx, uuid, model.ReservationStatusReleased).
+		Return(nil, &model.ReservationStateError{ReservationUUID: uuid, Status: model.ReservationStatusCommitted}).
+		Once()
+
+	var stateErr *model.ReservationStateError
+	s.Require().ErrorAs(s.service.ReleaseReservation(s.ctx, uuid), &stateErr)
+}
+
+func (s *SuiteService) TestCommitReservationIdempotent() {
+	uuid := gofakeit.UUID()
+
+	s.reservationRepository.
+		On("CommitReservation", s.ctx, uuid).
+		Return(nil, &model.ReservationStateError{ReservationUUID: uuid, Status: model.ReservationStatusCommitted}).
+		Once()
+
+	s.Require().NoError(s.service.CommitReservation(s.ctx, uuid))
+}
+
+func (s *SuiteService) TestCommitReservationExpired() {
+	uuid := gofakeit.UUID()
+
+	s.reservationRepository.
+		On("CommitReservation", s.ctx, uuid).
+		Return(nil, &model.ReservationStateError{ReservationUUID: uuid, Status: model.ReservationStatusExpired}).
+		Once()
+
+	var stateErr *model.ReservationStateError
+	err := s.service.CommitReservation(s.ctx, uuid)
+	s.Require().ErrorAs(err, &stateErr)
+	s.Require().Equal(model.ReservationStatusExpired, stateErr.Status)
+}
+
+func (s *SuiteService) TestReleaseExpired() {
+	expired := []*model.Reservation{
+		{Uuid: gofakeit.UUID()},
+		{Uuid: gofakeit.UUID()},
+		{Uuid: gofakeit.UUID()},
+	}
+
+	s.reservationRepository.
+		On("ListExpiredReservations", s.ctx, s.anyTime(), sweepBatchSize).
+		Return(expired, nil).
+		Once()
+
+	s.reservationRepository.
+		On("ReleaseReservation", s.ctx, expired[0].Uuid, model.ReservationStatusExpired).
+		Return(expired[0], nil).
+		Once()
+	// Успели подтвердить между выборкой и снятием
+	s.reservationRepository.
+		On("ReleaseReservation", s.ctx, expired[1].Uuid, model.ReservationStatusExpired).
+		Return(nil, &model.ReservationStateError{ReservationUUID: expired[1].Uuid, Status: model.ReservationStatusCommitted}).
+		Once()
+	s.reservationRepository.
+		On("ReleaseReservation", s.ctx, expired[2].Uuid, model.ReservationStatusExpired).
+		Return(expired[2], nil).
+		Once()
+
+	released, err := s.service.ReleaseExpired(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(2, released)
+}
+
+func (s *SuiteService) TestReleaseExpiredRepositoryError() {
+	s.reservationRepository.
+		On("ListExpiredReservations", s.ctx, s.anyTime(), sweepBatchSize).
+		Return(nil, errors.New("mongo is down")).
+		Once()
+
+	released, err := s.service.ReleaseExpired(s.ctx)
+	s.Require().Error(err)
+	s.Require().Zero(released)
+}
@@ -0,0 +1,46 @@
+package reservation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+)
+
+func (s *service) ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem, ttl time.Duration) (*model.Reservation, error) {
+	if ttl <= 0 {
+		ttl = s.defaultTTL
+	}
+
+	now := time.Now()
+	reservation := &model.Reservation{
+		Uuid:      uuid.NewString(),
+		OrderUuid: orderUUID,
+		Items:     mergeItems(items),
+		Status:    model.ReservationStatusActive,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return s.repository.CreateReservation(ctx, reservation)
+}
+
+// mergeItems схлопывает повторяющиеся детали в одну позицию, сохраняя порядок первого появления.
+func mergeItems(items []model.ReservationItem) []model.ReservationItem {
+	merged := make([]model.ReservationItem, 0, len(items))
+	index := make(map[string]int, len(items))
+
+	for _, item := range items {
+		if i, ok := index[item.PartUuid]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		index[item.PartUuid] = len(merged)
+		merged = append(merged, item)
+	}
+
+	return merged
+}
@@ -0,0 +1,74 @@
+package reservation
+
+import (
+	"context"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+)
+
+func (s *SuiteService) TestReservePartsMergesItemsAndAppliesDefaultTTL() {
+	orderUUID := gofakeit.UUID()
+	partA, partB := gofakeit.UUID(), gofakeit.UUID()
+
+	s.reservationRepository.
+		On("CreateReservation", s.ctx, mock.MatchedBy(func(r *model.Reservation) bool {
+			return r.OrderUuid == orderUUID &&
+				r.Status == model.ReservationStatusActive &&
+				r.ExpiresAt.Sub(r.CreatedAt) == testTTL &&
+				len(r.Items) == 2 &&
+				r.Items[0] == model.ReservationItem{PartUuid: partA, Quantity: 3} &&
+				r.Items[1] == model.ReservationItem{PartUuid: partB, Quantity: 1}
+		})).
+		Return(func(_ context.Context, r *model.Reservation) (*model.Reservation, error) { return r, nil }).
+		Once()
+
+	reservation, err := s.service.ReserveParts(s.ctx, orderUUID, []model.ReservationItem{
+		{PartUuid: partA, Quantity: 1},
+		{PartUuid: partB, Quantity: 1},
+		{PartUuid: partA, Quantity: 2},
+	}, 0)
+
+	s.Require().NoError(err)
+	s.Require().NotEmpty(reservation.Uuid)
+}
+
+func (s *SuiteService) TestReservePartsCustomTTL() {
+	ttl := 2 * time.Minute
+
+	s.reservationRepository.
+		On("CreateReservation", s.ctx, mock.MatchedBy(func(r *model.Reservation) bool {
+			return r.ExpiresAt.Sub(r.CreatedAt) == ttl
+		})).
+		Return(func(_ context.Context, r *model.Reservation) (*model.Reservation, error) { return r, nil }).
+		Once()
+
+	_, err := s.service.ReserveParts(s.ctx, gofakeit.UUID(),
+		[]model.ReservationItem{{PartUuid: gofakeit.UUID(), Quantity: 1}}, ttl)
+
+	s.Require().NoError(err)
+}
+
+func (s *SuiteService) TestReservePartsInsufficientStock() {
+	partUUID := gofakeit.UUID()
+	stockErr := &model.InsufficientStockError{
+		Shortages: []model.StockShortage{{PartUuid: partUUID, Requested: 5, Available: 2}},
+	}
+
+	s.reservationRepository.
+		On("CreateReservation", s.ctx, mock.Anything).
+		Return(nil, stockErr).
+		Once()
+
+	reservation, err := s.service.ReserveParts(s.ctx, gofakeit.UUID(),
+		[]model.ReservationItem{{PartUuid: partUUID, Quantity: 5}}, 0)
+
+	s.Require().Nil(reservation)
+
+	var target *model.InsufficientStockError
+	s.Require().ErrorAs(err, &target)
+	s.Require().Contains(err.Error(), partUUID)
+}
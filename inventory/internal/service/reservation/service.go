@@ -0,0 +1,29 @@
+package reservation
+
+import (
+	"time"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/repository"
+	srvc "github.com/ZanDattSu/star-factory/inventory/internal/service"
+)
+
+// Компиляторная проверка: убеждаемся, что *service реализует интерфейс ReservationService.
+var _ srvc.ReservationService = (*service)(nil)
+
+// sweepBatchSize сколько просроченных резервов снимается за один проход sweeper'а.
+const sweepBatchSize = 100
+
+type service struct {
+	repository repository.ReservationRepository
+
+	defaultTTL    time.Duration
+	sweepInterval time.Duration
+}
+
+func NewService(repository repository.ReservationRepository, defaultTTL, sweepInterval time.Duration) *service {
+	return &service{
+		repository:    repository,
+		defaultTTL:    defaultTTL,
+		sweepInterval: sweepInterval,
+	}
+}
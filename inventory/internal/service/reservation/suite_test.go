@@ -0,0 +1,48 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/repository/mocks"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+const (
+	testTTL           = 15 * time.Minute
+	testSweepInterval = time.Minute
+)
+
+type SuiteService struct {
+	suite.Suite
+
+	ctx context.Context //nolint:containedctx
+
+	reservationRepository *mocks.ReservationRepository
+
+	service *service
+}
+
+func (s *SuiteService) SetupTest() {
+	s.ctx = context.Background()
+
+	s.reservationRepository = mocks.NewReservationRepository(s.T())
+
+	s.service = NewService(s.reservationRepository, testTTL, testSweepInterval)
+	logger.SetNopLogger()
+}
+
+func (s *SuiteService) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(SuiteService))
+}
+
+func (s *SuiteService) anyTime() any {
+	return mock.AnythingOfType("time.Time")
+}
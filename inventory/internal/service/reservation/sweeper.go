@@ -0,0 +1,59 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+// ReleaseExpired снимает просроченные активные резервы и возвращает остатки на склад.
+func (s *service) ReleaseExpired(ctx context.Context) (int, error) {
+	reservations, err := s.repository.ListExpiredReservations(ctx, time.Now(), sweepBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	released := 0
+	for _, reservation := range reservations {
+		_, err = s.repository.ReleaseReservation(ctx, reservation.Uuid, model.ReservationStatusExpired)
+		if err != nil {
+			// Резерв успели подтвердить или снять между выборкой и обновлением
+			var stateErr *model.ReservationStateError
+			if errors.As(err, &stateErr) {
+				continue
+			}
+			return released, err
+		}
+		released++
+	}
+
+	return released, nil
+}
+
+func (s *service) RunExpirySweeper(ctx context.Context) error {
+	logger.Info(ctx, "Starting reservation expiry sweeper", zap.Duration("interval", s.sweepInterval))
+
+	ticker := time.NewTicker(s.sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		released, err := s.ReleaseExpired(ctx)
+		if err != nil {
+			logger.Error(ctx, "Failed to release expired reservations", zap.Error(err))
+		} else if released > 0 {
+			logger.Info(ctx, "Released expired reservations", zap.Int("count", released))
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "Reservation expiry sweeper stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
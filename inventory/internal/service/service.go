@@ -2,11 +2,30 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/ZanDattSu/star-factory/inventory/internal/model"
 )
 
 type PartService interface {
 	GetPart(ctx context.Context, uuid string) (*model.Part, error)
-	ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error)
+	ListParts(ctx context.Context, filter *model.PartsFilter, page model.PartsPageRequest) (*model.PartsList, error)
+	SearchParts(ctx context.Context, query string, filter *model.PartsFilter, limit int) ([]*model.PartSearchHit, error)
+	CreatePart(ctx context.Context, userUUID string, part *model.Part) (*model.Part, error)
+	UpdatePart(ctx context.Context, userUUID, partUUID string, patch *model.Part, paths []string) (*model.Part, error)
+	DeletePart(ctx context.Context, userUUID, partUUID string) error
+	BatchUpsertParts(ctx context.Context, userUUID string, parts []*model.Part) ([]*model.Part, error)
+}
+
+type ReservationService interface {
+	ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem, ttl time.Duration) (*model.Reservation, error)
+	ReleaseReservation(ctx context.Context, uuid string) error
+	CommitReservation(ctx context.Context, uuid string) error
+	ReleaseExpired(ctx context.Context) (int, error)
+	RunExpirySweeper(ctx context.Context) error
+}
+
+type StockService interface {
+	AdjustStock(ctx context.Context, userUUID string, movement *model.StockMovement) (*model.StockMovement, error)
+	ListStockMovements(ctx context.Context, userUUID, partUUID string, page model.StockMovementsPageRequest) (*model.StockMovementsList, error)
 }
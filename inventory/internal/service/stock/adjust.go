@@ -0,0 +1,79 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+// AdjustStock проводит ручное движение остатка от имени пользователя.
+// Резервы и продажи так провести нельзя: их пишет в журнал только резервирование.
+func (s *service) AdjustStock(ctx context.Context, userUUID string, movement *model.StockMovement) (*model.StockMovement, error) {
+	if err := s.checkAdmin(ctx, userUUID); err != nil {
+		return nil, err
+	}
+
+	if err := validateAdjustment(movement); err != nil {
+		return nil, err
+	}
+
+	adjustment := *movement
+	adjustment.Uuid = uuid.NewString()
+	adjustment.Reason = strings.TrimSpace(movement.Reason)
+	adjustment.Actor = userUUID
+	adjustment.ReservationUuid = ""
+	adjustment.CreatedAt = time.Now()
+
+	applied, err := s.repository.AdjustStock(ctx, &adjustment)
+	if err != nil {
+		logger.Error(ctx, "Failed to adjust stock",
+			zap.String("part_uuid", movement.PartUuid),
+			zap.String("type", string(movement.Type)),
+			zap.Int64("delta", movement.Delta),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("error adjusting stock: %w", err)
+	}
+
+	logger.Info(ctx, "Stock adjusted",
+		zap.String("movement_uuid", applied.Uuid),
+		zap.String("part_uuid", applied.PartUuid),
+		zap.String("type", string(applied.Type)),
+		zap.Int64("delta", applied.Delta),
+		zap.Int64("quantity_after", applied.QuantityAfter),
+		zap.String("actor", userUUID),
+	)
+
+	return applied, nil
+}
+
+// validateAdjustment проверяет, что движение можно провести вручную.
+func validateAdjustment(movement *model.StockMovement) error {
+	if strings.TrimSpace(movement.Reason) == "" {
+		return &model.InvalidStockMovementError{Reason: "reason is required"}
+	}
+	if movement.Delta == 0 {
+		return &model.InvalidStockMovementError{Reason: "delta must not be zero"}
+	}
+
+	switch movement.Type {
+	case model.StockMovementTypeReceipt, model.StockMovementTypeReturn:
+		if movement.Delta < 0 {
+			return &model.InvalidStockMovementError{Reason: fmt.Sprintf("%s must increase stock", movement.Type)}
+		}
+	case model.StockMovementTypeCorrection:
+	default:
+		return &model.InvalidStockMovementError{
+			Reason: fmt.Sprintf("%s movements are recorded by reservations only", movement.Type),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package stock
+
+import (
+	"context"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+)
+
+func (s *SuiteService) TestAdjustStockRecordsActor() {
+	partUUID := gofakeit.UUID()
+
+	s.stockRepository.
+		On("AdjustStock", s.ctx, mock.MatchedBy(func(m *model.StockMovement) bool {
+			return m.Uuid != "" &&
+				m.PartUuid == partUUID &&
+				m.Type == model.StockMovementTypeReceipt &&
+				m.Delta == 10 &&
+				m.Reason == "supplier delivery" &&
+				m.Actor == adminUUID &&
+				!m.CreatedAt.IsZero()
+		})).
+		Return(func(_ context.Context, m *model.StockMovement) (*model.StockMovement, error) {
+			applied := *m
+			applied.Sequence = 3
+			applied.QuantityAfter = 15
+			return &applied, nil
+		}).
+		Once()
+
+	movement, err := s.service.AdjustStock(s.ctx, adminUUID, &model.StockMovement{
+		PartUuid: partUUID,
+		Type:     model.StockMovementTypeReceipt,
+		Delta:    10,
+		Reason:   "  supplier delivery ",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(15), movement.QuantityAfter)
+}
+
+func (s *SuiteService) TestAdjustStockRejectsInvalidMovements() {
+	tests := []struct {
+		name     string
+		movement model.StockMovement
+	}{
+		{
+			name:     "reservation",
+			movement: model.StockMovement{Type: model.StockMovementTypeReservation, Delta: -1, Reason: "manual"},
+		},
+		{
+			name:     "sale",
+			movement: model.StockMovement{Type: model.StockMovementTypeSale, Delta: -1, Reason: "manual"},
+		},
+		{
+			name:     "negative receipt",
+			movement: model.StockMovement{Type: model.StockMovementTypeReceipt, Delta: -1, Reason: "manual"},
+		},
+		{
+			name:     "negative return",
+			movement: model.StockMovement{Type: model.StockMovementTypeReturn, Delta: -1, Reason: "manual"},
+		},
+		{
+			name:     "zero delta",
+			movement: model.StockMovement{Type: model.StockMovementTypeCorrection, Reason: "manual"},
+		},
+		{
+			name:     "blank reason",
+			movement: model.StockMovement{Type: model.StockMovementTypeCorrection, Delta: -1, Reason: " "},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			movement := tt.movement
+			movement.PartUuid = gofakeit.UUID()
+
+			_, err := s.service.AdjustStock(s.ctx, adminUUID, &movement)
+			var invalid *model.InvalidStockMovementError
+			s.Require().ErrorAs(err, &invalid)
+		})
+	}
+}
+
+func (s *SuiteService) TestAdjustStockAllowsNegativeCorrection() {
+	s.stockRepository.
+		On("AdjustStock", s.ctx, mock.MatchedBy(func(m *model.StockMovement) bool {
+			return m.Type == model.StockMovementTypeCorrection && m.Delta == -2
+		})).
+		Return(nil, &model.InsufficientStockError{Shortages: []model.StockShortage{{Requested: 2, Available: 1}}}).
+		Once()
+
+	_, err := s.service.AdjustStock(s.ctx, adminUUID, &model.StockMovement{
+		PartUuid: gofakeit.UUID(),
+		Type:     model.StockMovementTypeCorrection,
+		Delta:    -2,
+		Reason:   "stocktake",
+	})
+	var stockErr *model.InsufficientStockError
+	s.Require().ErrorAs(err, &stockErr)
+}
+
+func (s *SuiteService) TestStockRequiresAdmin() {
+	userUUID := gofakeit.UUID()
+
+	_, err := s.service.AdjustStock(s.ctx, userUUID, &model.StockMovement{
+		PartUuid: gofakeit.UUID(),
+		Type:     model.StockMovementTypeReceipt,
+		Delta:    1,
+		Reason:   "delivery",
+	})
+	var forbidden *model.ForbiddenError
+	s.Require().ErrorAs(err, &forbidden)
+
+	_, err = s.service.ListStockMovements(s.ctx, userUUID, gofakeit.UUID(), model.StockMovementsPageRequest{})
+	s.Require().ErrorAs(err, &forbidden)
+}
@@ -0,0 +1,51 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+// ListStockMovements возвращает страницу журнала движений детали от новых к старым.
+func (s *service) ListStockMovements(ctx context.Context, userUUID, partUUID string, page model.StockMovementsPageRequest) (*model.StockMovementsList, error) {
+	if err := s.checkAdmin(ctx, userUUID); err != nil {
+		return nil, err
+	}
+
+	pageSize := page.PageSize
+	if pageSize <= 0 {
+		pageSize = model.DefaultStockMovementsPageSize
+	}
+
+	// Запрашиваем на одно движение больше, чтобы узнать, есть ли следующая страница
+	repoPage := model.StockMovementsPage{Limit: pageSize + 1}
+	if page.PageToken != "" {
+		cursor, err := model.DecodeStockMovementsCursor(page.PageToken, partUUID)
+		if err != nil {
+			return nil, err
+		}
+		repoPage.BeforeSequence = cursor.Sequence
+	}
+
+	movements, err := s.repository.ListStockMovements(ctx, partUUID, repoPage)
+	if err != nil {
+		logger.Error(ctx, "Failed to list stock movements from repository",
+			zap.String("part_uuid", partUUID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("error listing stock movements: %w", err)
+	}
+
+	list := &model.StockMovementsList{Movements: movements}
+	if len(movements) > pageSize {
+		list.Movements = movements[:pageSize]
+		last := list.Movements[pageSize-1]
+		list.NextPageToken = (&model.StockMovementsCursor{PartUuid: partUUID, Sequence: last.Sequence}).Encode()
+	}
+
+	return list, nil
+}
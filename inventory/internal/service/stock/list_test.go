@@ -0,0 +1,64 @@
+package stock
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+)
+
+func movements(partUUID string, from, to int64) []*model.StockMovement {
+	var result []*model.StockMovement
+	for sequence := from; sequence >= to; sequence-- {
+		result = append(result, &model.StockMovement{PartUuid: partUUID, Sequence: sequence})
+	}
+	return result
+}
+
+func (s *SuiteService) TestListStockMovementsPaginates() {
+	partUUID := gofakeit.UUID()
+
+	s.stockRepository.
+		On("ListStockMovements", s.ctx, partUUID, model.StockMovementsPage{Limit: 3}).
+		Return(movements(partUUID, 5, 3), nil).
+		Once()
+
+	first, err := s.service.ListStockMovements(s.ctx, adminUUID, partUUID, model.StockMovementsPageRequest{PageSize: 2})
+	s.Require().NoError(err)
+	s.Require().Len(first.Movements, 2)
+	s.Require().NotEmpty(first.NextPageToken)
+
+	s.stockRepository.
+		On("ListStockMovements", s.ctx, partUUID, model.StockMovementsPage{Limit: 3, BeforeSequence: 4}).
+		Return(movements(partUUID, 3, 2), nil).
+		Once()
+
+	second, err := s.service.ListStockMovements(s.ctx, adminUUID, partUUID, model.StockMovementsPageRequest{
+		PageSize:  2,
+		PageToken: first.NextPageToken,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(second.Movements, 2)
+	s.Require().Empty(second.NextPageToken)
+}
+
+func (s *SuiteService) TestListStockMovementsDefaultPageSize() {
+	partUUID := gofakeit.UUID()
+
+	s.stockRepository.
+		On("ListStockMovements", s.ctx, partUUID, model.StockMovementsPage{Limit: model.DefaultStockMovementsPageSize + 1}).
+		Return(movements(partUUID, 1, 1), nil).
+		Once()
+
+	list, err := s.service.ListStockMovements(s.ctx, adminUUID, partUUID, model.StockMovementsPageRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(list.Movements, 1)
+	s.Require().Empty(list.NextPageToken)
+}
+
+func (s *SuiteService) TestListStockMovementsRejectsForeignToken() {
+	token := (&model.StockMovementsCursor{PartUuid: gofakeit.UUID(), Sequence: 4}).Encode()
+
+	_, err := s.service.ListStockMovements(s.ctx, adminUUID, gofakeit.UUID(), model.StockMovementsPageRequest{PageToken: token})
+	var tokenErr *model.InvalidPageTokenError
+	s.Require().ErrorAs(err, &tokenErr)
+}
@@ -0,0 +1,35 @@
+package stock
+
+import (
+	"context"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/model"
+	"github.com/ZanDattSu/star-factory/inventory/internal/repository"
+	srvc "github.com/ZanDattSu/star-factory/inventory/internal/service"
+	"github.com/ZanDattSu/star-factory/platform/pkg/admin"
+)
+
+// Компиляторная проверка: убеждаемся, что *service реализует интерфейс StockService.
+var _ srvc.StockService = (*service)(nil)
+
+type service struct {
+	repository repository.StockRepository
+	// admins проверяет, что остатки меняет и журнал смотрит администратор
+	admins *admin.Checker
+}
+
+func NewService(repository repository.StockRepository, admins *admin.Checker) *service {
+	return &service{
+		repository: repository,
+		admins:     admins,
+	}
+}
+
+// checkAdmin проверяет, что пользователь из сессии может управлять остатками.
+func (s *service) checkAdmin(ctx context.Context, userUUID string) error {
+	if s.admins.Allow(ctx, userUUID, "stock management") {
+		return nil
+	}
+
+	return &model.ForbiddenError{Message: "stock can be managed by administrators only"}
+}
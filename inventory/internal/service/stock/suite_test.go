@@ -0,0 +1,41 @@
+package stock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ZanDattSu/star-factory/inventory/internal/repository/mocks"
+	"github.com/ZanDattSu/star-factory/platform/pkg/admin"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+// adminUUID пользователь, которому в тестах разрешено управлять остатками.
+const adminUUID = "00000000-0000-0000-0000-00000000a11d"
+
+type SuiteService struct {
+	suite.Suite
+
+	ctx context.Context //nolint:containedctx
+
+	stockRepository *mocks.StockRepository
+
+	service *service
+}
+
+func (s *SuiteService) SetupTest() {
+	s.ctx = context.Background()
+
+	s.stockRepository = mocks.NewStockRepository(s.T())
+
+	s.service = NewService(s.stockRepository, admin.NewChecker([]string{adminUUID}))
+	logger.SetNopLogger()
+}
+
+func (s *SuiteService) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(SuiteService))
+}
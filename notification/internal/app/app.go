@@ -30,7 +30,7 @@ func New(ctx context.Context) (*App, error) {
 }
 
 func (a *App) Run(ctx context.Context) error {
-	errCh := make(chan error, 2)
+	errCh := make(chan error, 3)
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -47,6 +47,12 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	go func() {
+		if err := a.runOrderRefundedConsumer(ctx); err != nil {
+			errCh <- fmt.Errorf("consumer crashed: %w", err)
+		}
+	}()
+
 	select {
 	case <-ctx.Done():
 		logger.Info(ctx, "Shutdown signal received")
@@ -115,6 +121,17 @@ func (a *App) runShipAssembledConsumer(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) runOrderRefundedConsumer(ctx context.Context) error {
+	logger.Info(ctx, "OrderRefunded Kafka consumer starting")
+
+	err := a.diContainer.OrderRefundedConsumerService().RunOrderRefundedConsumer(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (a *App) initTelegramBot(ctx context.Context) error {
 	var (
 		maxRetries  = config.AppConfig().TelegramBot.MaxRetries()
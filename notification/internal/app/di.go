@@ -19,6 +19,7 @@ import (
 	"github.com/ZanDattSu/star-factory/notification/internal/converter/kafka/decoder"
 	"github.com/ZanDattSu/star-factory/notification/internal/service"
 	orderPaidConsumer "github.com/ZanDattSu/star-factory/notification/internal/service/consumer/order_paid_consumer"
+	orderRefundedConsumer "github.com/ZanDattSu/star-factory/notification/internal/service/consumer/order_refunded_consumer"
 	shipAssembledConsumer "github.com/ZanDattSu/star-factory/notification/internal/service/consumer/ship_assembled_consumer"
 	"github.com/ZanDattSu/star-factory/notification/internal/service/telegram"
 	"github.com/ZanDattSu/star-factory/platform/pkg/closer"
@@ -35,10 +36,12 @@ type diContainer struct {
 	notificationService          service.NotificationService
 	orderPaidConsumerService     service.OrderPaidConsumerService
 	shipAssembledConsumerService service.ShipAssembledConsumerService
+	orderRefundedConsumerService service.OrderRefundedConsumerService
 
 	// Converters
 	orderPaidDecoder     kafkaConverter.OrderPaidDecoder
 	shipAssembledDecoder kafkaConverter.ShipAssembledDecoder
+	orderRefundedDecoder kafkaConverter.OrderRefundedDecoder
 
 	// telegram
 	authClient     auth.AuthClient
@@ -48,10 +51,12 @@ type diContainer struct {
 	// Consumer Groups
 	shipAssembledConsumerGroup sarama.ConsumerGroup
 	orderPaidConsumerGroup     sarama.ConsumerGroup
+	orderRefundedConsumerGroup sarama.ConsumerGroup
 
 	// Consumers
 	shipAssembledConsumer wrappedKafka.Consumer
 	orderPaidConsumer     wrappedKafka.Consumer
+	orderRefundedConsumer wrappedKafka.Consumer
 }
 
 func NewDIContainer() *diContainer {
@@ -90,6 +95,17 @@ func (d *diContainer) ShipAssembledConsumerService() service.ShipAssembledConsum
 	return d.shipAssembledConsumerService
 }
 
+func (d *diContainer) OrderRefundedConsumerService() service.OrderRefundedConsumerService {
+	if d.orderRefundedConsumerService == nil {
+		d.orderRefundedConsumerService = orderRefundedConsumer.NewService(
+			d.OrderRefundedConsumer(),
+			d.OrderRefundedDecoder(),
+			d.NotificationService(),
+		)
+	}
+	return d.orderRefundedConsumerService
+}
+
 func (d *diContainer) ShipAssembledDecoder() kafkaConverter.ShipAssembledDecoder {
 	if d.shipAssembledDecoder == nil {
 		d.shipAssembledDecoder = decoder.NewAssemblyDecoder()
@@ -105,6 +121,14 @@ func (d *diContainer) OrderPaidDecoder() kafkaConverter.OrderPaidDecoder {
 	return d.orderPaidDecoder
 }
 
+func (d *diContainer) OrderRefundedDecoder() kafkaConverter.OrderRefundedDecoder {
+	if d.orderRefundedDecoder == nil {
+		d.orderRefundedDecoder = decoder.NewOrderRefundedDecoder()
+	}
+
+	return d.orderRefundedDecoder
+}
+
 func (d *diContainer) TelegramClient() httpClient.TelegramClient {
 	if d.telegramClient == nil {
 		tgBot, _ := d.TelegramBot() //nolint:gosec
@@ -204,6 +228,24 @@ func (d *diContainer) OrderPaidConsumerGroup() sarama.ConsumerGroup {
 	return d.orderPaidConsumerGroup
 }
 
+func (d *diContainer) OrderRefundedConsumerGroup() sarama.ConsumerGroup {
+	if d.orderRefundedConsumerGroup == nil {
+		consumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().OrderRefundedConsumer.GroupID(),
+			config.AppConfig().OrderRefundedConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create order refunded consumer group: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka order refunded consumer group", func(ctx context.Context) error {
+			return d.orderRefundedConsumerGroup.Close()
+		})
+		d.orderRefundedConsumerGroup = consumerGroup
+	}
+	return d.orderRefundedConsumerGroup
+}
+
 func (d *diContainer) ShipAssembledConsumer() wrappedKafka.Consumer {
 	if d.shipAssembledConsumer == nil {
 		d.shipAssembledConsumer = wrappedKafkaConsumer.NewConsumer(
@@ -231,3 +273,17 @@ func (d *diContainer) OrderPaidConsumer() wrappedKafka.Consumer {
 	}
 	return d.orderPaidConsumer
 }
+
+func (d *diContainer) OrderRefundedConsumer() wrappedKafka.Consumer {
+	if d.orderRefundedConsumer == nil {
+		d.orderRefundedConsumer = wrappedKafkaConsumer.NewConsumer(
+			d.OrderRefundedConsumerGroup(),
+			[]string{
+				config.AppConfig().OrderRefundedConsumer.Topic(),
+			},
+			logger.Logger(),
+			kafkaMiddleware.Logging(logger.Logger()),
+		)
+	}
+	return d.orderRefundedConsumer
+}
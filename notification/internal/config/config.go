@@ -15,6 +15,7 @@ type config struct {
 	Kafka                 KafkaConfig
 	OrderPaidConsumer     OrderPaidConsumerConfig
 	ShipAssembledConsumer ShipAssembledConsumerConfig
+	OrderRefundedConsumer OrderRefundedConsumerConfig
 	TelegramBot           TelegramBotConfig
 	AuthService           AuthGRPCService
 }
@@ -45,6 +46,11 @@ func Load(path ...string) error {
 		return err
 	}
 
+	orderRefundedConsumerCfg, err := env.NewOrderRefundedConsumerConfig()
+	if err != nil {
+		return err
+	}
+
 	telegramBotCfg, err := env.NewTelegramBotConfig()
 	if err != nil {
 		return err
@@ -60,6 +66,7 @@ func Load(path ...string) error {
 		Kafka:                 kafkaCfg,
 		OrderPaidConsumer:     orderPaidConsumerCfg,
 		ShipAssembledConsumer: shipAssembledConsumerCfg,
+		OrderRefundedConsumer: orderRefundedConsumerCfg,
 		TelegramBot:           telegramBotCfg,
 		AuthService:           authGrpcConfig,
 	}
@@ -27,6 +27,12 @@ type ShipAssembledConsumerConfig interface {
 	Config() *sarama.Config
 }
 
+type OrderRefundedConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	Config() *sarama.Config
+}
+
 type TelegramBotConfig interface {
 	Token() string
 	MaxRetries() int
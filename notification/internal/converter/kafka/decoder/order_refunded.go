@@ -0,0 +1,33 @@
+package decoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ZanDattSu/star-factory/notification/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+	eventsV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/events/v1"
+)
+
+type orderRefundedDecoder struct{}
+
+func NewOrderRefundedDecoder() *orderRefundedDecoder {
+	return &orderRefundedDecoder{}
+}
+
+func (d *orderRefundedDecoder) Decode(data []byte) (model.OrderRefundedEvent, error) {
+	var pb eventsV1.OrderRefunded
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return model.OrderRefundedEvent{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return model.OrderRefundedEvent{
+		EventUUID:       pb.EventUuid,
+		OrderUUID:       pb.OrderUuid,
+		UserUUID:        pb.UserUuid,
+		TransactionUUID: pb.TransactionUuid,
+		RefundUUID:      pb.RefundUuid,
+		Amount:          money.FromProto(pb.Amount),
+	}, nil
+}
@@ -7,6 +7,11 @@ type OrderPaidDecoder interface {
 	Decode(data []byte) (model.OrderPaidEvent, error)
 }
 
+// OrderRefundedDecoder - декодер для OrderRefundedEvent события
+type OrderRefundedDecoder interface {
+	Decode(data []byte) (model.OrderRefundedEvent, error)
+}
+
 type ShipAssembledDecoder interface {
 	Decode(data []byte) (model.ShipAssembledEvent, error)
 }
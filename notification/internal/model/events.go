@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
 
 // OrderPaidEvent - событие "заказ оплачен" (приходит от Order Service)
 type OrderPaidEvent struct {
@@ -11,6 +15,16 @@ type OrderPaidEvent struct {
 	TransactionUUID string
 }
 
+// OrderRefundedEvent - событие "оплата заказа возвращена" (приходит от Order Service)
+type OrderRefundedEvent struct {
+	EventUUID       string
+	OrderUUID       string
+	UserUUID        string
+	TransactionUUID string
+	RefundUUID      string
+	Amount          money.Money
+}
+
 // ShipAssembledEvent - событие "корабль собран"
 type ShipAssembledEvent struct {
 	EventUUID string
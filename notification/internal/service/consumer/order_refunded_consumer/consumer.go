@@ -0,0 +1,43 @@
+package order_refunded_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/ZanDattSu/star-factory/notification/internal/converter/kafka"
+	serv "github.com/ZanDattSu/star-factory/notification/internal/service"
+	"github.com/ZanDattSu/star-factory/platform/pkg/kafka"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+type service struct {
+	orderRefundedConsumer kafka.Consumer
+	orderRefundedDecoder  kafkaConverter.OrderRefundedDecoder
+	notificationService   serv.NotificationService
+}
+
+func NewService(
+	orderRefundedConsumer kafka.Consumer,
+	orderRefundedDecoder kafkaConverter.OrderRefundedDecoder,
+	notificationService serv.NotificationService,
+) *service {
+	return &service{
+		orderRefundedConsumer: orderRefundedConsumer,
+		orderRefundedDecoder:  orderRefundedDecoder,
+		notificationService:   notificationService,
+	}
+}
+
+func (s *service) RunOrderRefundedConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting consumer for order.refunded topic")
+
+	err := s.orderRefundedConsumer.Consume(ctx, s.handleOrderRefunded)
+	if err != nil {
+		logger.Error(ctx, "Failed to consume from order.refunded topic", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "order.refunded consumer stopped")
+	return nil
+}
@@ -0,0 +1,46 @@
+package order_refunded_consumer
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/kafka/consumer"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+func (s *service) handleOrderRefunded(ctx context.Context, msg consumer.Message) error {
+	event, err := s.orderRefundedDecoder.Decode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode OrderRefunded event", zap.Error(err))
+		return err
+	}
+
+	if event.OrderUUID == "" {
+		logger.Error(ctx, "Invalid event: empty order_uuid")
+		return errors.New("invalid event")
+	}
+
+	logger.Info(ctx, "Received OrderRefunded event",
+		zap.String("topic", msg.Topic),
+		zap.Any("partition", msg.Partition),
+		zap.Any("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("refund_uuid", event.RefundUUID),
+		zap.Stringer("amount", event.Amount),
+	)
+
+	err = s.notificationService.SendRefundedNotification(ctx, event)
+	if err != nil {
+		logger.Error(ctx, "Failed to send refund telegram notification", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "OrderRefunded event processed successfully",
+		zap.String("order_uuid", event.OrderUUID),
+	)
+
+	return nil
+}
@@ -116,6 +116,53 @@ func (_c *NotificationService_SendPaidNotification_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// SendRefundedNotification provides a mock function with given fields: ctx, refundedEvent
+func (_m *NotificationService) SendRefundedNotification(ctx context.Context, refundedEvent model.OrderRefundedEvent) error {
+	ret := _m.Called(ctx, refundedEvent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendRefundedNotification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderRefundedEvent) error); ok {
+		r0 = rf(ctx, refundedEvent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationService_SendRefundedNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendRefundedNotification'
+type NotificationService_SendRefundedNotification_Call struct {
+	*mock.Call
+}
+
+// SendRefundedNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refundedEvent model.OrderRefundedEvent
+func (_e *NotificationService_Expecter) SendRefundedNotification(ctx interface{}, refundedEvent interface{}) *NotificationService_SendRefundedNotification_Call {
+	return &NotificationService_SendRefundedNotification_Call{Call: _e.mock.On("SendRefundedNotification", ctx, refundedEvent)}
+}
+
+func (_c *NotificationService_SendRefundedNotification_Call) Run(run func(ctx context.Context, refundedEvent model.OrderRefundedEvent)) *NotificationService_SendRefundedNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrderRefundedEvent))
+	})
+	return _c
+}
+
+func (_c *NotificationService_SendRefundedNotification_Call) Return(_a0 error) *NotificationService_SendRefundedNotification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationService_SendRefundedNotification_Call) RunAndReturn(run func(context.Context, model.OrderRefundedEvent) error) *NotificationService_SendRefundedNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewNotificationService creates a new instance of NotificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNotificationService(t interface {
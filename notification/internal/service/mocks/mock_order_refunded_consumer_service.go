@@ -0,0 +1,82 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrderRefundedConsumerService is an autogenerated mock type for the OrderRefundedConsumerService type
+type OrderRefundedConsumerService struct {
+	mock.Mock
+}
+
+type OrderRefundedConsumerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderRefundedConsumerService) EXPECT() *OrderRefundedConsumerService_Expecter {
+	return &OrderRefundedConsumerService_Expecter{mock: &_m.Mock}
+}
+
+// RunOrderRefundedConsumer provides a mock function with given fields: ctx
+func (_m *OrderRefundedConsumerService) RunOrderRefundedConsumer(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunOrderRefundedConsumer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderRefundedConsumerService_RunOrderRefundedConsumer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunOrderRefundedConsumer'
+type OrderRefundedConsumerService_RunOrderRefundedConsumer_Call struct {
+	*mock.Call
+}
+
+// RunOrderRefundedConsumer is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OrderRefundedConsumerService_Expecter) RunOrderRefundedConsumer(ctx interface{}) *OrderRefundedConsumerService_RunOrderRefundedConsumer_Call {
+	return &OrderRefundedConsumerService_RunOrderRefundedConsumer_Call{Call: _e.mock.On("RunOrderRefundedConsumer", ctx)}
+}
+
+func (_c *OrderRefundedConsumerService_RunOrderRefundedConsumer_Call) Run(run func(ctx context.Context)) *OrderRefundedConsumerService_RunOrderRefundedConsumer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OrderRefundedConsumerService_RunOrderRefundedConsumer_Call) Return(_a0 error) *OrderRefundedConsumerService_RunOrderRefundedConsumer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderRefundedConsumerService_RunOrderRefundedConsumer_Call) RunAndReturn(run func(context.Context) error) *OrderRefundedConsumerService_RunOrderRefundedConsumer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderRefundedConsumerService creates a new instance of OrderRefundedConsumerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderRefundedConsumerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderRefundedConsumerService {
+	mock := &OrderRefundedConsumerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
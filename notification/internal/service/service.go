@@ -10,6 +10,7 @@ import (
 type NotificationService interface {
 	SendPaidNotification(ctx context.Context, paidEvent model.OrderPaidEvent) error
 	SendAssembledNotification(ctx context.Context, shipAssembledEvent model.ShipAssembledEvent) error
+	SendRefundedNotification(ctx context.Context, refundedEvent model.OrderRefundedEvent) error
 }
 
 // OrderPaidConsumerService - слушает "order.paid" топик
@@ -21,3 +22,8 @@ type OrderPaidConsumerService interface {
 type ShipAssembledConsumerService interface {
 	RunShipAssembledConsumer(ctx context.Context) error
 }
+
+// OrderRefundedConsumerService - слушает "order.refunded" топик
+type OrderRefundedConsumerService interface {
+	RunOrderRefundedConsumer(ctx context.Context) error
+}
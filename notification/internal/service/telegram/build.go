@@ -36,6 +36,21 @@ type shipAssembled struct {
 
 var shipAssembledTemplate = template.Must(template.ParseFS(shipAssembledTemplateFS, "templates/ship_assembled_notification.tmpl"))
 
+//go:embed templates/order_refunded_notification.tmpl
+var orderRefundedTemplateFS embed.FS
+
+type orderRefunded struct {
+	EventUUID       string
+	OrderUUID       string
+	UserUUID        string
+	Amount          string
+	TransactionUUID string
+	RefundUUID      string
+	RegisteredAt    time.Time
+}
+
+var orderRefundedTemplate = template.Must(template.ParseFS(orderRefundedTemplateFS, "templates/order_refunded_notification.tmpl"))
+
 func (s *service) buildPaidMessage(paidEvent model.OrderPaidEvent) (string, error) {
 	data := orderPaid{
 		EventUUID:       paidEvent.EventUUID,
@@ -72,3 +87,23 @@ func (s *service) buildAssembledMessage(shipAssembledEvent model.ShipAssembledEv
 
 	return buf.String(), nil
 }
+
+func (s *service) buildRefundedMessage(refundedEvent model.OrderRefundedEvent) (string, error) {
+	data := orderRefunded{
+		EventUUID:       refundedEvent.EventUUID,
+		OrderUUID:       refundedEvent.OrderUUID,
+		UserUUID:        refundedEvent.UserUUID,
+		Amount:          refundedEvent.Amount.String(),
+		TransactionUUID: refundedEvent.TransactionUUID,
+		RefundUUID:      refundedEvent.RefundUUID,
+		RegisteredAt:    time.Now(),
+	}
+
+	var buf bytes.Buffer
+	err := orderRefundedTemplate.Execute(&buf, data)
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
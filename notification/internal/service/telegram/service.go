@@ -87,6 +87,40 @@ func (s *service) SendAssembledNotification(ctx context.Context, shipAssembledEv
 	return nil
 }
 
+func (s *service) SendRefundedNotification(ctx context.Context, refundedEvent model.OrderRefundedEvent) error {
+	message, err := s.buildRefundedMessage(refundedEvent)
+	if err != nil {
+		return err
+	}
+
+	isSub, chatID, err := s.telegramSubscription(ctx, refundedEvent.UserUUID)
+	if err != nil {
+		return err
+	}
+
+	if !isSub {
+		logger.Info(
+			ctx,
+			"user is not subscribed to telegram notifications",
+			zap.String("user_uuid", refundedEvent.UserUUID),
+		)
+		return nil
+	}
+
+	err = s.telegramClient.SendMessage(ctx, chatID, message)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		ctx,
+		"telegram message sent",
+		zap.Int64("chat_id", chatID),
+		zap.String("user_uuid", refundedEvent.UserUUID),
+	)
+	return nil
+}
+
 func (s *service) telegramSubscription(ctx context.Context, userUUID string) (bool, int64, error) {
 	user, err := s.authClient.GetUser(ctx, userUUID)
 	if err != nil {
@@ -0,0 +1,70 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryV1 "github.com/ZanDattSu/star-factory/order/internal/client/grpc/inventory/v1"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/order/internal/service"
+	"github.com/ZanDattSu/star-factory/platform/pkg/grpc/interceptor"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/order/v1"
+)
+
+// Компиляторная проверка: убеждаемся, что *api реализует gRPC-сервер OrderService.
+var _ orderV1.OrderServiceServer = (*api)(nil)
+
+type api struct {
+	orderV1.UnimplementedOrderServiceServer
+	orderService service.OrderService
+}
+
+func NewApi(orderService service.OrderService) *api {
+	return &api{orderService: orderService}
+}
+
+// sessionUserUUID возвращает UUID пользователя, которого AuthInterceptor положил в контекст.
+func sessionUserUUID(ctx context.Context) (string, error) {
+	user, ok := interceptor.GetUserFromContext(ctx)
+	if !ok || user.GetUuid() == "" {
+		return "", status.Error(codes.Unauthenticated, "user is not authenticated")
+	}
+	return user.GetUuid(), nil
+}
+
+// toStatusError переводит доменные ошибки сервиса в коды gRPC так же, как HTTP API переводит их в коды ответа.
+func toStatusError(err error) error {
+	badRequest := &model.BadRequestError{}
+	notFound := &model.OrderNotFoundError{}
+	partsNotFound := &inventoryV1.PartsNotFoundError{}
+	forbidden := &model.ForbiddenError{}
+	shortage := &model.InsufficientStockError{}
+	conflict := &model.ConflictError{}
+	versionConflict := &model.OrderVersionConflictError{}
+	blueprint := &model.BlueprintViolationError{}
+	priceChanged := &model.PriceChangedError{}
+
+	switch {
+	case errors.As(err, &badRequest):
+		return status.Error(codes.InvalidArgument, badRequest.Message)
+	case errors.As(err, &blueprint):
+		return status.Error(codes.InvalidArgument, blueprint.Error())
+	case errors.As(err, &notFound), errors.As(err, &partsNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.As(err, &forbidden):
+		return status.Error(codes.PermissionDenied, forbidden.Message)
+	case errors.As(err, &priceChanged):
+		return status.Error(codes.FailedPrecondition, priceChanged.Error())
+	case errors.As(err, &shortage):
+		return status.Error(codes.FailedPrecondition, shortage.Error())
+	case errors.As(err, &conflict):
+		return status.Error(codes.FailedPrecondition, conflict.Message)
+	case errors.As(err, &versionConflict):
+		return status.Error(codes.Aborted, versionConflict.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,20 @@
+package order
+
+import (
+	"context"
+
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/order/v1"
+)
+
+func (a *api) CancelOrder(ctx context.Context, req *orderV1.CancelOrderRequest) (*orderV1.CancelOrderResponse, error) {
+	userUUID, err := sessionUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.orderService.CancelOrder(ctx, userUUID, req.GetOrderUuid()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &orderV1.CancelOrderResponse{}, nil
+}
@@ -0,0 +1,46 @@
+package order
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	protoConverter "github.com/ZanDattSu/star-factory/order/internal/converter/proto"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/order/v1"
+)
+
+func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest) (*orderV1.CreateOrderResponse, error) {
+	userUUID, err := sessionUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// С токеном расчёта позиции берутся из расчёта
+	if len(req.GetItems()) == 0 && req.GetQuoteToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "order should contain at least 1 item")
+	}
+
+	orderUUID, price, err := a.orderService.CreateOrder(
+		ctx,
+		userUUID,
+		model.OrderRequest{
+			Items:      protoConverter.OrderItemsFromProto(req.GetItems()),
+			PromoCode:  req.GetPromoCode(),
+			QuoteToken: req.GetQuoteToken(),
+		},
+		req.GetIdempotencyKey(),
+	)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &orderV1.CreateOrderResponse{
+		OrderUuid:  orderUUID,
+		TotalPrice: money.ToProto(price.Total),
+		Subtotal:   money.ToProto(price.Subtotal),
+		Discount:   money.ToProto(price.Discount),
+	}, nil
+}
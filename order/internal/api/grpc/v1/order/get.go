@@ -0,0 +1,24 @@
+package order
+
+import (
+	"context"
+
+	protoConverter "github.com/ZanDattSu/star-factory/order/internal/converter/proto"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/order/v1"
+)
+
+func (a *api) GetOrder(ctx context.Context, req *orderV1.GetOrderRequest) (*orderV1.GetOrderResponse, error) {
+	userUUID, err := sessionUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := a.orderService.GetOrder(ctx, userUUID, req.GetOrderUuid())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &orderV1.GetOrderResponse{
+		Order: protoConverter.OrderToProto(order),
+	}, nil
+}
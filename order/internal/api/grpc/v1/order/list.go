@@ -0,0 +1,29 @@
+package order
+
+import (
+	"context"
+
+	protoConverter "github.com/ZanDattSu/star-factory/order/internal/converter/proto"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/order/v1"
+)
+
+func (a *api) ListOrders(ctx context.Context, req *orderV1.ListOrdersRequest) (*orderV1.ListOrdersResponse, error) {
+	userUUID, err := sessionUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, nextCursor, err := a.orderService.ListOrders(
+		ctx,
+		protoConverter.OrdersFilterFromProto(userUUID, req),
+		req.GetCursor(),
+	)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &orderV1.ListOrdersResponse{
+		Orders:     protoConverter.OrdersToProto(orders),
+		NextCursor: nextCursor,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package order
+
+import (
+	"context"
+
+	protoConverter "github.com/ZanDattSu/star-factory/order/internal/converter/proto"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/order/v1"
+)
+
+func (a *api) PayOrder(ctx context.Context, req *orderV1.PayOrderRequest) (*orderV1.PayOrderResponse, error) {
+	userUUID, err := sessionUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionUUID, err := a.orderService.PayOrder(
+		ctx,
+		userUUID,
+		protoConverter.PaymentMethodFromProto(req.GetPaymentMethod()),
+		req.GetOrderUuid(),
+		req.GetIdempotencyKey(),
+	)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &orderV1.PayOrderResponse{
+		TransactionUuid: transactionUUID,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package order
+
+import (
+	"context"
+
+	protoConverter "github.com/ZanDattSu/star-factory/order/internal/converter/proto"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/order/v1"
+)
+
+func (a *api) QuoteOrder(ctx context.Context, req *orderV1.QuoteOrderRequest) (*orderV1.QuoteOrderResponse, error) {
+	userUUID, err := sessionUserUUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := a.orderService.QuoteOrder(
+		ctx,
+		userUUID,
+		protoConverter.OrderItemsFromProto(req.GetItems()),
+		req.GetPromoCode(),
+	)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return protoConverter.QuoteToProto(quote), nil
+}
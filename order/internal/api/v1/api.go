@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"net/http"
 
 	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
 )
@@ -11,6 +12,20 @@ type OrderApi interface {
 	PayOrder(ctx context.Context, req *orderV1.PayOrderRequest, params orderV1.PayOrderParams) (orderV1.PayOrderRes, error)
 	GetOrder(ctx context.Context, params orderV1.GetOrderParams) (orderV1.GetOrderRes, error)
 	CancelOrder(ctx context.Context, params orderV1.CancelOrderParams) (orderV1.CancelOrderRes, error)
+	UpdateOrder(ctx context.Context, req *orderV1.UpdateOrderRequest, params orderV1.UpdateOrderParams) (orderV1.UpdateOrderRes, error)
+	QuoteOrder(ctx context.Context, req *orderV1.QuoteRequest, params orderV1.QuoteOrderParams) (orderV1.QuoteOrderRes, error)
+	ListOrders(ctx context.Context, params orderV1.ListOrdersParams) (orderV1.ListOrdersRes, error)
+	GetOrderStatusHistory(ctx context.Context, params orderV1.GetOrderStatusHistoryParams) (orderV1.GetOrderStatusHistoryRes, error)
 	Health(ctx context.Context) (orderV1.HealthRes, error)
+	CreatePromoCode(ctx context.Context, req *orderV1.CreatePromoCodeRequest, params orderV1.CreatePromoCodeParams) (orderV1.CreatePromoCodeRes, error)
+	GetPromoCode(ctx context.Context, params orderV1.GetPromoCodeParams) (orderV1.GetPromoCodeRes, error)
+	ListPromoCodes(ctx context.Context, params orderV1.ListPromoCodesParams) (orderV1.ListPromoCodesRes, error)
+	DeactivatePromoCode(ctx context.Context, params orderV1.DeactivatePromoCodeParams) (orderV1.DeactivatePromoCodeRes, error)
+	GetCart(ctx context.Context, params orderV1.GetCartParams) (orderV1.GetCartRes, error)
+	AddCartItem(ctx context.Context, req *orderV1.OrderItemRequest, params orderV1.AddCartItemParams) (orderV1.AddCartItemRes, error)
+	RemoveCartItem(ctx context.Context, params orderV1.RemoveCartItemParams) (orderV1.RemoveCartItemRes, error)
+	CheckoutCart(ctx context.Context, req *orderV1.CheckoutCartRequest, params orderV1.CheckoutCartParams) (orderV1.CheckoutCartRes, error)
+	// StreamOrderEvents обслуживает text/event-stream, который ogen не поддерживает, поэтому это обычный http-обработчик.
+	StreamOrderEvents(w http.ResponseWriter, r *http.Request)
 	NewError(_ context.Context, err error) *orderV1.GenericErrorStatusCode
 }
@@ -3,17 +3,32 @@ package order
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/ZanDattSu/star-factory/order/internal/service"
+	httpmiddleware "github.com/ZanDattSu/star-factory/platform/pkg/middleware/http"
 	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
 )
 
 type api struct {
-	orderService service.OrderService
+	orderService         service.OrderService
+	promoCodeService     service.PromoCodeService
+	cartService          service.CartService
+	sseHeartbeatInterval time.Duration
 }
 
-func NewApi(orderService service.OrderService) *api {
-	return &api{orderService: orderService}
+func NewApi(
+	orderService service.OrderService,
+	promoCodeService service.PromoCodeService,
+	cartService service.CartService,
+	sseHeartbeatInterval time.Duration,
+) *api {
+	return &api{
+		orderService:         orderService,
+		promoCodeService:     promoCodeService,
+		cartService:          cartService,
+		sseHeartbeatInterval: sseHeartbeatInterval,
+	}
 }
 
 func (a *api) NewError(_ context.Context, err error) *orderV1.GenericErrorStatusCode {
@@ -25,3 +40,19 @@ func (a *api) NewError(_ context.Context, err error) *orderV1.GenericErrorStatus
 		},
 	}
 }
+
+// sessionUserUUID возвращает UUID пользователя, которого AuthMiddleware положил в контекст.
+func sessionUserUUID(ctx context.Context) (string, bool) {
+	user, ok := httpmiddleware.GetUserFromContext(ctx)
+	if !ok || user.GetUuid() == "" {
+		return "", false
+	}
+	return user.GetUuid(), true
+}
+
+func unauthorizedError() *orderV1.UnauthorizedError {
+	return &orderV1.UnauthorizedError{
+		Code:    401,
+		Message: "user is not authenticated",
+	}
+}
@@ -17,18 +17,30 @@ func (a *api) CancelOrder(ctx context.Context, params orderV1.CancelOrderParams)
 		}, nil
 	}
 
-	err := a.orderService.CancelOrder(ctx, params.OrderUUID)
+	userUUID, ok := sessionUserUUID(ctx)
+	if !ok {
+		return unauthorizedError(), nil
+	}
+
+	err := a.orderService.CancelOrder(ctx, userUUID, params.OrderUUID)
 	if err != nil {
 
 		notFound := &model.OrderNotFoundError{}
+		forbidden := &model.ForbiddenError{}
 		conflict := &model.ConflictError{}
+		versionConflict := &model.OrderVersionConflictError{}
 		switch {
 		case errors.As(err, &notFound):
 			return &orderV1.NotFoundError{
 				Code:    404,
 				Message: fmt.Sprintf("CancelOrder err: %s", err),
 			}, nil
-		case errors.As(err, &conflict):
+		case errors.As(err, &forbidden):
+			return &orderV1.ForbiddenError{
+				Code:    403,
+				Message: forbidden.Message,
+			}, nil
+		case errors.As(err, &conflict), errors.As(err, &versionConflict):
 			return &orderV1.ConflictError{
 				Code:    409,
 				Message: err.Error(),
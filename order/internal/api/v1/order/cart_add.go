@@ -0,0 +1,44 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	inventoryV1 "github.com/ZanDattSu/star-factory/order/internal/client/grpc/inventory/v1"
+	api2 "github.com/ZanDattSu/star-factory/order/internal/converter/api"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) AddCartItem(ctx context.Context, req *orderV1.OrderItemRequest, _ orderV1.AddCartItemParams) (orderV1.AddCartItemRes, error) {
+	userUUID, ok := sessionUserUUID(ctx)
+	if !ok {
+		return unauthorizedError(), nil
+	}
+
+	cart, err := a.cartService.AddCartItem(ctx, userUUID, req.PartUUID, req.Quantity)
+	if err != nil {
+		badRequest := &model.BadRequestError{}
+		partNotFound := &inventoryV1.PartsNotFoundError{}
+		switch {
+		case errors.As(err, &badRequest):
+			return &orderV1.BadRequestError{
+				Code:    400,
+				Message: badRequest.Message,
+			}, nil
+		case errors.As(err, &partNotFound):
+			return &orderV1.NotFoundError{
+				Code:    404,
+				Message: fmt.Sprintf("part not found: %s", err),
+			}, nil
+		default:
+			return &orderV1.InternalServerError{
+				Code:    500,
+				Message: fmt.Sprintf("internal server error: %s", err),
+			}, nil
+		}
+	}
+
+	return api2.CartToAPI(cart), nil
+}
@@ -0,0 +1,75 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	inventoryV1 "github.com/ZanDattSu/star-factory/order/internal/client/grpc/inventory/v1"
+	api2 "github.com/ZanDattSu/star-factory/order/internal/converter/api"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) CheckoutCart(ctx context.Context, req *orderV1.CheckoutCartRequest, params orderV1.CheckoutCartParams) (orderV1.CheckoutCartRes, error) {
+	userUUID, ok := sessionUserUUID(ctx)
+	if !ok {
+		return unauthorizedError(), nil
+	}
+
+	orderUUID, price, err := a.orderService.CheckoutCart(
+		ctx,
+		userUUID,
+		req.PromoCode.Or(""),
+		req.QuoteToken.Or(""),
+		params.IdempotencyKey.Or(""),
+	)
+	if err != nil {
+		badRequest := &model.BadRequestError{}
+		partNotFound := &inventoryV1.PartsNotFoundError{}
+		shortage := &model.InsufficientStockError{}
+		conflict := &model.ConflictError{}
+		blueprint := &model.BlueprintViolationError{}
+		switch {
+		case errors.As(err, &badRequest):
+			return &orderV1.BadRequestError{
+				Code:    400,
+				Message: badRequest.Message,
+			}, nil
+		case errors.As(err, &partNotFound):
+			return &orderV1.NotFoundError{
+				Code:    404,
+				Message: fmt.Sprintf("one or more parts not found: %s", err),
+			}, nil
+		case errors.As(err, &shortage):
+			return &orderV1.ConflictError{
+				Code:      409,
+				Message:   shortage.Error(),
+				PartUuids: shortage.PartUUIDs,
+			}, nil
+		case errors.As(err, &conflict):
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: conflict.Message,
+			}, nil
+		case errors.As(err, &blueprint):
+			return &orderV1.ValidationError{
+				Code:       422,
+				Message:    blueprint.Error(),
+				Violations: blueprint.Violations,
+			}, nil
+		default:
+			return &orderV1.InternalServerError{
+				Code:    500,
+				Message: fmt.Sprintf("internal server error: %s", err),
+			}, nil
+		}
+	}
+
+	return &orderV1.CreateOrderResponse{
+		OrderUUID:  orderUUID,
+		Subtotal:   api2.MoneyToAPI(price.Subtotal),
+		Discount:   api2.MoneyToAPI(price.Discount),
+		TotalPrice: api2.MoneyToAPI(price.Total),
+	}, nil
+}
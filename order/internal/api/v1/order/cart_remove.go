@@ -0,0 +1,26 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	api2 "github.com/ZanDattSu/star-factory/order/internal/converter/api"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) RemoveCartItem(ctx context.Context, params orderV1.RemoveCartItemParams) (orderV1.RemoveCartItemRes, error) {
+	userUUID, ok := sessionUserUUID(ctx)
+	if !ok {
+		return unauthorizedError(), nil
+	}
+
+	cart, err := a.cartService.RemoveCartItem(ctx, userUUID, params.PartUUID)
+	if err != nil {
+		return &orderV1.InternalServerError{
+			Code:    500,
+			Message: fmt.Sprintf("internal server error: %s", err),
+		}, nil
+	}
+
+	return api2.CartToAPI(cart), nil
+}
@@ -6,26 +6,55 @@ import (
 	"fmt"
 
 	inventoryV1 "github.com/ZanDattSu/star-factory/order/internal/client/grpc/inventory/v1"
+	api2 "github.com/ZanDattSu/star-factory/order/internal/converter/api"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
 	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
 )
 
-func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest, _ orderV1.CreateOrderParams) (orderV1.CreateOrderRes, error) {
-	if req.UserUUID == "" {
-		return &orderV1.BadRequestError{
-			Code:    400,
-			Message: "user UUID should be not empty",
+func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest, params orderV1.CreateOrderParams) (orderV1.CreateOrderRes, error) {
+	userUUID, ok := sessionUserUUID(ctx)
+	if !ok {
+		return unauthorizedError(), nil
+	}
+
+	// user_uuid в теле устарел: допускаем его только если он совпадает с пользователем сессии
+	if bodyUserUUID, set := req.UserUUID.Get(); set && bodyUserUUID != userUUID { //nolint:staticcheck
+		return &orderV1.ForbiddenError{
+			Code:    403,
+			Message: "user UUID does not match the session user",
 		}, nil
 	}
 
-	if len(req.PartUuids) == 0 {
+	items := api2.OrderItemsFromAPI(req.Items)
+	// part_uuids устарел и учитывается, только если items не передан
+	if len(items) == 0 {
+		items = model.OrderItemsFromParts(req.PartUuids) //nolint:staticcheck
+	}
+
+	quoteToken := req.QuoteToken.Or("")
+	// С токеном расчёта позиции берутся из расчёта
+	if len(items) == 0 && quoteToken == "" {
 		return &orderV1.BadRequestError{
 			Code:    400,
-			Message: "parts UUIDs should be contains at least 1 part",
+			Message: "order should contain at least 1 item",
 		}, nil
 	}
 
-	orderUUID, totalPrice, err := a.orderService.CreateOrder(ctx, req.UserUUID, req.PartUuids)
+	orderReq := model.OrderRequest{
+		Items:      items,
+		PromoCode:  req.PromoCode.Or(""),
+		QuoteToken: quoteToken,
+	}
+
+	orderUUID, price, err := a.orderService.CreateOrder(ctx, userUUID, orderReq, params.IdempotencyKey.Or(""))
 	if err != nil {
+		badRequest := &model.BadRequestError{}
+		if errors.As(err, &badRequest) {
+			return &orderV1.BadRequestError{
+				Code:    400,
+				Message: badRequest.Message,
+			}, nil
+		}
 		partNotFound := &inventoryV1.PartsNotFoundError{}
 		if errors.As(err, &partNotFound) {
 			return &orderV1.NotFoundError{
@@ -33,6 +62,29 @@ func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest,
 				Message: fmt.Sprintf("one or more parts not found: %s", err),
 			}, nil
 		}
+		shortage := &model.InsufficientStockError{}
+		if errors.As(err, &shortage) {
+			return &orderV1.ConflictError{
+				Code:      409,
+				Message:   shortage.Error(),
+				PartUuids: shortage.PartUUIDs,
+			}, nil
+		}
+		conflict := &model.ConflictError{}
+		if errors.As(err, &conflict) {
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: conflict.Message,
+			}, nil
+		}
+		blueprint := &model.BlueprintViolationError{}
+		if errors.As(err, &blueprint) {
+			return &orderV1.ValidationError{
+				Code:       422,
+				Message:    blueprint.Error(),
+				Violations: blueprint.Violations,
+			}, nil
+		}
 		return &orderV1.InternalServerError{
 			Code:    500,
 			Message: fmt.Sprintf("inventory service internal error: %v", err),
@@ -41,6 +93,8 @@ func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest,
 
 	return &orderV1.CreateOrderResponse{
 		OrderUUID:  orderUUID,
-		TotalPrice: totalPrice,
+		Subtotal:   api2.MoneyToAPI(price.Subtotal),
+		Discount:   api2.MoneyToAPI(price.Discount),
+		TotalPrice: api2.MoneyToAPI(price.Total),
 	}, nil
 }
@@ -0,0 +1,152 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+const (
+	orderUUIDParam    = "order_uuid"
+	lastEventIDHeader = "Last-Event-ID"
+	statusChangedType = "status_changed"
+)
+
+// StreamOrderEvents отдаёт переходы статуса заказа в формате text/event-stream.
+// id события — порядковый номер перехода в истории статусов, поэтому после переподключения
+// клиент продолжает поток с заголовком Last-Event-ID и не теряет переходы.
+// Кроме сигналов внутри процесса история перечитывается на каждом heartbeat,
+// чтобы подписчик увидел и переходы, выполненные другими репликами.
+func (a *api) StreamOrderEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	orderUUID := chi.URLParam(r, orderUUIDParam)
+	if orderUUID == "" {
+		writeStreamError(w, http.StatusBadRequest, "order UUID should be not empty")
+		return
+	}
+
+	userUUID, ok := sessionUserUUID(ctx)
+	if !ok {
+		writeStreamError(w, http.StatusUnauthorized, unauthorizedError().Message)
+		return
+	}
+
+	lastEventID, err := parseLastEventID(r.Header.Get(lastEventIDHeader))
+	if err != nil {
+		writeStreamError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updates, unsubscribe, err := a.orderService.WatchOrderStatus(ctx, userUUID, orderUUID)
+	if err != nil {
+		notFound := &model.OrderNotFoundError{}
+		forbidden := &model.ForbiddenError{}
+		switch {
+		case errors.As(err, &notFound):
+			writeStreamError(w, http.StatusNotFound, err.Error())
+		case errors.As(err, &forbidden):
+			writeStreamError(w, http.StatusForbidden, forbidden.Message)
+		default:
+			writeStreamError(w, http.StatusInternalServerError, fmt.Sprintf("internal server error: %s", err))
+		}
+		return
+	}
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Отключаем буферизацию ответа в nginx, иначе события приходят пачками
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	heartbeat := time.NewTicker(a.sseHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	sent := lastEventID
+	for {
+		sent, err = a.writeStatusChanges(ctx, w, userUUID, orderUUID, sent)
+		if err != nil {
+			logger.Warn(ctx, "Order events stream closed",
+				zap.String("order_uuid", orderUUID),
+				zap.Error(err),
+			)
+			return
+		}
+		if err = rc.Flush(); err != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-updates:
+		case <-heartbeat.C:
+			if _, err = io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// writeStatusChanges пишет в поток переходы из истории статусов, идущие после sent,
+// и возвращает номер последнего отправленного перехода.
+func (a *api) writeStatusChanges(ctx context.Context, w io.Writer, userUUID, orderUUID string, sent int) (int, error) {
+	history, err := a.orderService.GetOrderStatusHistory(ctx, userUUID, orderUUID)
+	if err != nil {
+		return sent, err
+	}
+
+	for ; sent < len(history); sent++ {
+		data, err := json.Marshal(history[sent])
+		if err != nil {
+			return sent, fmt.Errorf("failed to marshal status change: %w", err)
+		}
+
+		_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", sent+1, statusChangedType, data)
+		if err != nil {
+			return sent, err
+		}
+	}
+
+	return sent, nil
+}
+
+func parseLastEventID(header string) (int, error) {
+	if header == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.Atoi(header)
+	if err != nil || id < 0 {
+		return 0, fmt.Errorf("invalid %s header: %q", lastEventIDHeader, header)
+	}
+	return id, nil
+}
+
+// writeStreamError отвечает ошибкой в том же формате, что и остальные ручки OpenAPI.
+func writeStreamError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	_ = json.NewEncoder(w).Encode(struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}{
+		Code:    code,
+		Message: message,
+	})
+}
@@ -18,13 +18,26 @@ func (a *api) GetOrder(ctx context.Context, params orderV1.GetOrderParams) (orde
 		}, nil
 	}
 
-	order, err := a.orderService.GetOrder(ctx, params.OrderUUID)
+	userUUID, ok := sessionUserUUID(ctx)
+	if !ok {
+		return unauthorizedError(), nil
+	}
+
+	order, err := a.orderService.GetOrder(ctx, userUUID, params.OrderUUID)
 	if err != nil {
 		notFound := &model.OrderNotFoundError{}
-		if errors.As(err, &notFound) {
+		forbidden := &model.ForbiddenError{}
+		switch {
+		case errors.As(err, &notFound):
 			return nil, fmt.Errorf("order not found error: %w", err)
+		case errors.As(err, &forbidden):
+			return &orderV1.ForbiddenError{
+				Code:    403,
+				Message: forbidden.Message,
+			}, nil
+		default:
+			return nil, fmt.Errorf("get order error: %w", err)
 		}
-		return nil, fmt.Errorf("get order error: %w", err)
 	}
 
 	return api2.OrderToAPI(order), nil
@@ -0,0 +1,52 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	api2 "github.com/ZanDattSu/star-factory/order/internal/converter/api"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) GetOrderStatusHistory(ctx context.Context, params orderV1.GetOrderStatusHistoryParams) (orderV1.GetOrderStatusHistoryRes, error) {
+	if params.OrderUUID == "" {
+		return &orderV1.BadRequestError{
+			Code:    400,
+			Message: "order UUID should be not empty",
+		}, nil
+	}
+
+	userUUID, ok := sessionUserUUID(ctx)
+	if !ok {
+		return unauthorizedError(), nil
+	}
+
+	history, err := a.orderService.GetOrderStatusHistory(ctx, userUUID, params.OrderUUID)
+	if err != nil {
+		notFound := &model.OrderNotFoundError{}
+		forbidden := &model.ForbiddenError{}
+		switch {
+		case errors.As(err, &notFound):
+			return &orderV1.NotFoundError{
+				Code:    404,
+				Message: err.Error(),
+			}, nil
+		case errors.As(err, &forbidden):
+			return &orderV1.ForbiddenError{
+				Code:    403,
+				Message: forbidden.Message,
+			}, nil
+		default:
+			return &orderV1.InternalServerError{
+				Code:    500,
+				Message: fmt.Sprintf("internal server error: %s", err),
+			}, nil
+		}
+	}
+
+	return &orderV1.OrderStatusHistoryResponse{
+		History: api2.OrderStatusHistoryToAPI(history),
+	}, nil
+}
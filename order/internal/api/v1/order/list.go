@@ -0,0 +1,46 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	api2 "github.com/ZanDattSu/star-factory/order/internal/converter/api"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) ListOrders(ctx context.Context, params orderV1.ListOrdersParams) (orderV1.ListOrdersRes, error) {
+	userUUID, ok := sessionUserUUID(ctx)
+	if !ok {
+		return unauthorizedError(), nil
+	}
+
+	orders, nextCursor, err := a.orderService.ListOrders(
+		ctx,
+		api2.OrdersFilterFromAPI(userUUID, params),
+		params.Cursor.Or(""),
+	)
+	if err != nil {
+		badRequest := &model.BadRequestError{}
+		if errors.As(err, &badRequest) {
+			return &orderV1.BadRequestError{
+				Code:    400,
+				Message: badRequest.Message,
+			}, nil
+		}
+		return &orderV1.InternalServerError{
+			Code:    500,
+			Message: fmt.Sprintf("internal server error: %s", err),
+		}, nil
+	}
+
+	res := &orderV1.ListOrdersResponse{
+		Orders: api2.OrdersToAPI(orders),
+	}
+	if nextCursor != "" {
+		res.NextCursor = orderV1.NewOptString(nextCursor)
+	}
+
+	return res, nil
+}
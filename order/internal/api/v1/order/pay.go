@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	api2 "github.com/ZanDattSu/star-factory/order/internal/converter/api"
 	"github.com/ZanDattSu/star-factory/order/internal/model"
@@ -32,19 +33,58 @@ func (a *api) PayOrder(ctx context.Context, req *orderV1.PayOrderRequest, params
 		}, nil
 	}
 
-	transactionUUID, err := a.orderService.PayOrder(ctx, api2.PaymentMethodToModel(req.PaymentMethod), params.OrderUUID)
+	userUUID, ok := sessionUserUUID(ctx)
+	if !ok {
+		return unauthorizedError(), nil
+	}
+
+	transactionUUID, err := a.orderService.PayOrder(ctx, userUUID, api2.PaymentMethodToModel(req.PaymentMethod), params.OrderUUID, params.IdempotencyKey.Or(""))
 	if err != nil {
+		badRequest := &model.BadRequestError{}
 		notFound := &model.OrderNotFoundError{}
-		if errors.As(err, &notFound) {
+		forbidden := &model.ForbiddenError{}
+		priceChanged := &model.PriceChangedError{}
+		conflict := &model.ConflictError{}
+		versionConflict := &model.OrderVersionConflictError{}
+		switch {
+		case errors.As(err, &badRequest):
+			return &orderV1.BadRequestError{
+				Code:    400,
+				Message: badRequest.Message,
+			}, nil
+		case errors.As(err, &notFound):
 			return &orderV1.NotFoundError{
 				Code:    404,
 				Message: fmt.Sprintf("one or more parts not found: %s", err),
 			}, nil
+		case errors.As(err, &forbidden):
+			return &orderV1.ForbiddenError{
+				Code:    403,
+				Message: forbidden.Message,
+			}, nil
+		case errors.As(err, &priceChanged):
+			return &orderV1.ConflictError{
+				Code:      409,
+				Message:   "order prices changed since creation, review the new quote before paying",
+				PartUuids: slices.Concat(priceChanged.ChangedPartUUIDs, priceChanged.UnavailablePartUUIDs),
+				Quote:     orderV1.NewOptPriceQuote(api2.PriceQuoteToAPI(priceChanged)),
+			}, nil
+		case errors.As(err, &conflict):
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: conflict.Message,
+			}, nil
+		case errors.As(err, &versionConflict):
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: versionConflict.Error(),
+			}, nil
+		default:
+			return &orderV1.InternalServerError{
+				Code:    500,
+				Message: fmt.Sprintf("payment service internal error: %v", err),
+			}, nil
 		}
-		return &orderV1.InternalServerError{
-			Code:    500,
-			Message: fmt.Sprintf("payment service internal error: %v", err),
-		}, nil
 	}
 
 	return &orderV1.PayOrderResponse{
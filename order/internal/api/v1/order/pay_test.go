@@ -0,0 +1,47 @@
+package order
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
+)
+
+func (s *SuiteAPI) TestPayOrderBadRequest() {
+	orderUUID := gofakeit.UUID()
+
+	s.orderService.
+		On("PayOrder", s.ctx, s.userUUID, model.PaymentMethodCard, orderUUID, "").
+		Return("", model.NewBadRequestError("invalid payment request")).
+		Once()
+
+	res, err := s.api.PayOrder(s.ctx,
+		&orderV1.PayOrderRequest{PaymentMethod: orderV1.PaymentMethodCARD},
+		orderV1.PayOrderParams{OrderUUID: orderUUID},
+	)
+
+	s.Require().NoError(err)
+	badRequest, ok := res.(*orderV1.BadRequestError)
+	s.Require().True(ok, "unexpected response %T", res)
+	s.Require().Equal(400, badRequest.Code)
+	s.Require().Equal("invalid payment request", badRequest.Message)
+}
+
+func (s *SuiteAPI) TestPayOrderVersionConflict() {
+	orderUUID := gofakeit.UUID()
+
+	s.orderService.
+		On("PayOrder", s.ctx, s.userUUID, model.PaymentMethodCard, orderUUID, "").
+		Return("", model.NewOrderVersionConflictError(orderUUID, 1, 2)).
+		Once()
+
+	res, err := s.api.PayOrder(s.ctx,
+		&orderV1.PayOrderRequest{PaymentMethod: orderV1.PaymentMethodCARD},
+		orderV1.PayOrderParams{OrderUUID: orderUUID},
+	)
+
+	s.Require().NoError(err)
+	conflict, ok := res.(*orderV1.ConflictError)
+	s.Require().True(ok, "unexpected response %T", res)
+	s.Require().Equal(409, conflict.Code)
+}
@@ -0,0 +1,49 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	api2 "github.com/ZanDattSu/star-factory/order/internal/converter/api"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) CreatePromoCode(ctx context.Context, req *orderV1.CreatePromoCodeRequest, _ orderV1.CreatePromoCodeParams) (orderV1.CreatePromoCodeRes, error) {
+	userUUID, ok := sessionUserUUID(ctx)
+	if !ok {
+		return unauthorizedError(), nil
+	}
+
+	promo, err := a.promoCodeService.CreatePromoCode(ctx, userUUID, api2.PromoCodeFromAPI(req))
+	if err != nil {
+		badRequest := &model.BadRequestError{}
+		forbidden := &model.ForbiddenError{}
+		conflict := &model.ConflictError{}
+		switch {
+		case errors.As(err, &badRequest):
+			return &orderV1.BadRequestError{
+				Code:    400,
+				Message: badRequest.Message,
+			}, nil
+		case errors.As(err, &forbidden):
+			return &orderV1.ForbiddenError{
+				Code:    403,
+				Message: forbidden.Message,
+			}, nil
+		case errors.As(err, &conflict):
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: conflict.Message,
+			}, nil
+		default:
+			return &orderV1.InternalServerError{
+				Code:    500,
+				Message: fmt.Sprintf("internal server error: %s", err),
+			}, nil
+		}
+	}
+
+	return api2.PromoCodeToAPI(promo), nil
+}
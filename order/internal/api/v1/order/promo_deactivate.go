@@ -0,0 +1,43 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	api2 "github.com/ZanDattSu/star-factory/order/internal/converter/api"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) DeactivatePromoCode(ctx context.Context, params orderV1.DeactivatePromoCodeParams) (orderV1.DeactivatePromoCodeRes, error) {
+	userUUID, ok := sessionUserUUID(ctx)
+	if !ok {
+		return unauthorizedError(), nil
+	}
+
+	promo, err := a.promoCodeService.DeactivatePromoCode(ctx, userUUID, params.Code)
+	if err != nil {
+		notFound := &model.PromoCodeNotFoundError{}
+		forbidden := &model.ForbiddenError{}
+		switch {
+		case errors.As(err, &notFound):
+			return &orderV1.NotFoundError{
+				Code:    404,
+				Message: notFound.Error(),
+			}, nil
+		case errors.As(err, &forbidden):
+			return &orderV1.ForbiddenError{
+				Code:    403,
+				Message: forbidden.Message,
+			}, nil
+		default:
+			return &orderV1.InternalServerError{
+				Code:    500,
+				Message: fmt.Sprintf("internal server error: %s", err),
+			}, nil
+		}
+	}
+
+	return api2.PromoCodeToAPI(promo), nil
+}
@@ -0,0 +1,37 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	api2 "github.com/ZanDattSu/star-factory/order/internal/converter/api"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) ListPromoCodes(ctx context.Context, _ orderV1.ListPromoCodesParams) (orderV1.ListPromoCodesRes, error) {
+	userUUID, ok := sessionUserUUID(ctx)
+	if !ok {
+		return unauthorizedError(), nil
+	}
+
+	promos, err := a.promoCodeService.ListPromoCodes(ctx, userUUID)
+	if err != nil {
+		forbidden := &model.ForbiddenError{}
+		if errors.As(err, &forbidden) {
+			return &orderV1.ForbiddenError{
+				Code:    403,
+				Message: forbidden.Message,
+			}, nil
+		}
+		return &orderV1.InternalServerError{
+			Code:    500,
+			Message: fmt.Sprintf("internal server error: %s", err),
+		}, nil
+	}
+
+	return &orderV1.ListPromoCodesResponse{
+		PromoCodes: api2.PromoCodesToAPI(promos),
+	}, nil
+}
@@ -0,0 +1,58 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	inventoryV1 "github.com/ZanDattSu/star-factory/order/internal/client/grpc/inventory/v1"
+	api2 "github.com/ZanDattSu/star-factory/order/internal/converter/api"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) QuoteOrder(ctx context.Context, req *orderV1.QuoteRequest, _ orderV1.QuoteOrderParams) (orderV1.QuoteOrderRes, error) {
+	userUUID, ok := sessionUserUUID(ctx)
+	if !ok {
+		return unauthorizedError(), nil
+	}
+
+	quote, err := a.orderService.QuoteOrder(ctx, userUUID, api2.OrderItemsFromAPI(req.Items), req.PromoCode.Or(""))
+	if err != nil {
+		badRequest := &model.BadRequestError{}
+		partNotFound := &inventoryV1.PartsNotFoundError{}
+		shortage := &model.InsufficientStockError{}
+		blueprint := &model.BlueprintViolationError{}
+		switch {
+		case errors.As(err, &badRequest):
+			return &orderV1.BadRequestError{
+				Code:    400,
+				Message: badRequest.Message,
+			}, nil
+		case errors.As(err, &partNotFound):
+			return &orderV1.NotFoundError{
+				Code:    404,
+				Message: fmt.Sprintf("one or more parts not found: %s", err),
+			}, nil
+		case errors.As(err, &shortage):
+			return &orderV1.ConflictError{
+				Code:      409,
+				Message:   shortage.Error(),
+				PartUuids: shortage.PartUUIDs,
+			}, nil
+		case errors.As(err, &blueprint):
+			return &orderV1.ValidationError{
+				Code:       422,
+				Message:    blueprint.Error(),
+				Violations: blueprint.Violations,
+			}, nil
+		default:
+			return &orderV1.InternalServerError{
+				Code:    500,
+				Message: fmt.Sprintf("internal server error: %s", err),
+			}, nil
+		}
+	}
+
+	return api2.QuoteToAPI(quote), nil
+}
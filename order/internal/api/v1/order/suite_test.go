@@ -0,0 +1,46 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ZanDattSu/star-factory/order/internal/service/mocks"
+	"github.com/ZanDattSu/star-factory/platform/pkg/grpc/interceptor"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+	commonV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/common/v1"
+)
+
+type SuiteAPI struct {
+	suite.Suite
+
+	ctx context.Context //nolint:containedctx
+
+	userUUID string
+
+	orderService     *mocks.OrderService
+	promoCodeService *mocks.PromoCodeService
+	cartService      *mocks.CartService
+
+	api *api
+}
+
+func (s *SuiteAPI) SetupTest() {
+	s.userUUID = gofakeit.UUID()
+	// Пользователя в контекст кладёт AuthMiddleware
+	s.ctx = interceptor.AddUserToContext(context.Background(), &commonV1.User{Uuid: s.userUUID})
+
+	s.orderService = mocks.NewOrderService(s.T())
+	s.promoCodeService = mocks.NewPromoCodeService(s.T())
+	s.cartService = mocks.NewCartService(s.T())
+
+	s.api = NewApi(s.orderService, s.promoCodeService, s.cartService, time.Second)
+	logger.SetNopLogger()
+}
+
+func TestAPIIntegration(t *testing.T) {
+	suite.Run(t, new(SuiteAPI))
+}
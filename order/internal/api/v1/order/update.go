@@ -0,0 +1,89 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	inventoryV1 "github.com/ZanDattSu/star-factory/order/internal/client/grpc/inventory/v1"
+	api2 "github.com/ZanDattSu/star-factory/order/internal/converter/api"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) UpdateOrder(ctx context.Context, req *orderV1.UpdateOrderRequest, params orderV1.UpdateOrderParams) (orderV1.UpdateOrderRes, error) {
+	if params.OrderUUID == "" {
+		return &orderV1.BadRequestError{
+			Code:    400,
+			Message: "order UUID should be not empty",
+		}, nil
+	}
+
+	userUUID, ok := sessionUserUUID(ctx)
+	if !ok {
+		return unauthorizedError(), nil
+	}
+
+	order, err := a.orderService.UpdateOrderItems(ctx, userUUID, params.OrderUUID, api2.OrderItemsFromAPI(req.Items), req.Version)
+	if err != nil {
+		badRequest := &model.BadRequestError{}
+		notFound := &model.OrderNotFoundError{}
+		partNotFound := &inventoryV1.PartsNotFoundError{}
+		forbidden := &model.ForbiddenError{}
+		shortage := &model.InsufficientStockError{}
+		conflict := &model.ConflictError{}
+		versionConflict := &model.OrderVersionConflictError{}
+		blueprint := &model.BlueprintViolationError{}
+		switch {
+		case errors.As(err, &badRequest):
+			return &orderV1.BadRequestError{
+				Code:    400,
+				Message: badRequest.Message,
+			}, nil
+		case errors.As(err, &notFound):
+			return &orderV1.NotFoundError{
+				Code:    404,
+				Message: fmt.Sprintf("UpdateOrder err: %s", err),
+			}, nil
+		case errors.As(err, &partNotFound):
+			return &orderV1.NotFoundError{
+				Code:    404,
+				Message: fmt.Sprintf("one or more parts not found: %s", err),
+			}, nil
+		case errors.As(err, &forbidden):
+			return &orderV1.ForbiddenError{
+				Code:    403,
+				Message: forbidden.Message,
+			}, nil
+		case errors.As(err, &shortage):
+			return &orderV1.ConflictError{
+				Code:      409,
+				Message:   shortage.Error(),
+				PartUuids: shortage.PartUUIDs,
+			}, nil
+		case errors.As(err, &conflict):
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: conflict.Message,
+			}, nil
+		case errors.As(err, &versionConflict):
+			return &orderV1.ConflictError{
+				Code:    409,
+				Message: versionConflict.Error(),
+			}, nil
+		case errors.As(err, &blueprint):
+			return &orderV1.ValidationError{
+				Code:       422,
+				Message:    blueprint.Error(),
+				Violations: blueprint.Violations,
+			}, nil
+		default:
+			return &orderV1.InternalServerError{
+				Code:    500,
+				Message: fmt.Sprintf("internal server error: %s", err),
+			}, nil
+		}
+	}
+
+	return api2.OrderToAPI(order), nil
+}
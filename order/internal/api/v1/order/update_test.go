@@ -0,0 +1,36 @@
+package order
+
+import (
+	"fmt"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
+)
+
+func (s *SuiteAPI) TestUpdateOrderReservationReleasedConcurrently() {
+	orderUUID := gofakeit.UUID()
+
+	// Проигравшая параллельная правка не смогла снять резерв, который уже сняла победившая
+	s.orderService.
+		On("UpdateOrderItems", s.ctx, s.userUUID, orderUUID, mock.Anything, int64(3)).
+		Return(nil, fmt.Errorf("failed to release previous reservation: %w",
+			model.NewConflictError("parts reservation was already released or committed, reload the order and try again"),
+		)).
+		Once()
+
+	res, err := s.api.UpdateOrder(s.ctx,
+		&orderV1.UpdateOrderRequest{
+			Items:   []orderV1.OrderItemRequest{{PartUUID: gofakeit.UUID(), Quantity: 1}},
+			Version: 3,
+		},
+		orderV1.UpdateOrderParams{OrderUUID: orderUUID},
+	)
+
+	s.Require().NoError(err)
+	conflict, ok := res.(*orderV1.ConflictError)
+	s.Require().True(ok, "unexpected response %T", res)
+	s.Require().Equal(409, conflict.Code)
+}
@@ -8,17 +8,21 @@ import (
 
 	"github.com/jackc/pgx/v5/stdlib"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	"github.com/ZanDattSu/star-factory/order/internal/config"
 	"github.com/ZanDattSu/star-factory/order/internal/server"
 	"github.com/ZanDattSu/star-factory/platform/pkg/closer"
+	platformServer "github.com/ZanDattSu/star-factory/platform/pkg/grpc/server"
 	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
 	"github.com/ZanDattSu/star-factory/platform/pkg/migrator"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/order/v1"
 )
 
 type App struct {
 	diContainer *diContainer
 	server      *server.HTTPServer
+	gRPCServer  *platformServer.GRPCServer
 }
 
 func New(ctx context.Context) (*App, error) {
@@ -33,7 +37,7 @@ func New(ctx context.Context) (*App, error) {
 }
 
 func (a *App) Run(ctx context.Context) error {
-	errCh := make(chan error, 2)
+	errCh := make(chan error, 6)
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -43,11 +47,31 @@ func (a *App) Run(ctx context.Context) error {
 			errCh <- fmt.Errorf("HHTP server crashed: %w", err)
 		}
 	}()
+	go func() {
+		if err := a.runGRPCServer(ctx); err != nil {
+			errCh <- fmt.Errorf("gRPC server crashed: %w", err)
+		}
+	}()
 	go func() {
 		if err := a.runConsumer(ctx); err != nil {
 			errCh <- fmt.Errorf("consumer crashed: %w", err)
 		}
 	}()
+	go func() {
+		if err := a.runOutboxRelay(ctx); err != nil {
+			errCh <- fmt.Errorf("outbox relay crashed: %w", err)
+		}
+	}()
+	go func() {
+		if err := a.runOrderExpirySweeper(ctx); err != nil {
+			errCh <- fmt.Errorf("order expiry sweeper crashed: %w", err)
+		}
+	}()
+	go func() {
+		if err := a.runIdempotencyPurgeSweeper(ctx); err != nil {
+			errCh <- fmt.Errorf("idempotency purge sweeper crashed: %w", err)
+		}
+	}()
 
 	select {
 	case <-ctx.Done():
@@ -68,6 +92,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDI,
 		a.migratorUp,
 		a.initServer,
+		a.initGRPCServer,
 	}
 
 	for _, f := range inits {
@@ -116,6 +141,7 @@ func (a *App) initServer(ctx context.Context) error {
 	httpServer, err := server.NewHTTPServer(
 		config.AppConfig().OrderHTTP.OrderAddress(),
 		a.diContainer.OrderApi(ctx),
+		a.diContainer.OrderApi(ctx).StreamOrderEvents,
 		a.diContainer.AuthClient(ctx),
 	)
 	if err != nil {
@@ -142,6 +168,36 @@ func (a *App) runServer(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) initGRPCServer(ctx context.Context) error {
+	srv, err := platformServer.NewGRPCServer(
+		config.AppConfig().OrderGRPC.GRPCAddress(),
+		platformServer.Options{
+			Register: func(s *grpc.Server) {
+				orderV1.RegisterOrderServiceServer(s, a.diContainer.OrderGRPCApi(ctx))
+			},
+			Auth: a.diContainer.AuthInterceptor(ctx),
+		},
+	)
+	if err != nil {
+		logger.Error(ctx, "Failed to create gRPC server", zap.Error(err))
+		return err
+	}
+
+	a.gRPCServer = srv
+
+	closer.AddNamed("gRPC server", func(ctx context.Context) error {
+		a.gRPCServer.Shutdown()
+		return nil
+	})
+
+	return nil
+}
+
+func (a *App) runGRPCServer(ctx context.Context) error {
+	logger.Info(ctx, "gRPC server listening on: "+config.AppConfig().OrderGRPC.GRPCPort())
+	return a.gRPCServer.Serve()
+}
+
 func (a *App) runConsumer(ctx context.Context) error {
 	logger.Info(ctx, "Ship Assembled Kafka consumer starting")
 
@@ -152,3 +208,15 @@ func (a *App) runConsumer(ctx context.Context) error {
 
 	return nil
 }
+
+func (a *App) runOutboxRelay(ctx context.Context) error {
+	return a.diContainer.OutboxRelayService(ctx).RunRelay(ctx)
+}
+
+func (a *App) runOrderExpirySweeper(ctx context.Context) error {
+	return a.diContainer.OrderExpiryService(ctx).RunExpirySweeper(ctx)
+}
+
+func (a *App) runIdempotencyPurgeSweeper(ctx context.Context) error {
+	return a.diContainer.IdempotencyExpiryService(ctx).RunPurgeSweeper(ctx)
+}
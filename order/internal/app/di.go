@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	"github.com/IBM/sarama"
+	redigo "github.com/gomodule/redigo/redis"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	ordGRPCApi "github.com/ZanDattSu/star-factory/order/internal/api/grpc/v1/order"
 	orderApi "github.com/ZanDattSu/star-factory/order/internal/api/v1"
 	ordApi "github.com/ZanDattSu/star-factory/order/internal/api/v1/order"
 	gRPCClient "github.com/ZanDattSu/star-factory/order/internal/client/grpc"
@@ -16,13 +18,28 @@ import (
 	kafkaDecoder "github.com/ZanDattSu/star-factory/order/internal/converter/kafka"
 	"github.com/ZanDattSu/star-factory/order/internal/converter/kafka/decoder"
 	orderRepo "github.com/ZanDattSu/star-factory/order/internal/repository"
+	cartRepo "github.com/ZanDattSu/star-factory/order/internal/repository/cart/redis"
+	idempotencyRepo "github.com/ZanDattSu/star-factory/order/internal/repository/idempotency/postgresql"
 	"github.com/ZanDattSu/star-factory/order/internal/repository/order/postgresql"
+	outboxRepo "github.com/ZanDattSu/star-factory/order/internal/repository/outbox/postgresql"
+	promoRepo "github.com/ZanDattSu/star-factory/order/internal/repository/promo/postgresql"
+	quoteRepo "github.com/ZanDattSu/star-factory/order/internal/repository/quote/postgresql"
 	orderService "github.com/ZanDattSu/star-factory/order/internal/service"
+	cartService "github.com/ZanDattSu/star-factory/order/internal/service/cart"
 	"github.com/ZanDattSu/star-factory/order/internal/service/consumer/order_consumer"
+	"github.com/ZanDattSu/star-factory/order/internal/service/expiry/idempotency_expiry"
+	"github.com/ZanDattSu/star-factory/order/internal/service/expiry/order_expiry"
+	"github.com/ZanDattSu/star-factory/order/internal/service/notifier/status_notifier"
 	ordService "github.com/ZanDattSu/star-factory/order/internal/service/order"
 	"github.com/ZanDattSu/star-factory/order/internal/service/produser/order_producer"
+	promoService "github.com/ZanDattSu/star-factory/order/internal/service/promo"
+	"github.com/ZanDattSu/star-factory/order/internal/service/relay/outbox_relay"
+	"github.com/ZanDattSu/star-factory/platform/pkg/admin"
+	"github.com/ZanDattSu/star-factory/platform/pkg/cache"
+	rediscache "github.com/ZanDattSu/star-factory/platform/pkg/cache/redis"
 	"github.com/ZanDattSu/star-factory/platform/pkg/closer"
 	grpcclient "github.com/ZanDattSu/star-factory/platform/pkg/grpc"
+	"github.com/ZanDattSu/star-factory/platform/pkg/grpc/interceptor"
 	wrappedKafka "github.com/ZanDattSu/star-factory/platform/pkg/kafka"
 	wrappedKafkaConsumer "github.com/ZanDattSu/star-factory/platform/pkg/kafka/consumer"
 	wrappedKafkaProducer "github.com/ZanDattSu/star-factory/platform/pkg/kafka/producer"
@@ -30,20 +47,37 @@ import (
 	kafkaMiddleware "github.com/ZanDattSu/star-factory/platform/pkg/middleware/kafka"
 	authV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/auth/v1"
 	inventoryV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/inventory/v1"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/order/v1"
 	paymentV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/payment/v1"
 )
 
 type diContainer struct {
 	// API
-	orderApi orderApi.OrderApi
+	orderApi     orderApi.OrderApi
+	orderGRPCApi orderV1.OrderServiceServer
 
 	// Services
-	orderService            orderService.OrderService
-	assemblyConsumerService orderService.ConsumerService
-	orderProducerService    orderService.OrderProducerService
+	orderService             orderService.OrderService
+	assemblyConsumerService  orderService.ConsumerService
+	orderProducerService     orderService.OrderProducerService
+	outboxRelayService       orderService.OutboxRelayService
+	orderExpiryService       orderService.OrderExpiryService
+	idempotencyExpiryService orderService.IdempotencyExpiryService
+	orderStatusNotifier      orderService.OrderStatusNotifier
+	promoCodeService         orderService.PromoCodeService
+	cartService              orderService.CartService
 
 	// Repository
-	orderRepository orderRepo.OrderRepository
+	orderRepository       orderRepo.OrderRepository
+	outboxRepository      orderRepo.OutboxRepository
+	idempotencyRepository orderRepo.IdempotencyRepository
+	promoCodeRepository   orderRepo.PromoCodeRepository
+	quoteRepository       orderRepo.QuoteRepository
+	cartRepository        orderRepo.CartRepository
+
+	// gRPC Interceptors
+	authInterceptor *interceptor.AuthInterceptor
+	adminChecker    *admin.Checker
 
 	// gRPC Clients
 	authClient      authV1.AuthServiceClient
@@ -53,14 +87,23 @@ type diContainer struct {
 	// PostgreSQL
 	postgreSQLPool *pgxpool.Pool
 
+	// Redis
+	redisClient cache.RedisClient
+	redisPool   *redigo.Pool
+
 	// Kafka Decoder
 	assemblyDecoder kafkaDecoder.ShipAssembledDecoder
 
 	// Kafka Infrastructure
-	consumerGroup    sarama.ConsumerGroup
-	assemblyConsumer wrappedKafka.Consumer
-	orderProducer    wrappedKafka.Producer
-	syncProducer     sarama.SyncProducer
+	consumerGroup              sarama.ConsumerGroup
+	assemblyConsumer           wrappedKafka.Consumer
+	orderProducer              wrappedKafka.Producer
+	orderRefundedProducer      wrappedKafka.Producer
+	orderExpiredProducer       wrappedKafka.Producer
+	orderCreatedProducer       wrappedKafka.Producer
+	orderCancelledProducer     wrappedKafka.Producer
+	orderStatusChangedProducer wrappedKafka.Producer
+	syncProducer               sarama.SyncProducer
 }
 
 func NewDIContainer() *diContainer {
@@ -69,25 +112,85 @@ func NewDIContainer() *diContainer {
 
 func (d *diContainer) OrderApi(ctx context.Context) orderApi.OrderApi {
 	if d.orderApi == nil {
-		d.orderApi = ordApi.NewApi(d.OrderService(ctx))
+		d.orderApi = ordApi.NewApi(
+			d.OrderService(ctx),
+			d.PromoCodeService(ctx),
+			d.CartService(ctx),
+			config.AppConfig().OrderHTTP.SSEHeartbeatInterval(),
+		)
 	}
 
 	return d.orderApi
 }
 
+func (d *diContainer) OrderGRPCApi(ctx context.Context) orderV1.OrderServiceServer {
+	if d.orderGRPCApi == nil {
+		d.orderGRPCApi = ordGRPCApi.NewApi(d.OrderService(ctx))
+	}
+
+	return d.orderGRPCApi
+}
+
 func (d *diContainer) OrderService(ctx context.Context) orderService.OrderService {
 	if d.orderService == nil {
 		d.orderService = ordService.NewService(
 			d.OrderRepository(ctx),
+			d.IdempotencyRepository(ctx),
+			d.PromoCodeRepository(ctx),
+			d.QuoteRepository(ctx),
+			d.CartRepository(),
 			d.PaymentClient(ctx),
 			d.InventoryClient(ctx),
-			d.OrderProducerService(),
+			d.OrderStatusNotifier(),
+			config.AppConfig().OrderQuote.TTL(),
+			// Резерв не должен истечь раньше, чем заказ можно оплатить
+			config.AppConfig().OrderExpiry.TTL(),
+			config.AppConfig().Blueprint.Blueprint(),
+			config.AppConfig().OrderPayment.PriceTolerancePercent(),
 		)
 	}
 
 	return d.orderService
 }
 
+func (d *diContainer) PromoCodeService(ctx context.Context) orderService.PromoCodeService {
+	if d.promoCodeService == nil {
+		d.promoCodeService = promoService.NewService(
+			d.PromoCodeRepository(ctx),
+			d.AdminChecker(),
+		)
+	}
+
+	return d.promoCodeService
+}
+
+func (d *diContainer) CartService(ctx context.Context) orderService.CartService {
+	if d.cartService == nil {
+		d.cartService = cartService.NewService(
+			d.CartRepository(),
+			d.InventoryClient(ctx),
+		)
+	}
+
+	return d.cartService
+}
+
+func (d *diContainer) OrderStatusNotifier() orderService.OrderStatusNotifier {
+	if d.orderStatusNotifier == nil {
+		d.orderStatusNotifier = status_notifier.NewNotifier()
+	}
+
+	return d.orderStatusNotifier
+}
+
+func (d *diContainer) AdminChecker() *admin.Checker {
+	if d.adminChecker == nil {
+		d.adminChecker = admin.NewChecker(config.AppConfig().Admin.UserUUIDs())
+	}
+
+	return d.adminChecker
+}
+
 func (d *diContainer) AuthClient(_ context.Context) authV1.AuthServiceClient {
 	if d.authClient == nil {
 		authConn, err := grpcclient.NewGRPCConnectWithoutSecure(config.AppConfig().Auth.AuthServiceAddress())
@@ -109,6 +212,14 @@ func (d *diContainer) AuthClient(_ context.Context) authV1.AuthServiceClient {
 	return d.authClient
 }
 
+func (d *diContainer) AuthInterceptor(ctx context.Context) *interceptor.AuthInterceptor {
+	if d.authInterceptor == nil {
+		d.authInterceptor = interceptor.NewAuthInterceptor(d.AuthClient(ctx))
+	}
+
+	return d.authInterceptor
+}
+
 func (d *diContainer) PaymentClient(_ context.Context) gRPCClient.PaymentClient {
 	if d.paymentClient == nil {
 		paymentConn, err := grpcclient.NewGRPCConnectWithoutSecure(config.AppConfig().Payment.PaymentAddress())
@@ -148,7 +259,7 @@ func (d *diContainer) InventoryClient(_ context.Context) gRPCClient.InventoryCli
 
 		inventoryClient := inventoryV1.NewInventoryServiceClient(inventoryConn)
 
-		d.inventoryClient = inventoryService.NewClient(inventoryClient)
+		d.inventoryClient = inventoryService.NewClient(inventoryClient, config.AppConfig().Inventory.InventoryServiceToken())
 	}
 
 	return d.inventoryClient
@@ -162,6 +273,125 @@ func (d *diContainer) OrderRepository(ctx context.Context) orderRepo.OrderReposi
 	return d.orderRepository
 }
 
+func (d *diContainer) OutboxRepository(ctx context.Context) orderRepo.OutboxRepository {
+	if d.outboxRepository == nil {
+		d.outboxRepository = outboxRepo.NewRepository(d.PostgreSQLPool(ctx))
+	}
+
+	return d.outboxRepository
+}
+
+func (d *diContainer) IdempotencyRepository(ctx context.Context) orderRepo.IdempotencyRepository {
+	if d.idempotencyRepository == nil {
+		d.idempotencyRepository = idempotencyRepo.NewRepository(
+			d.PostgreSQLPool(ctx),
+			config.AppConfig().OrderIdempotency.KeyTTL(),
+			config.AppConfig().OrderIdempotency.PendingTTL(),
+		)
+	}
+
+	return d.idempotencyRepository
+}
+
+func (d *diContainer) PromoCodeRepository(ctx context.Context) orderRepo.PromoCodeRepository {
+	if d.promoCodeRepository == nil {
+		d.promoCodeRepository = promoRepo.NewRepository(d.PostgreSQLPool(ctx))
+	}
+
+	return d.promoCodeRepository
+}
+
+func (d *diContainer) QuoteRepository(ctx context.Context) orderRepo.QuoteRepository {
+	if d.quoteRepository == nil {
+		d.quoteRepository = quoteRepo.NewRepository(d.PostgreSQLPool(ctx))
+	}
+
+	return d.quoteRepository
+}
+
+func (d *diContainer) CartRepository() orderRepo.CartRepository {
+	if d.cartRepository == nil {
+		d.cartRepository = cartRepo.NewRepository(
+			d.RedisClient(),
+			config.AppConfig().Cart.TTL(),
+		)
+	}
+
+	return d.cartRepository
+}
+
+func (d *diContainer) RedisClient() cache.RedisClient {
+	if d.redisClient == nil {
+		d.redisClient = rediscache.NewClient(
+			d.RedisPool(),
+			logger.Logger(),
+			config.AppConfig().Redis.ConnectionTimeout(),
+		)
+	}
+
+	return d.redisClient
+}
+
+func (d *diContainer) RedisPool() *redigo.Pool {
+	if d.redisPool == nil {
+		d.redisPool = &redigo.Pool{
+			MaxIdle:     config.AppConfig().Redis.MaxIdle(),
+			IdleTimeout: config.AppConfig().Redis.IdleTimeout(),
+			DialContext: func(ctx context.Context) (redigo.Conn, error) {
+				return redigo.DialContext(ctx, "tcp", config.AppConfig().Redis.Address())
+			},
+		}
+
+		closer.AddNamed("Redis pool", func(ctx context.Context) error {
+			return d.redisPool.Close()
+		})
+	}
+
+	return d.redisPool
+}
+
+func (d *diContainer) OutboxRelayService(ctx context.Context) orderService.OutboxRelayService {
+	if d.outboxRelayService == nil {
+		d.outboxRelayService = outbox_relay.NewService(
+			d.OutboxRepository(ctx),
+			d.OrderProducerService(),
+			config.AppConfig().OutboxRelay.PollInterval(),
+			config.AppConfig().OutboxRelay.BatchSize(),
+			config.AppConfig().OutboxRelay.RetryBaseDelay(),
+			config.AppConfig().OutboxRelay.RetryMaxDelay(),
+		)
+	}
+
+	return d.outboxRelayService
+}
+
+func (d *diContainer) OrderExpiryService(ctx context.Context) orderService.OrderExpiryService {
+	if d.orderExpiryService == nil {
+		d.orderExpiryService = order_expiry.NewService(
+			d.OrderRepository(ctx),
+			d.InventoryClient(ctx),
+			d.OrderStatusNotifier(),
+			config.AppConfig().OrderExpiry.TTL(),
+			config.AppConfig().OrderExpiry.SweepInterval(),
+			config.AppConfig().OrderExpiry.BatchSize(),
+		)
+	}
+
+	return d.orderExpiryService
+}
+
+func (d *diContainer) IdempotencyExpiryService(ctx context.Context) orderService.IdempotencyExpiryService {
+	if d.idempotencyExpiryService == nil {
+		d.idempotencyExpiryService = idempotency_expiry.NewService(
+			d.IdempotencyRepository(ctx),
+			config.AppConfig().OrderIdempotency.PurgeInterval(),
+			config.AppConfig().OrderIdempotency.BatchSize(),
+		)
+	}
+
+	return d.idempotencyExpiryService
+}
+
 func (d *diContainer) PostgreSQLPool(ctx context.Context) *pgxpool.Pool {
 	if d.postgreSQLPool == nil {
 		dbURI := config.AppConfig().Postgres.URI()
@@ -194,6 +424,7 @@ func (d *diContainer) AssemblyConsumerService(ctx context.Context) orderService.
 			d.AssemblyDecoder(),
 			d.OrderService(ctx),
 			d.OrderRepository(ctx),
+			d.OrderStatusNotifier(),
 		)
 	}
 	return d.assemblyConsumerService
@@ -241,7 +472,14 @@ func (d *diContainer) AssemblyDecoder() kafkaDecoder.ShipAssembledDecoder {
 
 func (d *diContainer) OrderProducerService() orderService.OrderProducerService {
 	if d.orderProducerService == nil {
-		d.orderProducerService = order_producer.NewService(d.OrderProducer())
+		d.orderProducerService = order_producer.NewService(
+			d.OrderProducer(),
+			d.OrderRefundedProducer(),
+			d.OrderExpiredProducer(),
+			d.OrderCreatedProducer(),
+			d.OrderCancelledProducer(),
+			d.OrderStatusChangedProducer(),
+		)
 	}
 	return d.orderProducerService
 }
@@ -257,6 +495,61 @@ func (d *diContainer) OrderProducer() wrappedKafka.Producer {
 	return d.orderProducer
 }
 
+func (d *diContainer) OrderRefundedProducer() wrappedKafka.Producer {
+	if d.orderRefundedProducer == nil {
+		d.orderRefundedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().OrderProducer.RefundedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.orderRefundedProducer
+}
+
+func (d *diContainer) OrderExpiredProducer() wrappedKafka.Producer {
+	if d.orderExpiredProducer == nil {
+		d.orderExpiredProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().OrderProducer.ExpiredTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.orderExpiredProducer
+}
+
+func (d *diContainer) OrderCreatedProducer() wrappedKafka.Producer {
+	if d.orderCreatedProducer == nil {
+		d.orderCreatedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().OrderProducer.CreatedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.orderCreatedProducer
+}
+
+func (d *diContainer) OrderCancelledProducer() wrappedKafka.Producer {
+	if d.orderCancelledProducer == nil {
+		d.orderCancelledProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().OrderProducer.CancelledTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.orderCancelledProducer
+}
+
+func (d *diContainer) OrderStatusChangedProducer() wrappedKafka.Producer {
+	if d.orderStatusChangedProducer == nil {
+		d.orderStatusChangedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().OrderProducer.StatusChangedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.orderStatusChangedProducer
+}
+
 func (d *diContainer) SyncProducer() sarama.SyncProducer {
 	if d.syncProducer == nil {
 		p, err := sarama.NewSyncProducer(
@@ -4,6 +4,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 	inventoryV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/inventory/v1"
 	paymentV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/payment/v1"
 )
@@ -54,7 +55,7 @@ func PartToProto(part *model.Part) *inventoryV1.Part {
 		Uuid:          part.Uuid,
 		Name:          part.Name,
 		Description:   part.Description,
-		Price:         part.Price,
+		Price:         money.ToProto(part.Price),
 		StockQuantity: part.StockQuantity,
 		Category:      CategoryToProto(part.Category),
 		Dimensions:    DimensionsToProto(part.Dimensions),
@@ -76,7 +77,7 @@ func PartToModel(part *inventoryV1.Part) *model.Part {
 		Uuid:          part.Uuid,
 		Name:          part.Name,
 		Description:   part.Description,
-		Price:         part.Price,
+		Price:         money.FromProto(part.Price),
 		StockQuantity: part.StockQuantity,
 		Category:      CategoryToModel(part.Category),
 		Dimensions:    DimensionsToModel(part.Dimensions),
@@ -305,3 +306,17 @@ func categoriesFromProto(cats []inventoryV1.Category) []model.Category {
 	}
 	return out
 }
+
+// === Reservation ===
+
+// ReservationItemsToProto конвертирует []model.ReservationItem → []*proto ReservationItem.
+func ReservationItemsToProto(items []model.ReservationItem) []*inventoryV1.ReservationItem {
+	out := make([]*inventoryV1.ReservationItem, 0, len(items))
+	for _, item := range items {
+		out = append(out, &inventoryV1.ReservationItem{
+			PartUuid: item.PartUUID,
+			Quantity: item.Quantity,
+		})
+	}
+	return out
+}
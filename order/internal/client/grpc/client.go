@@ -2,14 +2,26 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 )
 
 type InventoryClient interface {
 	ListParts(ctx context.Context, partsFilter model.PartsFilter) ([]*model.Part, error)
+	// ReserveParts резервирует детали под заказ. Повтор с тем же составом возвращает прежний резерв;
+	// если у заказа уже есть активный резерв с другим составом, возвращает ConflictError.
+	// Резерв живёт ttl; нулевой ttl означает срок по умолчанию в inventory.
+	ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem, ttl time.Duration) (string, error)
+	// ReleaseReservation снимает резерв, в том числе подтверждённый, и возвращает детали на склад.
+	ReleaseReservation(ctx context.Context, reservationUUID string) error
+	// CommitReservation подтверждает резерв. Если резерв истёк или снят, возвращает ConflictError.
+	CommitReservation(ctx context.Context, reservationUUID string) error
 }
 
 type PaymentClient interface {
-	PayOrder(ctx context.Context, orderUuid, userUuid string, paymentMethod model.PaymentMethod) (string, error)
+	PayOrder(ctx context.Context, orderUuid, userUuid string, paymentMethod model.PaymentMethod, amount money.Money) (string, error)
+	// RefundPayment возвращает оплату транзакции. Повторный вызов по той же транзакции не проводит второй возврат.
+	RefundPayment(ctx context.Context, orderUuid, userUuid, transactionUuid string, amount money.Money) (string, error)
 }
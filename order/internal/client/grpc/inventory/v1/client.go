@@ -6,8 +6,13 @@ import (
 
 type client struct {
 	genClient inventoryV1.InventoryServiceClient
+	// serviceToken подписывает вызовы внутренних методов резервирования
+	serviceToken string
 }
 
-func NewClient(genClient inventoryV1.InventoryServiceClient) *client {
-	return &client{genClient: genClient}
+func NewClient(genClient inventoryV1.InventoryServiceClient, serviceToken string) *client {
+	return &client{
+		genClient:    genClient,
+		serviceToken: serviceToken,
+	}
 }
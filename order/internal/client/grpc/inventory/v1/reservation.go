@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/ZanDattSu/star-factory/order/internal/client/converter"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	grpcAuth "github.com/ZanDattSu/star-factory/platform/pkg/grpc/interceptor"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+	inventoryV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) ReserveParts(ctx context.Context, orderUUID string, items []model.ReservationItem, ttl time.Duration) (string, error) {
+	logger.Info(ctx, "Reserving parts in inventory service",
+		zap.String("order_uuid", orderUUID),
+		zap.Int("items_count", len(items)),
+	)
+
+	ctx = grpcAuth.AddServiceTokenToGRPC(ctx, c.serviceToken)
+
+	req := &inventoryV1.ReservePartsRequest{
+		OrderUuid: orderUUID,
+		Items:     converter.ReservationItemsToProto(items),
+	}
+	if ttl > 0 {
+		req.Ttl = durationpb.New(ttl)
+	}
+
+	resp, err := c.genClient.ReserveParts(ctx, req)
+	if err != nil {
+		if shortage := insufficientStock(err); shortage != nil {
+			logger.Warn(ctx, "Not enough stock to reserve parts",
+				zap.String("order_uuid", orderUUID),
+				zap.Strings("part_uuids", shortage.PartUUIDs),
+			)
+			return "", shortage
+		}
+		if status.Code(err) == codes.AlreadyExists {
+			logger.Warn(ctx, "Order already has an active reservation with other items",
+				zap.String("order_uuid", orderUUID),
+			)
+			return "", model.NewConflictError("order is being modified concurrently, try again")
+		}
+
+		logger.Error(ctx, "Failed to reserve parts in inventory",
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+		return "", fmt.Errorf("inventory ReserveParts failed: %w", err)
+	}
+
+	logger.Info(ctx, "Parts reserved successfully",
+		zap.String("order_uuid", orderUUID),
+		zap.String("reservation_uuid", resp.ReservationUuid),
+	)
+
+	return resp.ReservationUuid, nil
+}
+
+func (c *client) ReleaseReservation(ctx context.Context, reservationUUID string) error {
+	ctx = grpcAuth.AddServiceTokenToGRPC(ctx, c.serviceToken)
+
+	_, err := c.genClient.ReleaseReservation(ctx, &inventoryV1.ReleaseReservationRequest{
+		ReservationUuid: reservationUUID,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition, codes.NotFound:
+			return model.NewConflictError("parts reservation was already released or committed, reload the order and try again")
+		}
+		return fmt.Errorf("inventory ReleaseReservation failed: %w", err)
+	}
+
+	return nil
+}
+
+func (c *client) CommitReservation(ctx context.Context, reservationUUID string) error {
+	ctx = grpcAuth.AddServiceTokenToGRPC(ctx, c.serviceToken)
+
+	_, err := c.genClient.CommitReservation(ctx, &inventoryV1.CommitReservationRequest{
+		ReservationUuid: reservationUUID,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition, codes.NotFound:
+			return model.NewConflictError("parts reservation has expired or was released, edit the order to reserve parts again")
+		}
+		return fmt.Errorf("inventory CommitReservation failed: %w", err)
+	}
+
+	return nil
+}
+
+// insufficientStock достаёт из FailedPrecondition детали нехватки остатков.
+// Возвращает nil, если ошибка вызвана чем-то другим.
+func insufficientStock(err error) *model.InsufficientStockError {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.FailedPrecondition {
+		return nil
+	}
+
+	for _, detail := range st.Details() {
+		stock, ok := detail.(*inventoryV1.InsufficientStock)
+		if !ok {
+			continue
+		}
+
+		partUUIDs := make([]string, 0, len(stock.Shortages))
+		for _, shortage := range stock.Shortages {
+			partUUIDs = append(partUUIDs, shortage.PartUuid)
+		}
+		return model.NewInsufficientStockError(partUUIDs)
+	}
+
+	return nil
+}
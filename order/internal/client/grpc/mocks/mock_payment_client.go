@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/ZanDattSu/star-factory/order/internal/model"
+
+	money "github.com/ZanDattSu/star-factory/platform/pkg/money"
 )
 
 // PaymentClient is an autogenerated mock type for the PaymentClient type
@@ -23,9 +25,9 @@ func (_m *PaymentClient) EXPECT() *PaymentClient_Expecter {
 	return &PaymentClient_Expecter{mock: &_m.Mock}
 }
 
-// PayOrder provides a mock function with given fields: ctx, orderUuid, userUuid, paymentMethod
-func (_m *PaymentClient) PayOrder(ctx context.Context, orderUuid string, userUuid string, paymentMethod model.PaymentMethod) (string, error) {
-	ret := _m.Called(ctx, orderUuid, userUuid, paymentMethod)
+// PayOrder provides a mock function with given fields: ctx, orderUuid, userUuid, paymentMethod, amount
+func (_m *PaymentClient) PayOrder(ctx context.Context, orderUuid string, userUuid string, paymentMethod model.PaymentMethod, amount money.Money) (string, error) {
+	ret := _m.Called(ctx, orderUuid, userUuid, paymentMethod, amount)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
@@ -33,17 +35,17 @@ func (_m *PaymentClient) PayOrder(ctx context.Context, orderUuid string, userUui
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PaymentMethod) (string, error)); ok {
-		return rf(ctx, orderUuid, userUuid, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PaymentMethod, money.Money) (string, error)); ok {
+		return rf(ctx, orderUuid, userUuid, paymentMethod, amount)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PaymentMethod) string); ok {
-		r0 = rf(ctx, orderUuid, userUuid, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PaymentMethod, money.Money) string); ok {
+		r0 = rf(ctx, orderUuid, userUuid, paymentMethod, amount)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.PaymentMethod) error); ok {
-		r1 = rf(ctx, orderUuid, userUuid, paymentMethod)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.PaymentMethod, money.Money) error); ok {
+		r1 = rf(ctx, orderUuid, userUuid, paymentMethod, amount)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,13 +63,14 @@ type PaymentClient_PayOrder_Call struct {
 //   - orderUuid string
 //   - userUuid string
 //   - paymentMethod model.PaymentMethod
-func (_e *PaymentClient_Expecter) PayOrder(ctx interface{}, orderUuid interface{}, userUuid interface{}, paymentMethod interface{}) *PaymentClient_PayOrder_Call {
-	return &PaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUuid, userUuid, paymentMethod)}
+//   - amount money.Money
+func (_e *PaymentClient_Expecter) PayOrder(ctx interface{}, orderUuid interface{}, userUuid interface{}, paymentMethod interface{}, amount interface{}) *PaymentClient_PayOrder_Call {
+	return &PaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUuid, userUuid, paymentMethod, amount)}
 }
 
-func (_c *PaymentClient_PayOrder_Call) Run(run func(ctx context.Context, orderUuid string, userUuid string, paymentMethod model.PaymentMethod)) *PaymentClient_PayOrder_Call {
+func (_c *PaymentClient_PayOrder_Call) Run(run func(ctx context.Context, orderUuid string, userUuid string, paymentMethod model.PaymentMethod, amount money.Money)) *PaymentClient_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.PaymentMethod))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.PaymentMethod), args[4].(money.Money))
 	})
 	return _c
 }
@@ -77,7 +80,67 @@ func (_c *PaymentClient_PayOrder_Call) Return(_a0 string, _a1 error) *PaymentCli
 	return _c
 }
 
-func (_c *PaymentClient_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, model.PaymentMethod) (string, error)) *PaymentClient_PayOrder_Call {
+func (_c *PaymentClient_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, model.PaymentMethod, money.Money) (string, error)) *PaymentClient_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundPayment provides a mock function with given fields: ctx, orderUuid, userUuid, transactionUuid, amount
+func (_m *PaymentClient) RefundPayment(ctx context.Context, orderUuid string, userUuid string, transactionUuid string, amount money.Money) (string, error) {
+	ret := _m.Called(ctx, orderUuid, userUuid, transactionUuid, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, money.Money) (string, error)); ok {
+		return rf(ctx, orderUuid, userUuid, transactionUuid, amount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, money.Money) string); ok {
+		r0 = rf(ctx, orderUuid, userUuid, transactionUuid, amount)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, money.Money) error); ok {
+		r1 = rf(ctx, orderUuid, userUuid, transactionUuid, amount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentClient_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type PaymentClient_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUuid string
+//   - userUuid string
+//   - transactionUuid string
+//   - amount money.Money
+func (_e *PaymentClient_Expecter) RefundPayment(ctx interface{}, orderUuid interface{}, userUuid interface{}, transactionUuid interface{}, amount interface{}) *PaymentClient_RefundPayment_Call {
+	return &PaymentClient_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, orderUuid, userUuid, transactionUuid, amount)}
+}
+
+func (_c *PaymentClient_RefundPayment_Call) Run(run func(ctx context.Context, orderUuid string, userUuid string, transactionUuid string, amount money.Money)) *PaymentClient_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(money.Money))
+	})
+	return _c
+}
+
+func (_c *PaymentClient_RefundPayment_Call) Return(_a0 string, _a1 error) *PaymentClient_RefundPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentClient_RefundPayment_Call) RunAndReturn(run func(context.Context, string, string, string, money.Money) (string, error)) *PaymentClient_RefundPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
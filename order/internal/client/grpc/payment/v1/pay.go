@@ -12,14 +12,16 @@ import (
 	"github.com/ZanDattSu/star-factory/order/internal/model"
 	grpcAuth "github.com/ZanDattSu/star-factory/platform/pkg/grpc/interceptor"
 	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 	paymentV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/payment/v1"
 )
 
-func (c *client) PayOrder(ctx context.Context, orderUuid, userUuid string, paymentMethod model.PaymentMethod) (string, error) {
+func (c *client) PayOrder(ctx context.Context, orderUuid, userUuid string, paymentMethod model.PaymentMethod, amount money.Money) (string, error) {
 	logger.Info(ctx, "Requesting payment from payment service",
 		zap.String("order_uuid", orderUuid),
 		zap.String("user_uuid", userUuid),
 		zap.String("payment_method", string(paymentMethod)),
+		zap.Stringer("amount", amount),
 	)
 
 	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
@@ -28,6 +30,7 @@ func (c *client) PayOrder(ctx context.Context, orderUuid, userUuid string, payme
 		OrderUuid:     orderUuid,
 		UserUuid:      userUuid,
 		PaymentMethod: converter.PaymentMethodToProto(paymentMethod),
+		Amount:        money.ToProto(amount),
 	})
 	if err != nil {
 		statusCode, ok := status.FromError(err)
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	grpcAuth "github.com/ZanDattSu/star-factory/platform/pkg/grpc/interceptor"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+	paymentV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/payment/v1"
+)
+
+func (c *client) RefundPayment(ctx context.Context, orderUuid, userUuid, transactionUuid string, amount money.Money) (string, error) {
+	logger.Info(ctx, "Requesting refund from payment service",
+		zap.String("order_uuid", orderUuid),
+		zap.String("user_uuid", userUuid),
+		zap.String("transaction_uuid", transactionUuid),
+		zap.Stringer("amount", amount),
+	)
+
+	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
+
+	resp, err := c.genClient.RefundPayment(ctx, &paymentV1.RefundPaymentRequest{
+		OrderUuid:       orderUuid,
+		UserUuid:        userUuid,
+		TransactionUuid: transactionUuid,
+		Amount:          money.ToProto(amount),
+	})
+	if err != nil {
+		statusCode, ok := status.FromError(err)
+		if ok && statusCode.Code() == codes.Internal {
+			logger.Error(ctx, "Payment service internal error on refund",
+				zap.String("order_uuid", orderUuid),
+				zap.String("transaction_uuid", transactionUuid),
+				zap.String("grpc_code", statusCode.Code().String()),
+				zap.Error(err),
+			)
+			return "", fmt.Errorf("payment service internal error: %w", err)
+		}
+
+		logger.Error(ctx, "Refund request failed",
+			zap.String("order_uuid", orderUuid),
+			zap.String("transaction_uuid", transactionUuid),
+			zap.Error(err),
+		)
+		return "", err
+	}
+
+	logger.Info(ctx, "Refund successful",
+		zap.String("order_uuid", orderUuid),
+		zap.String("transaction_uuid", transactionUuid),
+		zap.String("refund_uuid", resp.RefundUuid),
+	)
+
+	return resp.RefundUuid, nil
+}
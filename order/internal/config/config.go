@@ -4,6 +4,7 @@ import (
 	"github.com/joho/godotenv"
 
 	"github.com/ZanDattSu/star-factory/order/internal/config/env"
+	"github.com/ZanDattSu/star-factory/platform/pkg/admin"
 )
 
 var appConfig *config
@@ -12,13 +13,23 @@ type config struct {
 	App              App
 	Logger           LoggerConfig
 	OrderHTTP        OrderHTTPConfig
+	OrderGRPC        OrderGRPCConfig
 	Payment          PaymentGRPCService
 	Inventory        InventoryGRPCService
 	Auth             AuthGRPCService
 	Postgres         PostgresConfig
+	Redis            RedisConfig
 	Kafka            KafkaConfig
 	AssemblyConsumer AssemblyConsumerConfig
 	OrderProducer    OrderProducerConfig
+	OutboxRelay      OutboxRelayConfig
+	OrderExpiry      OrderExpiryConfig
+	OrderIdempotency OrderIdempotencyConfig
+	OrderQuote       OrderQuoteConfig
+	Cart             CartConfig
+	Blueprint        BlueprintConfig
+	OrderPayment     OrderPaymentConfig
+	Admin            AdminConfig
 }
 
 func Load(path ...string) error {
@@ -42,11 +53,21 @@ func Load(path ...string) error {
 		return err
 	}
 
+	orderGRPC, err := env.NewOrderGRPCConfig()
+	if err != nil {
+		return err
+	}
+
 	postgres, err := env.NewPostgresConfig()
 	if err != nil {
 		return err
 	}
 
+	redis, err := env.NewRedisConfig()
+	if err != nil {
+		return err
+	}
+
 	kafkaCfg, err := env.NewKafkaConfig()
 	if err != nil {
 		return err
@@ -62,17 +83,67 @@ func Load(path ...string) error {
 		return err
 	}
 
+	outboxRelayCfg, err := env.NewOutboxRelayConfig()
+	if err != nil {
+		return err
+	}
+
+	orderExpiryCfg, err := env.NewOrderExpiryConfig()
+	if err != nil {
+		return err
+	}
+
+	orderIdempotencyCfg, err := env.NewOrderIdempotencyConfig()
+	if err != nil {
+		return err
+	}
+
+	orderQuoteCfg, err := env.NewOrderQuoteConfig()
+	if err != nil {
+		return err
+	}
+
+	cartCfg, err := env.NewCartConfig()
+	if err != nil {
+		return err
+	}
+
+	blueprintCfg, err := env.NewBlueprintConfig()
+	if err != nil {
+		return err
+	}
+
+	orderPaymentCfg, err := env.NewOrderPaymentConfig()
+	if err != nil {
+		return err
+	}
+
+	adminCfg, err := admin.NewConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		App:              app,
 		Logger:           logger,
 		OrderHTTP:        orderHTTP,
+		OrderGRPC:        orderGRPC,
 		Payment:          orderHTTP,
 		Inventory:        orderHTTP,
 		Auth:             orderHTTP,
 		Postgres:         postgres,
+		Redis:            redis,
 		Kafka:            kafkaCfg,
 		OrderProducer:    producerCfg,
 		AssemblyConsumer: consumerCfg,
+		OutboxRelay:      outboxRelayCfg,
+		OrderExpiry:      orderExpiryCfg,
+		OrderIdempotency: orderIdempotencyCfg,
+		OrderQuote:       orderQuoteCfg,
+		Cart:             cartCfg,
+		Blueprint:        blueprintCfg,
+		OrderPayment:     orderPaymentCfg,
+		Admin:            adminCfg,
 	}
 
 	return nil
@@ -0,0 +1,51 @@
+package env
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/caarlos0/env/v11"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+type blueprintEnvConfig struct {
+	// Path JSON-файл с правилами комплектации корабля; если не задан, действуют правила по умолчанию
+	Path string `env:"BLUEPRINT_PATH"`
+}
+
+type blueprintConfig struct {
+	blueprint model.ShipBlueprint
+}
+
+func NewBlueprintConfig() (*blueprintConfig, error) {
+	var raw blueprintEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	if raw.Path == "" {
+		return &blueprintConfig{blueprint: model.DefaultShipBlueprint()}, nil
+	}
+
+	data, err := os.ReadFile(raw.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ship blueprint %s: %w", raw.Path, err)
+	}
+
+	var blueprint model.ShipBlueprint
+	if err = json.Unmarshal(data, &blueprint); err != nil {
+		return nil, fmt.Errorf("failed to parse ship blueprint %s: %w", raw.Path, err)
+	}
+
+	if err = blueprint.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid ship blueprint %s: %w", raw.Path, err)
+	}
+
+	return &blueprintConfig{blueprint: blueprint}, nil
+}
+
+func (cfg *blueprintConfig) Blueprint() model.ShipBlueprint {
+	return cfg.blueprint
+}
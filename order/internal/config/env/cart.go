@@ -0,0 +1,28 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type cartEnvConfig struct {
+	TTL time.Duration `env:"CART_TTL" envDefault:"168h"`
+}
+
+type cartConfig struct {
+	raw cartEnvConfig
+}
+
+func NewCartConfig() (*cartConfig, error) {
+	var raw cartEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &cartConfig{raw: raw}, nil
+}
+
+func (cfg *cartConfig) TTL() time.Duration {
+	return cfg.raw.TTL
+}
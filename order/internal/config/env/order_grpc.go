@@ -0,0 +1,33 @@
+package env
+
+import (
+	"net"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type orderGRPCEnvConfig struct {
+	GRPCHost string `env:"GRPC_HOST,required"`
+	GRPCPort string `env:"GRPC_PORT,required"`
+}
+
+type orderGRPCConfig struct {
+	raw orderGRPCEnvConfig
+}
+
+func NewOrderGRPCConfig() (*orderGRPCConfig, error) {
+	var raw orderGRPCEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderGRPCConfig{raw: raw}, nil
+}
+
+func (cfg *orderGRPCConfig) GRPCAddress() string {
+	return net.JoinHostPort(cfg.raw.GRPCHost, cfg.raw.GRPCPort)
+}
+
+func (cfg *orderGRPCConfig) GRPCPort() string {
+	return cfg.raw.GRPCPort
+}
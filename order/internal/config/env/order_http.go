@@ -12,12 +12,14 @@ type orderHTTPEnvConfig struct {
 	HTTPPort              string        `env:"HTTP_PORT,required"`
 	InventoryGRPCHost     string        `env:"INVENTORY_GRPC_HOST,required"`
 	InventoryGRPCPort     string        `env:"INVENTORY_GRPC_PORT,required"`
+	InventoryServiceToken string        `env:"INVENTORY_SERVICE_TOKEN,required"`
 	PaymentGRPCHost       string        `env:"PAYMENT_GRPC_HOST,required"`
 	PaymentGRPCPort       string        `env:"PAYMENT_GRPC_PORT,required"`
 	AuthGRPCHost          string        `env:"AUTH_GRPC_HOST,required"`
 	AuthGRPCPort          string        `env:"AUTH_GRPC_PORT,required"`
 	HttpReadHeaderTimeout time.Duration `env:"HTTP_READ_TIMEOUT,required"`
 	HttpShutdownTimeout   time.Duration `env:"HTTP_SHUTDOWN_TIMEOUT,required"`
+	SSEHeartbeatInterval  time.Duration `env:"HTTP_SSE_HEARTBEAT_INTERVAL" envDefault:"15s"`
 }
 
 type orderHttpConfig struct {
@@ -56,6 +58,10 @@ func (cfg *orderHttpConfig) InventoryServicePort() string {
 	return cfg.raw.InventoryGRPCPort
 }
 
+func (cfg *orderHttpConfig) InventoryServiceToken() string {
+	return cfg.raw.InventoryServiceToken
+}
+
 func (cfg *orderHttpConfig) AuthServicePort() string {
 	return cfg.raw.AuthGRPCPort
 }
@@ -71,3 +77,7 @@ func (cfg *orderHttpConfig) ReadHeaderTimeout() time.Duration {
 func (cfg *orderHttpConfig) ShutdownTimeout() time.Duration {
 	return cfg.raw.HttpShutdownTimeout
 }
+
+func (cfg *orderHttpConfig) SSEHeartbeatInterval() time.Duration {
+	return cfg.raw.SSEHeartbeatInterval
+}
@@ -0,0 +1,43 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type orderIdempotencyEnvConfig struct {
+	KeyTTL        time.Duration `env:"ORDER_IDEMPOTENCY_KEY_TTL" envDefault:"24h"`
+	PendingTTL    time.Duration `env:"ORDER_IDEMPOTENCY_PENDING_TTL" envDefault:"1m"`
+	PurgeInterval time.Duration `env:"ORDER_IDEMPOTENCY_PURGE_INTERVAL" envDefault:"1h"`
+	BatchSize     int           `env:"ORDER_IDEMPOTENCY_PURGE_BATCH_SIZE" envDefault:"1000"`
+}
+
+type orderIdempotencyConfig struct {
+	raw orderIdempotencyEnvConfig
+}
+
+func NewOrderIdempotencyConfig() (*orderIdempotencyConfig, error) {
+	var raw orderIdempotencyEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderIdempotencyConfig{raw: raw}, nil
+}
+
+func (cfg *orderIdempotencyConfig) KeyTTL() time.Duration {
+	return cfg.raw.KeyTTL
+}
+
+func (cfg *orderIdempotencyConfig) PendingTTL() time.Duration {
+	return cfg.raw.PendingTTL
+}
+
+func (cfg *orderIdempotencyConfig) PurgeInterval() time.Duration {
+	return cfg.raw.PurgeInterval
+}
+
+func (cfg *orderIdempotencyConfig) BatchSize() int {
+	return cfg.raw.BatchSize
+}
@@ -0,0 +1,27 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type orderPaymentEnvConfig struct {
+	// PriceTolerancePercent допустимое отклонение текущей цены детали от цены в заказе, в процентах
+	PriceTolerancePercent float64 `env:"PAYMENT_PRICE_TOLERANCE_PERCENT" envDefault:"0"`
+}
+
+type orderPaymentConfig struct {
+	raw orderPaymentEnvConfig
+}
+
+func NewOrderPaymentConfig() (*orderPaymentConfig, error) {
+	var raw orderPaymentEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderPaymentConfig{raw: raw}, nil
+}
+
+func (cfg *orderPaymentConfig) PriceTolerancePercent() float64 {
+	return cfg.raw.PriceTolerancePercent
+}
@@ -6,7 +6,12 @@ import (
 )
 
 type orderProducerEnvConfig struct {
-	TopicName string `env:"PRODUCE_TOPIC_NAME"`
+	TopicName              string `env:"PRODUCE_TOPIC_NAME"`
+	RefundedTopicName      string `env:"REFUNDED_TOPIC_NAME"`
+	ExpiredTopicName       string `env:"EXPIRED_TOPIC_NAME"`
+	CreatedTopicName       string `env:"CREATED_TOPIC_NAME"`
+	CancelledTopicName     string `env:"CANCELLED_TOPIC_NAME"`
+	StatusChangedTopicName string `env:"STATUS_CHANGED_TOPIC_NAME"`
 }
 
 type orderProducerConfig struct {
@@ -26,6 +31,26 @@ func (cfg *orderProducerConfig) Topic() string {
 	return cfg.raw.TopicName
 }
 
+func (cfg *orderProducerConfig) RefundedTopic() string {
+	return cfg.raw.RefundedTopicName
+}
+
+func (cfg *orderProducerConfig) ExpiredTopic() string {
+	return cfg.raw.ExpiredTopicName
+}
+
+func (cfg *orderProducerConfig) CreatedTopic() string {
+	return cfg.raw.CreatedTopicName
+}
+
+func (cfg *orderProducerConfig) CancelledTopic() string {
+	return cfg.raw.CancelledTopicName
+}
+
+func (cfg *orderProducerConfig) StatusChangedTopic() string {
+	return cfg.raw.StatusChangedTopicName
+}
+
 func (cfg *orderProducerConfig) Config() *sarama.Config {
 	config := sarama.NewConfig()
 	config.Version = sarama.V4_0_0_0
@@ -0,0 +1,28 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type orderQuoteEnvConfig struct {
+	TTL time.Duration `env:"ORDER_QUOTE_TTL" envDefault:"15m"`
+}
+
+type orderQuoteConfig struct {
+	raw orderQuoteEnvConfig
+}
+
+func NewOrderQuoteConfig() (*orderQuoteConfig, error) {
+	var raw orderQuoteEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &orderQuoteConfig{raw: raw}, nil
+}
+
+func (cfg *orderQuoteConfig) TTL() time.Duration {
+	return cfg.raw.TTL
+}
@@ -0,0 +1,43 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type outboxRelayEnvConfig struct {
+	PollInterval   time.Duration `env:"OUTBOX_POLL_INTERVAL" envDefault:"1s"`
+	BatchSize      int           `env:"OUTBOX_BATCH_SIZE" envDefault:"100"`
+	RetryBaseDelay time.Duration `env:"OUTBOX_RETRY_BASE_DELAY" envDefault:"1s"`
+	RetryMaxDelay  time.Duration `env:"OUTBOX_RETRY_MAX_DELAY" envDefault:"5m"`
+}
+
+type outboxRelayConfig struct {
+	raw outboxRelayEnvConfig
+}
+
+func NewOutboxRelayConfig() (*outboxRelayConfig, error) {
+	var raw outboxRelayEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &outboxRelayConfig{raw: raw}, nil
+}
+
+func (cfg *outboxRelayConfig) PollInterval() time.Duration {
+	return cfg.raw.PollInterval
+}
+
+func (cfg *outboxRelayConfig) BatchSize() int {
+	return cfg.raw.BatchSize
+}
+
+func (cfg *outboxRelayConfig) RetryBaseDelay() time.Duration {
+	return cfg.raw.RetryBaseDelay
+}
+
+func (cfg *outboxRelayConfig) RetryMaxDelay() time.Duration {
+	return cfg.raw.RetryMaxDelay
+}
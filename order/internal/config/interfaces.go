@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/IBM/sarama"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
 )
 
 type App interface {
@@ -20,6 +22,12 @@ type OrderHTTPConfig interface {
 	OrderPort() string
 	ReadHeaderTimeout() time.Duration
 	ShutdownTimeout() time.Duration
+	SSEHeartbeatInterval() time.Duration
+}
+
+type OrderGRPCConfig interface {
+	GRPCAddress() string
+	GRPCPort() string
 }
 
 type PaymentGRPCService interface {
@@ -30,6 +38,8 @@ type PaymentGRPCService interface {
 type InventoryGRPCService interface {
 	InventoryAddress() string
 	InventoryServicePort() string
+	// InventoryServiceToken токен для внутренних методов inventory (резервы деталей).
+	InventoryServiceToken() string
 }
 
 type AuthGRPCService interface {
@@ -49,9 +59,71 @@ type KafkaConfig interface {
 
 type OrderProducerConfig interface {
 	Topic() string
+	RefundedTopic() string
+	ExpiredTopic() string
+	CreatedTopic() string
+	CancelledTopic() string
+	StatusChangedTopic() string
 	Config() *sarama.Config
 }
 
+type OutboxRelayConfig interface {
+	PollInterval() time.Duration
+	BatchSize() int
+	RetryBaseDelay() time.Duration
+	RetryMaxDelay() time.Duration
+}
+
+type OrderExpiryConfig interface {
+	// TTL время, после которого неоплаченный заказ отменяется.
+	TTL() time.Duration
+	SweepInterval() time.Duration
+	BatchSize() int
+}
+
+type OrderIdempotencyConfig interface {
+	// KeyTTL время хранения ответа по Idempotency-Key.
+	KeyTTL() time.Duration
+	// PendingTTL время, после которого незавершённый запрос с ключом считается зависшим.
+	PendingTTL() time.Duration
+	PurgeInterval() time.Duration
+	BatchSize() int
+}
+
+type OrderQuoteConfig interface {
+	// TTL время, в течение которого по расчёту можно оформить заказ.
+	TTL() time.Duration
+}
+
+type RedisConfig interface {
+	Address() string
+	Host() string
+	Port() string
+	ConnectionTimeout() time.Duration
+	MaxIdle() int
+	IdleTimeout() time.Duration
+}
+
+type CartConfig interface {
+	// TTL срок жизни корзины с последнего изменения.
+	TTL() time.Duration
+}
+
+type BlueprintConfig interface {
+	// Blueprint правила комплектации корабля, которым должен соответствовать заказ.
+	Blueprint() model.ShipBlueprint
+}
+
+type OrderPaymentConfig interface {
+	// PriceTolerancePercent допустимое отклонение цены детали от цены в заказе при оплате, в процентах.
+	PriceTolerancePercent() float64
+}
+
+type AdminConfig interface {
+	// UserUUIDs пользователи, которым доступно управление промокодами.
+	UserUUIDs() []string
+}
+
 type AssemblyConsumerConfig interface {
 	Topic() string
 	GroupID() string
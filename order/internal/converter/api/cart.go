@@ -0,0 +1,19 @@
+package api
+
+import (
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
+)
+
+// CartToAPI конвертирует model.Cart → orderV1.Cart.
+func CartToAPI(c *model.Cart) *orderV1.Cart {
+	items := make([]orderV1.OrderItemRequest, 0, len(c.Items))
+	for _, i := range c.Items {
+		items = append(items, orderV1.OrderItemRequest{
+			PartUUID: i.PartUUID,
+			Quantity: i.Quantity,
+		})
+	}
+
+	return &orderV1.Cart{Items: items}
+}
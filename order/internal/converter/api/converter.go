@@ -1,7 +1,10 @@
 package api
 
 import (
+	"github.com/samber/lo"
+
 	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
 )
 
@@ -15,8 +18,17 @@ func OrderToAPI(o *model.Order) *orderV1.OrderDto {
 		OrderUUID:  o.OrderUUID,
 		UserUUID:   o.UserUUID,
 		PartUuids:  o.PartUuids,
-		TotalPrice: o.TotalPrice,
+		Items:      OrderItemsToAPI(o.Items),
+		Subtotal:   MoneyToAPI(o.Subtotal),
+		Discount:   MoneyToAPI(o.Discount),
+		TotalPrice: MoneyToAPI(o.TotalPrice),
 		Status:     OrderStatusToAPI(o.Status),
+		Version:    o.Version,
+	}
+
+	// PromoCode
+	if o.PromoCode != nil {
+		dto.PromoCode = orderV1.NewOptString(*o.PromoCode)
 	}
 
 	// TransactionUUID
@@ -27,9 +39,111 @@ func OrderToAPI(o *model.Order) *orderV1.OrderDto {
 	// PaymentMethod
 	dto.PaymentMethod = orderV1.NewOptPaymentMethod(PaymentMethodToAPI(o.PaymentMethod))
 
+	// CreatedAt
+	if !o.CreatedAt.IsZero() {
+		dto.CreatedAt = orderV1.NewOptDateTime(o.CreatedAt)
+	}
+
 	return dto
 }
 
+// MoneyToAPI конвертирует money.Money → orderV1.Money.
+func MoneyToAPI(m money.Money) orderV1.Money {
+	return orderV1.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+// MoneyFromAPI конвертирует orderV1.Money → money.Money.
+func MoneyFromAPI(m orderV1.Money) money.Money {
+	return money.New(m.Amount, m.Currency)
+}
+
+// OrderItemsToAPI конвертирует []model.OrderItem → []orderV1.OrderItem.
+func OrderItemsToAPI(items []model.OrderItem) []orderV1.OrderItem {
+	out := make([]orderV1.OrderItem, 0, len(items))
+	for _, i := range items {
+		out = append(out, orderV1.OrderItem{
+			PartUUID:  i.PartUUID,
+			PartName:  i.PartName,
+			Quantity:  i.Quantity,
+			UnitPrice: MoneyToAPI(i.UnitPrice),
+		})
+	}
+	return out
+}
+
+// OrderItemsFromAPI конвертирует позиции запроса CreateOrder → []model.OrderItem.
+func OrderItemsFromAPI(items []orderV1.OrderItemRequest) []model.OrderItem {
+	out := make([]model.OrderItem, 0, len(items))
+	for _, i := range items {
+		out = append(out, model.OrderItem{
+			PartUUID: i.PartUUID,
+			Quantity: i.Quantity,
+		})
+	}
+	return out
+}
+
+// OrdersToAPI конвертирует []*model.Order → []orderV1.OrderDto.
+func OrdersToAPI(orders []*model.Order) []orderV1.OrderDto {
+	out := make([]orderV1.OrderDto, 0, len(orders))
+	for _, o := range orders {
+		if dto := OrderToAPI(o); dto != nil {
+			out = append(out, *dto)
+		}
+	}
+	return out
+}
+
+// OrdersFilterFromAPI собирает фильтр заказов пользователя из query-параметров ListOrders.
+func OrdersFilterFromAPI(userUUID string, params orderV1.ListOrdersParams) model.OrdersFilter {
+	filter := model.OrdersFilter{
+		UserUUID: userUUID,
+		Limit:    params.PageSize.Or(0),
+	}
+
+	for _, status := range params.Status {
+		filter.Statuses = append(filter.Statuses, OrderStatusFromAPI(status))
+	}
+
+	for _, method := range params.PaymentMethod {
+		filter.PaymentMethods = append(filter.PaymentMethods, PaymentMethodToModel(method))
+	}
+
+	if val, ok := params.CreatedFrom.Get(); ok {
+		filter.CreatedFrom = lo.ToPtr(val)
+	}
+
+	if val, ok := params.CreatedTo.Get(); ok {
+		filter.CreatedTo = lo.ToPtr(val)
+	}
+
+	return filter
+}
+
+// OrderStatusHistoryToAPI конвертирует историю статусов заказа → []orderV1.OrderStatusChange.
+func OrderStatusHistoryToAPI(history []*model.OrderStatusChange) []orderV1.OrderStatusChange {
+	out := make([]orderV1.OrderStatusChange, 0, len(history))
+	for _, c := range history {
+		if c == nil {
+			continue
+		}
+		dto := orderV1.OrderStatusChange{
+			FromStatus: OrderStatusToAPI(c.FromStatus),
+			ToStatus:   OrderStatusToAPI(c.ToStatus),
+			Actor:      c.Actor,
+			ChangedAt:  c.ChangedAt,
+		}
+		if c.Reason != "" {
+			dto.Reason = orderV1.NewOptString(c.Reason)
+		}
+		out = append(out, dto)
+	}
+	return out
+}
+
 // OrderToModel конвертирует OpenAPI DTO → service-модель.
 func OrderToModel(orderDto *orderV1.OrderDto) *model.Order {
 	if orderDto == nil {
@@ -40,8 +154,16 @@ func OrderToModel(orderDto *orderV1.OrderDto) *model.Order {
 		OrderUUID:  orderDto.OrderUUID,
 		UserUUID:   orderDto.UserUUID,
 		PartUuids:  orderDto.PartUuids,
-		TotalPrice: orderDto.TotalPrice,
+		Subtotal:   MoneyFromAPI(orderDto.Subtotal),
+		Discount:   MoneyFromAPI(orderDto.Discount),
+		TotalPrice: MoneyFromAPI(orderDto.TotalPrice),
 		Status:     OrderStatusFromAPI(orderDto.Status),
+		Version:    orderDto.Version,
+	}
+
+	// PromoCode
+	if val, ok := orderDto.PromoCode.Get(); ok {
+		o.PromoCode = &val
 	}
 
 	// TransactionUUID
@@ -54,6 +176,11 @@ func OrderToModel(orderDto *orderV1.OrderDto) *model.Order {
 		o.PaymentMethod = PaymentMethodToModel(val)
 	}
 
+	// CreatedAt
+	if val, ok := orderDto.CreatedAt.Get(); ok {
+		o.CreatedAt = val
+	}
+
 	return o
 }
 
@@ -69,6 +196,10 @@ func OrderStatusToAPI(status model.OrderStatus) orderV1.OrderStatus {
 		return orderV1.OrderStatusCANCELLED
 	case model.OrderStatusASSEMBLED:
 		return orderV1.OrderStatusASSEMBLED
+	case model.OrderStatusREFUNDED:
+		return orderV1.OrderStatusREFUNDED
+	case model.OrderStatusREFUNDING:
+		return orderV1.OrderStatusREFUNDING
 	default:
 		return orderV1.OrderStatusNOTSET
 	}
@@ -84,6 +215,10 @@ func OrderStatusFromAPI(status orderV1.OrderStatus) model.OrderStatus {
 		return model.OrderStatusCANCELLED
 	case orderV1.OrderStatusASSEMBLED:
 		return model.OrderStatusASSEMBLED
+	case orderV1.OrderStatusREFUNDED:
+		return model.OrderStatusREFUNDED
+	case orderV1.OrderStatusREFUNDING:
+		return model.OrderStatusREFUNDING
 	default:
 		return model.OrderStatusUNSPECIFIED
 	}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
+)
+
+// PromoCodeToAPI конвертирует *model.PromoCode → *orderV1.PromoCode.
+func PromoCodeToAPI(p *model.PromoCode) *orderV1.PromoCode {
+	if p == nil {
+		return nil
+	}
+
+	dto := &orderV1.PromoCode{
+		Code:         p.Code,
+		DiscountType: orderV1.DiscountType(p.DiscountType),
+		Categories:   lo.Map(p.Categories, func(c model.Category, _ int) orderV1.PartCategory { return orderV1.PartCategory(c) }),
+		UsedCount:    p.UsedCount,
+		Active:       p.Active,
+		CreatedAt:    p.CreatedAt,
+	}
+
+	switch p.DiscountType {
+	case model.DiscountTypePERCENT:
+		dto.PercentOff = orderV1.NewOptInt64(p.PercentOff)
+	case model.DiscountTypeFIXED:
+		dto.AmountOff = orderV1.NewOptMoney(MoneyToAPI(p.AmountOff))
+	}
+
+	if p.MaxUses != nil {
+		dto.MaxUses = orderV1.NewOptInt64(*p.MaxUses)
+	}
+
+	if p.ExpiresAt != nil {
+		dto.ExpiresAt = orderV1.NewOptDateTime(*p.ExpiresAt)
+	}
+
+	return dto
+}
+
+// PromoCodesToAPI конвертирует []*model.PromoCode → []orderV1.PromoCode.
+func PromoCodesToAPI(promos []*model.PromoCode) []orderV1.PromoCode {
+	out := make([]orderV1.PromoCode, 0, len(promos))
+	for _, p := range promos {
+		if dto := PromoCodeToAPI(p); dto != nil {
+			out = append(out, *dto)
+		}
+	}
+	return out
+}
+
+// PromoCodeFromAPI конвертирует запрос CreatePromoCode → model.PromoCode.
+func PromoCodeFromAPI(req *orderV1.CreatePromoCodeRequest) model.PromoCode {
+	promo := model.PromoCode{
+		Code:         req.Code,
+		DiscountType: model.DiscountType(req.DiscountType),
+		PercentOff:   req.PercentOff.Or(0),
+		Categories:   lo.Map(req.Categories, func(c orderV1.PartCategory, _ int) model.Category { return model.Category(c) }),
+	}
+
+	if val, ok := req.AmountOff.Get(); ok {
+		promo.AmountOff = MoneyFromAPI(val)
+	}
+
+	if val, ok := req.MaxUses.Get(); ok {
+		promo.MaxUses = lo.ToPtr(val)
+	}
+
+	if val, ok := req.ExpiresAt.Get(); ok {
+		promo.ExpiresAt = lo.ToPtr(val)
+	}
+
+	return promo
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/openapi/order/v1"
+)
+
+// QuoteToAPI конвертирует model.Quote → orderV1.QuoteResponse.
+func QuoteToAPI(q *model.Quote) *orderV1.QuoteResponse {
+	resp := &orderV1.QuoteResponse{
+		QuoteToken: q.Token,
+		Items:      quoteLinesToAPI(q.Items),
+		Subtotal:   MoneyToAPI(q.Subtotal),
+		Discount:   MoneyToAPI(q.Discount),
+		TotalPrice: MoneyToAPI(q.Total),
+		ExpiresAt:  q.ExpiresAt,
+	}
+
+	if q.PromoCode != nil {
+		resp.PromoCode = orderV1.NewOptString(*q.PromoCode)
+	}
+
+	return resp
+}
+
+// PriceQuoteToAPI конвертирует model.PriceChangedError → orderV1.PriceQuote.
+func PriceQuoteToAPI(e *model.PriceChangedError) orderV1.PriceQuote {
+	quote := orderV1.PriceQuote{
+		Items:                quoteLinesToAPI(e.Quote.Items),
+		Subtotal:             MoneyToAPI(e.Quote.Subtotal),
+		Discount:             MoneyToAPI(e.Quote.Discount),
+		TotalPrice:           MoneyToAPI(e.Quote.Total),
+		ChangedPartUuids:     e.ChangedPartUUIDs,
+		UnavailablePartUuids: e.UnavailablePartUUIDs,
+	}
+
+	if e.Quote.PromoCode != nil {
+		quote.PromoCode = orderV1.NewOptString(*e.Quote.PromoCode)
+	}
+
+	return quote
+}
+
+func quoteLinesToAPI(items []model.OrderItem) []orderV1.QuoteLine {
+	lines := make([]orderV1.QuoteLine, 0, len(items))
+	for _, i := range items {
+		lines = append(lines, orderV1.QuoteLine{
+			PartUUID:  i.PartUUID,
+			PartName:  i.PartName,
+			Quantity:  i.Quantity,
+			UnitPrice: MoneyToAPI(i.UnitPrice),
+			LineTotal: MoneyToAPI(i.Total()),
+		})
+	}
+
+	return lines
+}
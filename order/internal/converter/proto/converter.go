@@ -0,0 +1,169 @@
+package proto
+
+import (
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+	orderV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/order/v1"
+)
+
+// OrderToProto конвертирует service-модель → protobuf.
+func OrderToProto(o *model.Order) *orderV1.Order {
+	if o == nil {
+		return nil
+	}
+
+	order := &orderV1.Order{
+		OrderUuid:     o.OrderUUID,
+		UserUuid:      o.UserUUID,
+		Items:         OrderItemsToProto(o.Items),
+		TotalPrice:    money.ToProto(o.TotalPrice),
+		Subtotal:      money.ToProto(o.Subtotal),
+		Discount:      money.ToProto(o.Discount),
+		PaymentMethod: PaymentMethodToProto(o.PaymentMethod),
+		Status:        OrderStatusToProto(o.Status),
+	}
+
+	if o.TransactionUUID != nil {
+		order.TransactionUuid = *o.TransactionUUID
+	}
+
+	if o.PromoCode != nil {
+		order.PromoCode = *o.PromoCode
+	}
+
+	if !o.CreatedAt.IsZero() {
+		order.CreatedAt = timestamppb.New(o.CreatedAt)
+	}
+
+	return order
+}
+
+// OrdersToProto конвертирует []*model.Order → []*orderV1.Order.
+func OrdersToProto(orders []*model.Order) []*orderV1.Order {
+	out := make([]*orderV1.Order, 0, len(orders))
+	for _, o := range orders {
+		if order := OrderToProto(o); order != nil {
+			out = append(out, order)
+		}
+	}
+	return out
+}
+
+// OrderItemsToProto конвертирует []model.OrderItem → []*orderV1.OrderItem.
+func OrderItemsToProto(items []model.OrderItem) []*orderV1.OrderItem {
+	out := make([]*orderV1.OrderItem, 0, len(items))
+	for _, i := range items {
+		out = append(out, &orderV1.OrderItem{
+			PartUuid:  i.PartUUID,
+			PartName:  i.PartName,
+			Quantity:  i.Quantity,
+			UnitPrice: money.ToProto(i.UnitPrice),
+		})
+	}
+	return out
+}
+
+// QuoteToProto конвертирует model.Quote → orderV1.QuoteOrderResponse.
+func QuoteToProto(q *model.Quote) *orderV1.QuoteOrderResponse {
+	resp := &orderV1.QuoteOrderResponse{
+		QuoteToken: q.Token,
+		Items:      OrderItemsToProto(q.Items),
+		Subtotal:   money.ToProto(q.Subtotal),
+		Discount:   money.ToProto(q.Discount),
+		TotalPrice: money.ToProto(q.Total),
+		ExpiresAt:  timestamppb.New(q.ExpiresAt),
+	}
+
+	if q.PromoCode != nil {
+		resp.PromoCode = *q.PromoCode
+	}
+
+	return resp
+}
+
+// OrderItemsFromProto конвертирует позиции запроса CreateOrder → []model.OrderItem.
+func OrderItemsFromProto(items []*orderV1.CreateOrderItem) []model.OrderItem {
+	out := make([]model.OrderItem, 0, len(items))
+	for _, i := range items {
+		out = append(out, model.OrderItem{
+			PartUUID: i.GetPartUuid(),
+			Quantity: i.GetQuantity(),
+		})
+	}
+	return out
+}
+
+// OrdersFilterFromProto собирает фильтр заказов пользователя из запроса ListOrders.
+func OrdersFilterFromProto(userUUID string, req *orderV1.ListOrdersRequest) model.OrdersFilter {
+	filter := model.OrdersFilter{
+		UserUUID: userUUID,
+		Limit:    int(req.GetPageSize()),
+	}
+
+	for _, status := range req.GetStatuses() {
+		filter.Statuses = append(filter.Statuses, OrderStatusFromProto(status))
+	}
+
+	for _, method := range req.GetPaymentMethods() {
+		filter.PaymentMethods = append(filter.PaymentMethods, PaymentMethodFromProto(method))
+	}
+
+	if req.GetCreatedFrom() != nil {
+		filter.CreatedFrom = lo.ToPtr(req.GetCreatedFrom().AsTime())
+	}
+
+	if req.GetCreatedTo() != nil {
+		filter.CreatedTo = lo.ToPtr(req.GetCreatedTo().AsTime())
+	}
+
+	return filter
+}
+
+var orderStatusToProto = map[model.OrderStatus]orderV1.OrderStatus{
+	model.OrderStatusPENDINGPAYMENT: orderV1.OrderStatus_ORDER_STATUS_PENDING_PAYMENT,
+	model.OrderStatusPAID:           orderV1.OrderStatus_ORDER_STATUS_PAID,
+	model.OrderStatusCANCELLED:      orderV1.OrderStatus_ORDER_STATUS_CANCELLED,
+	model.OrderStatusASSEMBLED:      orderV1.OrderStatus_ORDER_STATUS_ASSEMBLED,
+	model.OrderStatusREFUNDED:       orderV1.OrderStatus_ORDER_STATUS_REFUNDED,
+	model.OrderStatusREFUNDING:      orderV1.OrderStatus_ORDER_STATUS_REFUNDING,
+}
+
+// OrderStatusToProto конвертирует model.OrderStatus → orderV1.OrderStatus.
+func OrderStatusToProto(status model.OrderStatus) orderV1.OrderStatus {
+	return orderStatusToProto[status]
+}
+
+// OrderStatusFromProto конвертирует orderV1.OrderStatus → model.OrderStatus.
+func OrderStatusFromProto(status orderV1.OrderStatus) model.OrderStatus {
+	for s, p := range orderStatusToProto {
+		if p == status {
+			return s
+		}
+	}
+	return model.OrderStatusUNSPECIFIED
+}
+
+var paymentMethodToProto = map[model.PaymentMethod]orderV1.PaymentMethod{
+	model.PaymentMethodCard:          orderV1.PaymentMethod_PAYMENT_METHOD_CARD,
+	model.PaymentMethodSbp:           orderV1.PaymentMethod_PAYMENT_METHOD_SBP,
+	model.PaymentMethodCreditCard:    orderV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD,
+	model.PaymentMethodInvestorMoney: orderV1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY,
+}
+
+// PaymentMethodToProto конвертирует model.PaymentMethod → orderV1.PaymentMethod.
+func PaymentMethodToProto(method model.PaymentMethod) orderV1.PaymentMethod {
+	return paymentMethodToProto[method]
+}
+
+// PaymentMethodFromProto конвертирует orderV1.PaymentMethod → model.PaymentMethod.
+func PaymentMethodFromProto(method orderV1.PaymentMethod) model.PaymentMethod {
+	for m, p := range paymentMethodToProto {
+		if p == method {
+			return m
+		}
+	}
+	return model.PaymentMethodUnspecified
+}
@@ -0,0 +1,129 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ShipBlueprint правила комплектации корабля: сколько деталей каждой категории нужно
+// и какие характеристики деталей разных категорий должны совпадать.
+// Пустой шаблон ничего не проверяет.
+type ShipBlueprint struct {
+	Categories    []CategoryRule      `json:"categories"`
+	Compatibility []CompatibilityRule `json:"compatibility"`
+}
+
+// CategoryRule ограничивает количество деталей категории в заказе. Max = 0 — без верхней границы.
+type CategoryRule struct {
+	Category Category `json:"category"`
+	Min      int64    `json:"min"`
+	Max      int64    `json:"max"`
+}
+
+// CompatibilityRule требует, чтобы у деталей перечисленных категорий совпадало значение Metadata[Key].
+// Детали без этого ключа правило не ограничивает.
+type CompatibilityRule struct {
+	Key        string     `json:"key"`
+	Categories []Category `json:"categories"`
+}
+
+// DefaultShipBlueprint минимальная комплектация: двигатель, топливо, иллюминатор и пара крыльев.
+func DefaultShipBlueprint() ShipBlueprint {
+	return ShipBlueprint{
+		Categories: []CategoryRule{
+			{Category: CategoryEngine, Min: 1},
+			{Category: CategoryFuel, Min: 1},
+			{Category: CategoryPorthole, Min: 1},
+			{Category: CategoryWing, Min: 2},
+		},
+	}
+}
+
+// Validate проверяет, что правила не противоречат сами себе.
+func (b ShipBlueprint) Validate() error {
+	for _, rule := range b.Categories {
+		if rule.Min < 0 || rule.Max < 0 {
+			return fmt.Errorf("blueprint rule for %s: counts must not be negative", rule.Category)
+		}
+		if rule.Max > 0 && rule.Max < rule.Min {
+			return fmt.Errorf("blueprint rule for %s: max %d is less than min %d", rule.Category, rule.Max, rule.Min)
+		}
+	}
+
+	for _, rule := range b.Compatibility {
+		if rule.Key == "" {
+			return errors.New("blueprint compatibility rule: metadata key must not be empty")
+		}
+		if len(rule.Categories) == 0 {
+			return fmt.Errorf("blueprint compatibility rule %q: categories must not be empty", rule.Key)
+		}
+	}
+
+	return nil
+}
+
+// Check возвращает нарушения правил для позиций заказа. parts — детали позиций по UUID.
+func (b ShipBlueprint) Check(items []OrderItem, parts map[string]*Part) []string {
+	counts := make(map[Category]int64)
+	for _, item := range items {
+		if part, ok := parts[item.PartUUID]; ok {
+			counts[part.Category] += item.Quantity
+		}
+	}
+
+	var violations []string
+	for _, rule := range b.Categories {
+		count := counts[rule.Category]
+		if count < rule.Min {
+			violations = append(violations, fmt.Sprintf("%s: need at least %d, got %d", rule.Category, rule.Min, count))
+		}
+		if rule.Max > 0 && count > rule.Max {
+			violations = append(violations, fmt.Sprintf("%s: at most %d allowed, got %d", rule.Category, rule.Max, count))
+		}
+	}
+
+	for _, rule := range b.Compatibility {
+		if violation := rule.check(items, parts); violation != "" {
+			violations = append(violations, violation)
+		}
+	}
+
+	return violations
+}
+
+func (r CompatibilityRule) check(items []OrderItem, parts map[string]*Part) string {
+	// значение характеристики → детали с этим значением
+	byValue := make(map[string][]string)
+	for _, item := range items {
+		part, ok := parts[item.PartUUID]
+		if !ok || !slices.Contains(r.Categories, part.Category) {
+			continue
+		}
+		value, ok := part.Metadata[r.Key]
+		if !ok || value == nil {
+			continue
+		}
+		byValue[value.String()] = append(byValue[value.String()], part.Uuid)
+	}
+
+	if len(byValue) <= 1 {
+		return ""
+	}
+
+	values := make([]string, 0, len(byValue))
+	for value, partUUIDs := range byValue {
+		values = append(values, fmt.Sprintf("%s (%s)", value, strings.Join(partUUIDs, ", ")))
+	}
+	sort.Strings(values)
+
+	categories := make([]string, 0, len(r.Categories))
+	for _, category := range r.Categories {
+		categories = append(categories, string(category))
+	}
+
+	return fmt.Sprintf("%s parts must have the same %q, got %s",
+		strings.Join(categories, ", "), r.Key, strings.Join(values, "; "))
+}
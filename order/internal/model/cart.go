@@ -0,0 +1,13 @@
+package model
+
+// Cart корзина пользователя. Хранит только детали и их количество:
+// цены фиксируются при расчёте стоимости или оформлении заказа.
+type Cart struct {
+	UserUUID string
+	Items    []OrderItem
+}
+
+// IsEmpty сообщает, что в корзине нет позиций.
+func (c *Cart) IsEmpty() bool {
+	return len(c.Items) == 0
+}
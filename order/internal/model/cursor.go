@@ -0,0 +1,59 @@
+package model
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const cursorSeparator = "|"
+
+// OrderCursor позиция в выдаче заказов, отсортированной по (created_at, order_uuid) по убыванию.
+type OrderCursor struct {
+	CreatedAt time.Time
+	OrderUUID string
+}
+
+// NewOrderCursor строит курсор, указывающий на следующий после order элемент выдачи.
+func NewOrderCursor(order *Order) *OrderCursor {
+	return &OrderCursor{
+		CreatedAt: order.CreatedAt,
+		OrderUUID: order.OrderUUID,
+	}
+}
+
+// Encode сериализует курсор в непрозрачную для клиента строку.
+func (c *OrderCursor) Encode() string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + cursorSeparator + c.OrderUUID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeOrderCursor разбирает строку, полученную из Encode.
+func DecodeOrderCursor(s string) (*OrderCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, NewBadRequestError("invalid cursor")
+	}
+
+	createdAt, orderUUID, ok := strings.Cut(string(raw), cursorSeparator)
+	if !ok {
+		return nil, NewBadRequestError("invalid cursor")
+	}
+
+	// UUID попадает в сравнение с order_uuid в БД, поэтому подделанный курсор отсекаем здесь
+	if _, err = uuid.Parse(orderUUID); err != nil {
+		return nil, NewBadRequestError("invalid cursor")
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, NewBadRequestError("invalid cursor")
+	}
+
+	return &OrderCursor{
+		CreatedAt: t,
+		OrderUUID: orderUUID,
+	}, nil
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"strings"
 )
 
 type OrderNotFoundError struct {
@@ -35,3 +36,145 @@ func NewConflictError(message string) *ConflictError {
 		Message: message,
 	}
 }
+
+// OrderVersionConflictError заказ изменили параллельно: версия в хранилище отличается от прочитанной.
+type OrderVersionConflictError struct {
+	Code            int    `json:"code"`
+	OrderUUID       string `json:"order_uuid"`
+	ExpectedVersion int64  `json:"expected_version"`
+	ActualVersion   int64  `json:"actual_version"`
+}
+
+func (e *OrderVersionConflictError) Error() string {
+	return fmt.Sprintf("order %q was modified concurrently: expected version %d, got %d",
+		e.OrderUUID, e.ExpectedVersion, e.ActualVersion)
+}
+
+func NewOrderVersionConflictError(orderUUID string, expected, actual int64) *OrderVersionConflictError {
+	return &OrderVersionConflictError{
+		Code:            409,
+		OrderUUID:       orderUUID,
+		ExpectedVersion: expected,
+		ActualVersion:   actual,
+	}
+}
+
+type BadRequestError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *BadRequestError) Error() string {
+	return e.Message
+}
+
+func NewBadRequestError(message string) *BadRequestError {
+	return &BadRequestError{
+		Code:    400,
+		Message: message,
+	}
+}
+
+type ForbiddenError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *ForbiddenError) Error() string {
+	return e.Message
+}
+
+func NewForbiddenError(message string) *ForbiddenError {
+	return &ForbiddenError{
+		Code:    403,
+		Message: message,
+	}
+}
+
+type InsufficientStockError struct {
+	Code      int      `json:"code"`
+	PartUUIDs []string `json:"part_uuids"`
+}
+
+func (e *InsufficientStockError) Error() string {
+	return fmt.Sprintf("insufficient stock for parts [%s]", strings.Join(e.PartUUIDs, ", "))
+}
+
+func NewInsufficientStockError(partUUIDs []string) *InsufficientStockError {
+	return &InsufficientStockError{
+		Code:      409,
+		PartUUIDs: partUUIDs,
+	}
+}
+
+type PromoCodeNotFoundError struct {
+	Code      int    `json:"code"`
+	PromoCode string `json:"promo_code"`
+}
+
+func (e *PromoCodeNotFoundError) Error() string {
+	return fmt.Sprintf("promo code %q not found", e.PromoCode)
+}
+
+func NewPromoCodeNotFoundError(code string) *PromoCodeNotFoundError {
+	return &PromoCodeNotFoundError{
+		Code:      404,
+		PromoCode: code,
+	}
+}
+
+type QuoteNotFoundError struct {
+	Code  int    `json:"code"`
+	Token string `json:"token"`
+}
+
+func (e *QuoteNotFoundError) Error() string {
+	return fmt.Sprintf("quote %q not found", e.Token)
+}
+
+func NewQuoteNotFoundError(token string) *QuoteNotFoundError {
+	return &QuoteNotFoundError{
+		Code:  404,
+		Token: token,
+	}
+}
+
+// BlueprintViolationError состав заказа не собирается в корабль по правилам комплектации.
+type BlueprintViolationError struct {
+	Code       int      `json:"code"`
+	Violations []string `json:"violations"`
+}
+
+func (e *BlueprintViolationError) Error() string {
+	return fmt.Sprintf("order does not match the ship blueprint: %s", strings.Join(e.Violations, "; "))
+}
+
+func NewBlueprintViolationError(violations []string) *BlueprintViolationError {
+	return &BlueprintViolationError{
+		Code:       422,
+		Violations: violations,
+	}
+}
+
+// PriceChangedError с момента оформления заказа цены деталей изменились или детали сняты с продажи.
+// Quote содержит стоимость заказа по текущему каталогу.
+type PriceChangedError struct {
+	Code                 int      `json:"code"`
+	ChangedPartUUIDs     []string `json:"changed_part_uuids"`
+	UnavailablePartUUIDs []string `json:"unavailable_part_uuids"`
+	Quote                *Quote   `json:"quote"`
+}
+
+func (e *PriceChangedError) Error() string {
+	return fmt.Sprintf("order prices changed: changed parts [%s], unavailable parts [%s]",
+		strings.Join(e.ChangedPartUUIDs, ", "), strings.Join(e.UnavailablePartUUIDs, ", "))
+}
+
+func NewPriceChangedError(changed, unavailable []string, quote *Quote) *PriceChangedError {
+	return &PriceChangedError{
+		Code:                 409,
+		ChangedPartUUIDs:     changed,
+		UnavailablePartUUIDs: unavailable,
+		Quote:                quote,
+	}
+}
@@ -1,13 +1,65 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
 
 type OrderPaidEvent struct {
-	EventUuid       string
-	OrderUuid       string
-	UserUuid        string
-	PaymentMethod   PaymentMethod
-	TransactionUuid string
+	EventUuid       string        `json:"event_uuid"`
+	OrderUuid       string        `json:"order_uuid"`
+	UserUuid        string        `json:"user_uuid"`
+	PaymentMethod   PaymentMethod `json:"payment_method"`
+	TransactionUuid string        `json:"transaction_uuid"`
+}
+
+type OrderRefundedEvent struct {
+	EventUuid       string      `json:"event_uuid"`
+	OrderUuid       string      `json:"order_uuid"`
+	UserUuid        string      `json:"user_uuid"`
+	TransactionUuid string      `json:"transaction_uuid"`
+	RefundUuid      string      `json:"refund_uuid"`
+	Amount          money.Money `json:"amount"`
+}
+
+type OrderExpiredEvent struct {
+	EventUuid string    `json:"event_uuid"`
+	OrderUuid string    `json:"order_uuid"`
+	UserUuid  string    `json:"user_uuid"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+type OrderCreatedEvent struct {
+	EventUuid  string      `json:"event_uuid"`
+	OrderUuid  string      `json:"order_uuid"`
+	UserUuid   string      `json:"user_uuid"`
+	Items      []OrderItem `json:"items"`
+	TotalPrice money.Money `json:"total_price"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+type OrderCancelledEvent struct {
+	EventUuid      string      `json:"event_uuid"`
+	OrderUuid      string      `json:"order_uuid"`
+	UserUuid       string      `json:"user_uuid"`
+	PreviousStatus OrderStatus `json:"previous_status"`
+	Actor          string      `json:"actor"`
+	Reason         string      `json:"reason"`
+	CancelledAt    time.Time   `json:"cancelled_at"`
+}
+
+type OrderStatusChangedEvent struct {
+	EventUuid  string      `json:"event_uuid"`
+	OrderUuid  string      `json:"order_uuid"`
+	UserUuid   string      `json:"user_uuid"`
+	FromStatus OrderStatus `json:"from_status"`
+	ToStatus   OrderStatus `json:"to_status"`
+	Actor      string      `json:"actor"`
+	Reason     string      `json:"reason"`
+	ChangedAt  time.Time   `json:"changed_at"`
 }
 
 type ShipAssembledEvent struct {
@@ -16,3 +68,42 @@ type ShipAssembledEvent struct {
 	UserUuid  string
 	BuildTime time.Duration
 }
+
+// NewOrderCreatedEvent описывает только что созданный заказ.
+func NewOrderCreatedEvent(order *Order) OrderCreatedEvent {
+	return OrderCreatedEvent{
+		EventUuid:  uuid.NewString(),
+		OrderUuid:  order.OrderUUID,
+		UserUuid:   order.UserUUID,
+		Items:      order.Items,
+		TotalPrice: order.TotalPrice,
+		CreatedAt:  order.CreatedAt,
+	}
+}
+
+// NewOrderCancelledEvent описывает отмену заказа переходом change.
+func NewOrderCancelledEvent(order *Order, change OrderStatusChange) OrderCancelledEvent {
+	return OrderCancelledEvent{
+		EventUuid:      uuid.NewString(),
+		OrderUuid:      order.OrderUUID,
+		UserUuid:       order.UserUUID,
+		PreviousStatus: change.FromStatus,
+		Actor:          change.Actor,
+		Reason:         change.Reason,
+		CancelledAt:    change.ChangedAt,
+	}
+}
+
+// NewOrderStatusChangedEvent описывает переход change для публикации в Kafka.
+func NewOrderStatusChangedEvent(order *Order, change OrderStatusChange) OrderStatusChangedEvent {
+	return OrderStatusChangedEvent{
+		EventUuid:  uuid.NewString(),
+		OrderUuid:  order.OrderUUID,
+		UserUuid:   order.UserUUID,
+		FromStatus: change.FromStatus,
+		ToStatus:   change.ToStatus,
+		Actor:      change.Actor,
+		Reason:     change.Reason,
+		ChangedAt:  change.ChangedAt,
+	}
+}
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// OrdersFilter описывает выборку заказов пользователя.
+// Пустые срезы и nil-границы означают отсутствие фильтра по соответствующему полю.
+type OrdersFilter struct {
+	UserUUID       string
+	Statuses       []OrderStatus
+	PaymentMethods []PaymentMethod
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time
+	Limit          int
+	Cursor         *OrderCursor
+}
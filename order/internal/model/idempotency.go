@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// Операции, для которых поддерживается Idempotency-Key.
+const (
+	IdempotentCreateOrder = "create_order"
+	IdempotentPayOrder    = "pay_order"
+	IdempotentCheckout    = "checkout_cart"
+)
+
+// IdempotencyRecord сохранённый результат запроса с Idempotency-Key.
+// Ключ уникален в пределах пользователя и операции.
+type IdempotencyRecord struct {
+	UserUUID    string
+	Operation   string
+	Key         string
+	Fingerprint string
+	// Response результат операции в JSON; nil, пока запрос ещё выполняется.
+	Response  []byte
+	CreatedAt time.Time
+	// ExpiresAt время, после которого запись удаляется, а незавершённый запрос можно выполнить заново.
+	ExpiresAt time.Time
+}
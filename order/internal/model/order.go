@@ -1,11 +1,112 @@
 package model
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
 type Order struct {
 	OrderUUID       string        `json:"order_uuid"`
 	UserUUID        string        `json:"user_uuid"`
 	PartUuids       []string      `json:"part_uuids"`
-	TotalPrice      float64       `json:"total_price"`
+	Items           []OrderItem   `json:"items"`
+	Subtotal        money.Money   `json:"subtotal"`
+	Discount        money.Money   `json:"discount"`
+	TotalPrice      money.Money   `json:"total_price"`
+	PromoCode       *string       `json:"promo_code,omitempty"`
 	TransactionUUID *string       `json:"transaction_uuid,omitempty"`
+	ReservationUUID *string       `json:"reservation_uuid,omitempty"`
 	PaymentMethod   PaymentMethod `json:"payment_method,omitempty"`
 	Status          OrderStatus   `json:"status,omitempty"`
+	Version         int64         `json:"version"`
+	CreatedAt       time.Time     `json:"created_at"`
+
+	// QuoteToken расчёт, по которому оформляется заказ. Нужен только при создании заказа
+	// и в заказе не хранится: расчёт сам запоминает оформленный по нему заказ.
+	QuoteToken *string `json:"quote_token,omitempty"`
+}
+
+// OrderRequest состав нового заказа от клиента.
+// Если задан QuoteToken, позиции и промокод берутся из расчёта, а переданные должны с ним совпадать.
+type OrderRequest struct {
+	Items      []OrderItem `json:"items"`
+	PromoCode  string      `json:"promo_code,omitempty"`
+	QuoteToken string      `json:"quote_token,omitempty"`
+}
+
+// OrderPrice стоимость заказа: сумма позиций, скидка по промокоду и итог к оплате.
+type OrderPrice struct {
+	Subtotal money.Money `json:"subtotal"`
+	Discount money.Money `json:"discount"`
+	Total    money.Money `json:"total"`
+}
+
+// Price возвращает стоимость заказа.
+func (o *Order) Price() OrderPrice {
+	return OrderPrice{
+		Subtotal: o.Subtotal,
+		Discount: o.Discount,
+		Total:    o.TotalPrice,
+	}
+}
+
+// ExpireOrderFunc переводит просроченный заказ в новый статус
+// и возвращает запись истории и события outbox для сохранения вместе с ним.
+type ExpireOrderFunc func(order *Order) (OrderStatusChange, []OutboxEvent, error)
+
+// TransitionTo переводит заказ в статус next по таблице переходов и возвращает запись для истории.
+// Недопустимый переход возвращает ConflictError, заказ при этом не меняется.
+func (o *Order) TransitionTo(next OrderStatus, actor, reason string) (OrderStatusChange, error) {
+	if !o.Status.CanTransitionTo(next) {
+		return OrderStatusChange{}, NewConflictError(
+			fmt.Sprintf("cannot change order status from %s to %s", o.Status, next),
+		)
+	}
+
+	change := OrderStatusChange{
+		OrderUUID:  o.OrderUUID,
+		FromStatus: o.Status,
+		ToStatus:   next,
+		Actor:      actor,
+		Reason:     reason,
+		ChangedAt:  time.Now().UTC(),
+	}
+	o.Status = next
+
+	return change, nil
+}
+
+// CreatedStatusChange возвращает первую запись истории нового заказа: переход из UNSPECIFIED
+// в начальный статус, который делает владелец заказа в момент создания.
+func (o *Order) CreatedStatusChange() OrderStatusChange {
+	return OrderStatusChange{
+		OrderUUID:  o.OrderUUID,
+		FromStatus: OrderStatusUNSPECIFIED,
+		ToStatus:   o.Status,
+		Actor:      o.UserUUID,
+		Reason:     "order created",
+		ChangedAt:  o.CreatedAt,
+	}
+}
+
+// Cancel отменяет неоплаченный заказ. Если заказ уже нельзя отменить,
+// возвращает ConflictError с причиной, понятной клиенту.
+func (o *Order) Cancel(actor, reason string) (OrderStatusChange, error) {
+	change, err := o.TransitionTo(OrderStatusCANCELLED, actor, reason)
+	if err == nil {
+		return change, nil
+	}
+
+	switch o.Status {
+	case OrderStatusCANCELLED:
+		return OrderStatusChange{}, NewConflictError("cannot cancel a canceled order")
+	case OrderStatusREFUNDED:
+		return OrderStatusChange{}, NewConflictError("cannot cancel a refunded order")
+	case OrderStatusASSEMBLED:
+		return OrderStatusChange{}, NewConflictError("cannot cancel an assembled order")
+	default:
+		return OrderStatusChange{}, err
+	}
 }
@@ -0,0 +1,45 @@
+package model
+
+import "github.com/ZanDattSu/star-factory/platform/pkg/money"
+
+// OrderItem позиция заказа. PartName и UnitPrice фиксируются при создании заказа
+// и не меняются вместе с каталогом.
+type OrderItem struct {
+	PartUUID  string      `json:"part_uuid"`
+	PartName  string      `json:"part_name"`
+	Quantity  int64       `json:"quantity"`
+	UnitPrice money.Money `json:"unit_price"`
+}
+
+// Total стоимость позиции.
+func (i OrderItem) Total() money.Money {
+	return i.UnitPrice.Mul(i.Quantity)
+}
+
+// MergeOrderItems схлопывает повторяющиеся детали в одну позицию с суммарным количеством,
+// сохраняя порядок первого появления.
+func MergeOrderItems(items []OrderItem) []OrderItem {
+	merged := make([]OrderItem, 0, len(items))
+	index := make(map[string]int, len(items))
+
+	for _, item := range items {
+		if i, ok := index[item.PartUUID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		index[item.PartUUID] = len(merged)
+		merged = append(merged, item)
+	}
+
+	return merged
+}
+
+// OrderItemsFromParts строит позиции из списка UUID, где каждая деталь — одна единица.
+func OrderItemsFromParts(partUuids []string) []OrderItem {
+	items := make([]OrderItem, 0, len(partUuids))
+	for _, partUUID := range partUuids {
+		items = append(items, OrderItem{PartUUID: partUUID, Quantity: 1})
+	}
+
+	return MergeOrderItems(items)
+}
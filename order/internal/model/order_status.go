@@ -1,6 +1,9 @@
 package model
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+)
 
 type OrderStatus string
 
@@ -10,6 +13,10 @@ const (
 	OrderStatusPAID           OrderStatus = "PAID"
 	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
 	OrderStatusASSEMBLED      OrderStatus = "ASSEMBLED"
+	OrderStatusREFUNDED       OrderStatus = "REFUNDED"
+	// OrderStatusREFUNDING оплаченный заказ отменён, оплата возвращается.
+	// Статус фиксируется до обращения к платёжному сервису, чтобы заказ не собрали и не вернули дважды.
+	OrderStatusREFUNDING OrderStatus = "REFUNDING"
 )
 
 var orderStatusToID = map[OrderStatus]int{
@@ -18,6 +25,8 @@ var orderStatusToID = map[OrderStatus]int{
 	OrderStatusPAID:           3,
 	OrderStatusCANCELLED:      4,
 	OrderStatusASSEMBLED:      5,
+	OrderStatusREFUNDED:       6,
+	OrderStatusREFUNDING:      7,
 }
 
 var idToOrderStatus = map[int]OrderStatus{
@@ -26,6 +35,8 @@ var idToOrderStatus = map[int]OrderStatus{
 	3: OrderStatusPAID,
 	4: OrderStatusCANCELLED,
 	5: OrderStatusASSEMBLED,
+	6: OrderStatusREFUNDED,
+	7: OrderStatusREFUNDING,
 }
 
 func (s OrderStatus) ID() (int, error) {
@@ -43,3 +54,16 @@ func OrderStatusFromID(id int) (OrderStatus, error) {
 	}
 	return s, nil
 }
+
+// orderStatusTransitions — единственная таблица допустимых переходов между статусами заказа.
+var orderStatusTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusUNSPECIFIED:    {OrderStatusPENDINGPAYMENT},
+	OrderStatusPENDINGPAYMENT: {OrderStatusPAID, OrderStatusCANCELLED},
+	OrderStatusPAID:           {OrderStatusASSEMBLED, OrderStatusREFUNDING},
+	OrderStatusREFUNDING:      {OrderStatusREFUNDED},
+}
+
+// CanTransitionTo сообщает, разрешён ли переход из текущего статуса в next.
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	return slices.Contains(orderStatusTransitions[s], next)
+}
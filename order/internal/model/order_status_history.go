@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+const (
+	// ActorAssembly инициатор смены статуса по событию сборки корабля.
+	ActorAssembly = "assembly"
+	// ActorOrderExpiry инициатор отмены заказа, не оплаченного вовремя.
+	ActorOrderExpiry = "order-expiry"
+)
+
+// OrderStatusChange запись истории статусов заказа.
+// Actor — UUID пользователя либо имя сервиса-инициатора (ActorAssembly, ActorOrderExpiry).
+type OrderStatusChange struct {
+	OrderUUID  string      `json:"order_uuid"`
+	FromStatus OrderStatus `json:"from_status"`
+	ToStatus   OrderStatus `json:"to_status"`
+	Actor      string      `json:"actor"`
+	Reason     string      `json:"reason"`
+	ChangedAt  time.Time   `json:"changed_at"`
+}
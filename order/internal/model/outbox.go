@@ -0,0 +1,76 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Типы событий, которые сервис заказов публикует через outbox.
+const (
+	OutboxEventOrderPaid          = "order.paid"
+	OutboxEventOrderRefunded      = "order.refunded"
+	OutboxEventOrderExpired       = "order.expired"
+	OutboxEventOrderCreated       = "order.created"
+	OutboxEventOrderCancelled     = "order.cancelled"
+	OutboxEventOrderStatusChanged = "order.status_changed"
+)
+
+// OutboxEvent событие, сохранённое в одной транзакции с изменением заказа
+// и ожидающее публикации в Kafka.
+type OutboxEvent struct {
+	ID        int64
+	EventUUID string
+	EventType string
+	// Key ключ сообщения Kafka, как правило UUID заказа.
+	Key string
+	// Payload доменное событие в JSON, тип определяется EventType.
+	Payload   []byte
+	Attempts  int
+	CreatedAt time.Time
+}
+
+// NewOrderPaidOutboxEvent упаковывает OrderPaidEvent в запись outbox.
+func NewOrderPaidOutboxEvent(event OrderPaidEvent) (OutboxEvent, error) {
+	return newOutboxEvent(event.EventUuid, OutboxEventOrderPaid, event.OrderUuid, event)
+}
+
+// NewOrderRefundedOutboxEvent упаковывает OrderRefundedEvent в запись outbox.
+func NewOrderRefundedOutboxEvent(event OrderRefundedEvent) (OutboxEvent, error) {
+	return newOutboxEvent(event.EventUuid, OutboxEventOrderRefunded, event.OrderUuid, event)
+}
+
+// NewOrderExpiredOutboxEvent упаковывает OrderExpiredEvent в запись outbox.
+func NewOrderExpiredOutboxEvent(event OrderExpiredEvent) (OutboxEvent, error) {
+	return newOutboxEvent(event.EventUuid, OutboxEventOrderExpired, event.OrderUuid, event)
+}
+
+// NewOrderCreatedOutboxEvent упаковывает OrderCreatedEvent в запись outbox.
+func NewOrderCreatedOutboxEvent(event OrderCreatedEvent) (OutboxEvent, error) {
+	return newOutboxEvent(event.EventUuid, OutboxEventOrderCreated, event.OrderUuid, event)
+}
+
+// NewOrderCancelledOutboxEvent упаковывает OrderCancelledEvent в запись outbox.
+func NewOrderCancelledOutboxEvent(event OrderCancelledEvent) (OutboxEvent, error) {
+	return newOutboxEvent(event.EventUuid, OutboxEventOrderCancelled, event.OrderUuid, event)
+}
+
+// NewOrderStatusChangedOutboxEvent упаковывает OrderStatusChangedEvent в запись outbox.
+func NewOrderStatusChangedOutboxEvent(event OrderStatusChangedEvent) (OutboxEvent, error) {
+	return newOutboxEvent(event.EventUuid, OutboxEventOrderStatusChanged, event.OrderUuid, event)
+}
+
+func newOutboxEvent(eventUUID, eventType, key string, event any) (OutboxEvent, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return OutboxEvent{}, fmt.Errorf("failed to marshal %s event: %w", eventType, err)
+	}
+
+	return OutboxEvent{
+		EventUUID: eventUUID,
+		EventType: eventType,
+		Key:       key,
+		Payload:   payload,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
@@ -1,12 +1,16 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
 
 type Part struct {
 	Uuid          string            `json:"uuid"`
 	Name          string            `json:"name"`
 	Description   string            `json:"description"`
-	Price         float64           `json:"price"`
+	Price         money.Money       `json:"price"`
 	StockQuantity int64             `json:"stock_quantity"`
 	Category      Category          `json:"category"`
 	Dimensions    *Dimensions       `json:"dimensions"`
@@ -0,0 +1,130 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+type DiscountType string
+
+const (
+	// DiscountTypePERCENT скидка в процентах от стоимости подходящих позиций.
+	DiscountTypePERCENT DiscountType = "PERCENT"
+	// DiscountTypeFIXED фиксированная сумма, но не больше стоимости подходящих позиций.
+	DiscountTypeFIXED DiscountType = "FIXED"
+)
+
+var promoCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+type PromoCode struct {
+	Code         string       `json:"code"`
+	DiscountType DiscountType `json:"discount_type"`
+	// PercentOff процент скидки для DiscountTypePERCENT
+	PercentOff int64 `json:"percent_off"`
+	// AmountOff сумма скидки для DiscountTypeFIXED
+	AmountOff money.Money `json:"amount_off"`
+	// Categories категории деталей, на которые действует скидка. Пустой список — на все детали.
+	Categories []Category `json:"categories"`
+	// MaxUses сколько заказов можно оформить с кодом; nil — без ограничения.
+	MaxUses   *int64     `json:"max_uses,omitempty"`
+	UsedCount int64      `json:"used_count"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Active    bool       `json:"active"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// NormalizePromoCode приводит введённый код к виду, в котором он хранится.
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Validate проверяет настройки кода перед сохранением.
+func (p *PromoCode) Validate() error {
+	if !promoCodePattern.MatchString(p.Code) {
+		return NewBadRequestError("promo code must be 3-32 characters of A-Z, 0-9, '_' or '-'")
+	}
+
+	switch p.DiscountType {
+	case DiscountTypePERCENT:
+		if p.PercentOff < 1 || p.PercentOff > 100 {
+			return NewBadRequestError("percent_off must be between 1 and 100")
+		}
+	case DiscountTypeFIXED:
+		if p.AmountOff.Amount <= 0 || p.AmountOff.Currency == "" {
+			return NewBadRequestError("amount_off must be a positive amount with currency")
+		}
+	default:
+		return NewBadRequestError(fmt.Sprintf("unknown discount type %q", p.DiscountType))
+	}
+
+	if p.MaxUses != nil && *p.MaxUses <= 0 {
+		return NewBadRequestError("max_uses must be positive")
+	}
+
+	for _, category := range p.Categories {
+		if category == CategoryUnspecified || category == "" {
+			return NewBadRequestError("promo code categories must be specified")
+		}
+	}
+
+	return nil
+}
+
+// CheckRedeemable проверяет, что код можно применить к новому заказу в момент now.
+func (p *PromoCode) CheckRedeemable(now time.Time) error {
+	switch {
+	case !p.Active:
+		return NewBadRequestError(fmt.Sprintf("promo code %q is not active", p.Code))
+	case p.ExpiresAt != nil && !now.Before(*p.ExpiresAt):
+		return NewBadRequestError(fmt.Sprintf("promo code %q has expired", p.Code))
+	case p.MaxUses != nil && p.UsedCount >= *p.MaxUses:
+		return NewBadRequestError(fmt.Sprintf("promo code %q has reached its usage limit", p.Code))
+	}
+
+	return nil
+}
+
+// appliesTo сообщает, действует ли код на детали категории category.
+func (p *PromoCode) appliesTo(category Category) bool {
+	return len(p.Categories) == 0 || slices.Contains(p.Categories, category)
+}
+
+// Discount считает скидку для позиций items. categories — категории деталей по их UUID.
+// Процентная скидка округляется вниз до минимальной единицы валюты.
+func (p *PromoCode) Discount(items []OrderItem, categories map[string]Category) (money.Money, error) {
+	var eligible money.Money
+	for _, item := range items {
+		if !p.appliesTo(categories[item.PartUUID]) {
+			continue
+		}
+
+		var err error
+		eligible, err = eligible.Add(item.Total())
+		if err != nil {
+			return money.Money{}, fmt.Errorf("failed to calculate discount: %w", err)
+		}
+	}
+
+	if eligible.IsZero() {
+		return money.Money{}, NewBadRequestError(fmt.Sprintf("promo code %q does not apply to any item in the order", p.Code))
+	}
+
+	switch p.DiscountType {
+	case DiscountTypePERCENT:
+		return money.New(eligible.Amount*p.PercentOff/100, eligible.Currency), nil
+	case DiscountTypeFIXED:
+		if p.AmountOff.Currency != eligible.Currency {
+			return money.Money{}, NewBadRequestError(fmt.Sprintf(
+				"promo code %q is in %s, order is in %s", p.Code, p.AmountOff.Currency, eligible.Currency,
+			))
+		}
+		return money.New(min(p.AmountOff.Amount, eligible.Amount), eligible.Currency), nil
+	default:
+		return money.Money{}, fmt.Errorf("unknown discount type %q", p.DiscountType)
+	}
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+// Quote расчёт стоимости позиций без оформления заказа.
+// Пока расчёт не истёк, заказ по его токену оформляется по зафиксированным в нём ценам.
+type Quote struct {
+	Token     string      `json:"token"`
+	UserUUID  string      `json:"user_uuid"`
+	Items     []OrderItem `json:"items"`
+	Subtotal  money.Money `json:"subtotal"`
+	Discount  money.Money `json:"discount"`
+	Total     money.Money `json:"total"`
+	PromoCode *string     `json:"promo_code,omitempty"`
+	ExpiresAt time.Time   `json:"expires_at"`
+	CreatedAt time.Time   `json:"created_at"`
+
+	// OrderUUID заказ, оформленный по расчёту; пусто, пока расчёт не использован
+	OrderUUID *string `json:"order_uuid,omitempty"`
+}
+
+// Price возвращает стоимость по расчёту.
+func (q *Quote) Price() OrderPrice {
+	return OrderPrice{
+		Subtotal: q.Subtotal,
+		Discount: q.Discount,
+		Total:    q.Total,
+	}
+}
+
+// Expired сообщает, что по расчёту уже нельзя оформить заказ.
+func (q *Quote) Expired(now time.Time) bool {
+	return !now.Before(q.ExpiresAt)
+}
+
+// Used сообщает, что по расчёту уже оформлен заказ и второй раз его использовать нельзя.
+func (q *Quote) Used() bool {
+	return q.OrderUUID != nil
+}
+
+// HasItems сообщает, совпадают ли позиции расчёта с items по деталям и количеству без учёта порядка.
+func (q *Quote) HasItems(items []OrderItem) bool {
+	items = MergeOrderItems(items)
+	if len(items) != len(q.Items) {
+		return false
+	}
+
+	quantities := make(map[string]int64, len(q.Items))
+	for _, item := range q.Items {
+		quantities[item.PartUUID] = item.Quantity
+	}
+
+	for _, item := range items {
+		if quantities[item.PartUUID] != item.Quantity {
+			return false
+		}
+	}
+
+	return true
+}
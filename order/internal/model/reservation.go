@@ -0,0 +1,17 @@
+package model
+
+// ReservationItem позиция резерва деталей в inventory
+type ReservationItem struct {
+	PartUUID string
+	Quantity int64
+}
+
+// ReservationItemsFromOrderItems строит позиции резерва по позициям заказа.
+func ReservationItemsFromOrderItems(items []OrderItem) []ReservationItem {
+	out := make([]ReservationItem, 0, len(items))
+	for _, item := range items {
+		out = append(out, ReservationItem{PartUUID: item.PartUUID, Quantity: item.Quantity})
+	}
+
+	return out
+}
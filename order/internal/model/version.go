@@ -0,0 +1,22 @@
+package model
+
+import "errors"
+
+// MaxVersionConflictRetries сколько раз операция перечитывает заказ, если его изменили параллельно.
+const MaxVersionConflictRetries = 3
+
+// RetryOnVersionConflict повторяет fn, пока она возвращает OrderVersionConflictError, но не больше attempts раз.
+// fn должна сама перечитывать заказ: повтор со старой версией снова закончится конфликтом.
+func RetryOnVersionConflict(attempts int, fn func() error) error {
+	var err error
+	for range attempts {
+		err = fn()
+
+		versionConflict := &OrderVersionConflictError{}
+		if !errors.As(err, &versionConflict) {
+			return err
+		}
+	}
+
+	return err
+}
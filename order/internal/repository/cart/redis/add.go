@@ -0,0 +1,21 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+)
+
+func (r *repository) AddItem(ctx context.Context, userUUID, partUUID string, quantity int64) (int64, error) {
+	cacheKey := r.getCacheKey(userUUID)
+
+	total, err := r.cache.HIncrBy(ctx, cacheKey, partUUID, quantity)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add part %s to cart: %w", partUUID, err)
+	}
+
+	if err = r.cache.Expire(ctx, cacheKey, r.ttl); err != nil {
+		return 0, fmt.Errorf("failed to prolong cart: %w", err)
+	}
+
+	return total, nil
+}
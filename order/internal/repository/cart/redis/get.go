@@ -0,0 +1,45 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	redigo "github.com/gomodule/redigo/redis"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (r *repository) GetCart(ctx context.Context, userUUID string) (*model.Cart, error) {
+	cart := &model.Cart{UserUUID: userUUID}
+
+	values, err := r.cache.HGetAll(ctx, r.getCacheKey(userUUID))
+	if err != nil {
+		if errors.Is(err, redigo.ErrNil) {
+			return cart, nil
+		}
+		return nil, fmt.Errorf("failed to get cart: %w", err)
+	}
+
+	quantities, err := redigo.Int64Map(values, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cart: %w", err)
+	}
+
+	for partUUID, quantity := range quantities {
+		if quantity <= 0 {
+			continue
+		}
+		cart.Items = append(cart.Items, model.OrderItem{
+			PartUUID: partUUID,
+			Quantity: quantity,
+		})
+	}
+
+	sort.Slice(cart.Items, func(i, j int) bool {
+		return cart.Items[i].PartUUID < cart.Items[j].PartUUID
+	})
+
+	return cart, nil
+}
@@ -0,0 +1,36 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (r *repository) RemoveItem(ctx context.Context, userUUID, partUUID string) error {
+	cacheKey := r.getCacheKey(userUUID)
+
+	if err := r.cache.HDel(ctx, cacheKey, partUUID); err != nil {
+		return fmt.Errorf("failed to remove part %s from cart: %w", partUUID, err)
+	}
+
+	// Для пустой корзины ключа уже нет, EXPIRE ничего не сделает
+	if err := r.cache.Expire(ctx, cacheKey, r.ttl); err != nil {
+		return fmt.Errorf("failed to prolong cart: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repository) RemoveItems(ctx context.Context, userUUID string, items []model.OrderItem) error {
+	decrements := make(map[string]int64, len(items))
+	for _, item := range items {
+		decrements[item.PartUUID] += item.Quantity
+	}
+
+	if err := r.cache.HDecrBy(ctx, r.getCacheKey(userUUID), decrements); err != nil {
+		return fmt.Errorf("failed to remove parts from cart: %w", err)
+	}
+
+	return nil
+}
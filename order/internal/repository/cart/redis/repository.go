@@ -0,0 +1,32 @@
+package redis
+
+import (
+	"fmt"
+	"time"
+
+	repo "github.com/ZanDattSu/star-factory/order/internal/repository"
+	"github.com/ZanDattSu/star-factory/platform/pkg/cache"
+)
+
+// Компиляторная проверка: убеждаемся, что *repository реализует интерфейс CartRepository.
+var _ repo.CartRepository = (*repository)(nil)
+
+// Корзина хранится в hash: поле — UUID детали, значение — количество.
+const cacheKeyPrefix = "cart:"
+
+type repository struct {
+	cache cache.RedisClient
+	// ttl срок жизни корзины с последнего изменения
+	ttl time.Duration
+}
+
+func NewRepository(redisClient cache.RedisClient, ttl time.Duration) *repository {
+	return &repository{
+		cache: redisClient,
+		ttl:   ttl,
+	}
+}
+
+func (r *repository) getCacheKey(userUUID string) string {
+	return fmt.Sprintf("%s%s", cacheKeyPrefix, userUUID)
+}
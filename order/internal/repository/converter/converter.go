@@ -14,10 +14,17 @@ func OrderToRepoModel(o *model.Order) *repoModel.Order {
 		OrderUUID:       o.OrderUUID,
 		UserUUID:        o.UserUUID,
 		PartUuids:       o.PartUuids,
+		Items:           OrderItemsToRepoModel(o.Items),
+		Subtotal:        o.Subtotal,
+		Discount:        o.Discount,
 		TotalPrice:      o.TotalPrice,
+		PromoCode:       o.PromoCode,
 		TransactionUUID: o.TransactionUUID,
+		ReservationUUID: o.ReservationUUID,
 		PaymentMethod:   repoModel.PaymentMethod(o.PaymentMethod),
 		Status:          repoModel.OrderStatus(o.Status),
+		Version:         o.Version,
+		CreatedAt:       o.CreatedAt,
 	}
 }
 
@@ -30,10 +37,17 @@ func OrderToModel(o *repoModel.Order) *model.Order {
 		OrderUUID:       o.OrderUUID,
 		UserUUID:        o.UserUUID,
 		PartUuids:       o.PartUuids,
+		Items:           OrderItemsToModel(o.Items),
+		Subtotal:        o.Subtotal,
+		Discount:        o.Discount,
 		TotalPrice:      o.TotalPrice,
+		PromoCode:       o.PromoCode,
 		TransactionUUID: o.TransactionUUID,
+		ReservationUUID: o.ReservationUUID,
 		PaymentMethod:   model.PaymentMethod(o.PaymentMethod),
 		Status:          model.OrderStatus(o.Status),
+		Version:         o.Version,
+		CreatedAt:       o.CreatedAt,
 	}
 }
 
@@ -54,3 +68,54 @@ func OrdersToModel(orders []*repoModel.Order) []*model.Order {
 	}
 	return out
 }
+
+// OrderItemsToRepoModel конвертирует []model.OrderItem → []repoModel.OrderItem.
+func OrderItemsToRepoModel(items []model.OrderItem) []repoModel.OrderItem {
+	if items == nil {
+		return nil
+	}
+	out := make([]repoModel.OrderItem, 0, len(items))
+	for _, i := range items {
+		out = append(out, repoModel.OrderItem(i))
+	}
+	return out
+}
+
+// OrderItemsToModel конвертирует []repoModel.OrderItem → []model.OrderItem.
+func OrderItemsToModel(items []repoModel.OrderItem) []model.OrderItem {
+	if items == nil {
+		return nil
+	}
+	out := make([]model.OrderItem, 0, len(items))
+	for _, i := range items {
+		out = append(out, model.OrderItem(i))
+	}
+	return out
+}
+
+// OrderStatusChangeToRepoModel конвертирует model.OrderStatusChange → *repoModel.OrderStatusChange.
+func OrderStatusChangeToRepoModel(c model.OrderStatusChange) *repoModel.OrderStatusChange {
+	return &repoModel.OrderStatusChange{
+		OrderUUID:  c.OrderUUID,
+		FromStatus: repoModel.OrderStatus(c.FromStatus),
+		ToStatus:   repoModel.OrderStatus(c.ToStatus),
+		Actor:      c.Actor,
+		Reason:     c.Reason,
+		ChangedAt:  c.ChangedAt,
+	}
+}
+
+// OrderStatusChangeToModel конвертирует *repoModel.OrderStatusChange → *model.OrderStatusChange.
+func OrderStatusChangeToModel(c *repoModel.OrderStatusChange) *model.OrderStatusChange {
+	if c == nil {
+		return nil
+	}
+	return &model.OrderStatusChange{
+		OrderUUID:  c.OrderUUID,
+		FromStatus: model.OrderStatus(c.FromStatus),
+		ToStatus:   model.OrderStatus(c.ToStatus),
+		Actor:      c.Actor,
+		Reason:     c.Reason,
+		ChangedAt:  c.ChangedAt,
+	}
+}
@@ -0,0 +1,47 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+// Complete и Release меняют запись, только если её не занял повторный запрос после истечения pendingTTL:
+// попытку определяет created_at, заданный при резервировании.
+func (r *repository) Complete(ctx context.Context, record model.IdempotencyRecord, response []byte) error {
+	const query = `
+		UPDATE idempotency_keys
+		SET response = $5, expires_at = $6
+		WHERE user_uuid = $1 AND operation = $2 AND idempotency_key = $3 AND created_at = $4
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		record.UserUUID,
+		record.Operation,
+		record.Key,
+		record.CreatedAt,
+		response,
+		time.Now().UTC().Add(r.keyTTL),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repository) Release(ctx context.Context, record model.IdempotencyRecord) error {
+	const query = `
+		DELETE FROM idempotency_keys
+		WHERE user_uuid = $1 AND operation = $2 AND idempotency_key = $3 AND created_at = $4 AND response IS NULL
+	`
+
+	_, err := r.pool.Exec(ctx, query, record.UserUUID, record.Operation, record.Key, record.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+
+	return nil
+}
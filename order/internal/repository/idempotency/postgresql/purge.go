@@ -0,0 +1,27 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func (r *repository) PurgeExpired(ctx context.Context, now time.Time, limit int) (int, error) {
+	const query = `
+		DELETE FROM idempotency_keys
+		WHERE (user_uuid, operation, idempotency_key) IN (
+			SELECT user_uuid, operation, idempotency_key
+			FROM idempotency_keys
+			WHERE expires_at <= $1
+			ORDER BY expires_at
+			LIMIT $2
+		)
+	`
+
+	cmdTag, err := r.pool.Exec(ctx, query, now, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge expired idempotency keys: %w", err)
+	}
+
+	return int(cmdTag.RowsAffected()), nil
+}
@@ -0,0 +1,29 @@
+package postgresql
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	repo "github.com/ZanDattSu/star-factory/order/internal/repository"
+)
+
+// Компиляторная проверка: убеждаемся, что *repository реализует интерфейс IdempotencyRepository.
+var _ repo.IdempotencyRepository = (*repository)(nil)
+
+type repository struct {
+	pool *pgxpool.Pool
+
+	// keyTTL время хранения завершённой записи
+	keyTTL time.Duration
+	// pendingTTL время, после которого незавершённую запись может занять повторный запрос
+	pendingTTL time.Duration
+}
+
+func NewRepository(pool *pgxpool.Pool, keyTTL, pendingTTL time.Duration) *repository {
+	return &repository{
+		pool:       pool,
+		keyTTL:     keyTTL,
+		pendingTTL: pendingTTL,
+	}
+}
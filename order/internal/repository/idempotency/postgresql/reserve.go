@@ -0,0 +1,71 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (r *repository) Reserve(ctx context.Context, record model.IdempotencyRecord) (*model.IdempotencyRecord, bool, error) {
+	// Истёкшая запись занимается заново: её ответ уже не хранится,
+	// а незавершённый запрос считается зависшим
+	const insertQuery = `
+		INSERT INTO idempotency_keys(user_uuid,
+		                             operation,
+		                             idempotency_key,
+		                             fingerprint,
+		                             created_at,
+		                             expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_uuid, operation, idempotency_key) DO UPDATE
+		SET fingerprint = EXCLUDED.fingerprint,
+		    response    = NULL,
+		    created_at  = EXCLUDED.created_at,
+		    expires_at  = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+	`
+
+	cmdTag, err := r.pool.Exec(ctx, insertQuery,
+		record.UserUUID,
+		record.Operation,
+		record.Key,
+		record.Fingerprint,
+		record.CreatedAt,
+		record.CreatedAt.Add(r.pendingTTL),
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+	if cmdTag.RowsAffected() == 1 {
+		return nil, true, nil
+	}
+
+	const selectQuery = `
+		SELECT user_uuid,
+		       operation,
+		       idempotency_key,
+		       fingerprint,
+		       response,
+		       created_at,
+		       expires_at
+		FROM idempotency_keys
+		WHERE user_uuid = $1 AND operation = $2 AND idempotency_key = $3
+	`
+
+	var existing model.IdempotencyRecord
+	err = r.pool.QueryRow(ctx, selectQuery, record.UserUUID, record.Operation, record.Key).Scan(
+		&existing.UserUUID,
+		&existing.Operation,
+		&existing.Key,
+		&existing.Fingerprint,
+		&existing.Response,
+		&existing.CreatedAt,
+		&existing.ExpiresAt,
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	return &existing, false, nil
+}
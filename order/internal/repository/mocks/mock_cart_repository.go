@@ -0,0 +1,251 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/ZanDattSu/star-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CartRepository is an autogenerated mock type for the CartRepository type
+type CartRepository struct {
+	mock.Mock
+}
+
+type CartRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CartRepository) EXPECT() *CartRepository_Expecter {
+	return &CartRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddItem provides a mock function with given fields: ctx, userUUID, partUUID, quantity
+func (_m *CartRepository) AddItem(ctx context.Context, userUUID string, partUUID string, quantity int64) (int64, error) {
+	ret := _m.Called(ctx, userUUID, partUUID, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddItem")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) (int64, error)); ok {
+		return rf(ctx, userUUID, partUUID, quantity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) int64); ok {
+		r0 = rf(ctx, userUUID, partUUID, quantity)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64) error); ok {
+		r1 = rf(ctx, userUUID, partUUID, quantity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CartRepository_AddItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddItem'
+type CartRepository_AddItem_Call struct {
+	*mock.Call
+}
+
+// AddItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - partUUID string
+//   - quantity int64
+func (_e *CartRepository_Expecter) AddItem(ctx interface{}, userUUID interface{}, partUUID interface{}, quantity interface{}) *CartRepository_AddItem_Call {
+	return &CartRepository_AddItem_Call{Call: _e.mock.On("AddItem", ctx, userUUID, partUUID, quantity)}
+}
+
+func (_c *CartRepository_AddItem_Call) Run(run func(ctx context.Context, userUUID string, partUUID string, quantity int64)) *CartRepository_AddItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *CartRepository_AddItem_Call) Return(_a0 int64, _a1 error) *CartRepository_AddItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CartRepository_AddItem_Call) RunAndReturn(run func(context.Context, string, string, int64) (int64, error)) *CartRepository_AddItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCart provides a mock function with given fields: ctx, userUUID
+func (_m *CartRepository) GetCart(ctx context.Context, userUUID string) (*model.Cart, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCart")
+	}
+
+	var r0 *model.Cart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Cart, error)); ok {
+		return rf(ctx, userUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Cart); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Cart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CartRepository_GetCart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCart'
+type CartRepository_GetCart_Call struct {
+	*mock.Call
+}
+
+// GetCart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+func (_e *CartRepository_Expecter) GetCart(ctx interface{}, userUUID interface{}) *CartRepository_GetCart_Call {
+	return &CartRepository_GetCart_Call{Call: _e.mock.On("GetCart", ctx, userUUID)}
+}
+
+func (_c *CartRepository_GetCart_Call) Run(run func(ctx context.Context, userUUID string)) *CartRepository_GetCart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CartRepository_GetCart_Call) Return(_a0 *model.Cart, _a1 error) *CartRepository_GetCart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CartRepository_GetCart_Call) RunAndReturn(run func(context.Context, string) (*model.Cart, error)) *CartRepository_GetCart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveItem provides a mock function with given fields: ctx, userUUID, partUUID
+func (_m *CartRepository) RemoveItem(ctx context.Context, userUUID string, partUUID string) error {
+	ret := _m.Called(ctx, userUUID, partUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userUUID, partUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CartRepository_RemoveItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveItem'
+type CartRepository_RemoveItem_Call struct {
+	*mock.Call
+}
+
+// RemoveItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - partUUID string
+func (_e *CartRepository_Expecter) RemoveItem(ctx interface{}, userUUID interface{}, partUUID interface{}) *CartRepository_RemoveItem_Call {
+	return &CartRepository_RemoveItem_Call{Call: _e.mock.On("RemoveItem", ctx, userUUID, partUUID)}
+}
+
+func (_c *CartRepository_RemoveItem_Call) Run(run func(ctx context.Context, userUUID string, partUUID string)) *CartRepository_RemoveItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *CartRepository_RemoveItem_Call) Return(_a0 error) *CartRepository_RemoveItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CartRepository_RemoveItem_Call) RunAndReturn(run func(context.Context, string, string) error) *CartRepository_RemoveItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveItems provides a mock function with given fields: ctx, userUUID, items
+func (_m *CartRepository) RemoveItems(ctx context.Context, userUUID string, items []model.OrderItem) error {
+	ret := _m.Called(ctx, userUUID, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveItems")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.OrderItem) error); ok {
+		r0 = rf(ctx, userUUID, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CartRepository_RemoveItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveItems'
+type CartRepository_RemoveItems_Call struct {
+	*mock.Call
+}
+
+// RemoveItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - items []model.OrderItem
+func (_e *CartRepository_Expecter) RemoveItems(ctx interface{}, userUUID interface{}, items interface{}) *CartRepository_RemoveItems_Call {
+	return &CartRepository_RemoveItems_Call{Call: _e.mock.On("RemoveItems", ctx, userUUID, items)}
+}
+
+func (_c *CartRepository_RemoveItems_Call) Run(run func(ctx context.Context, userUUID string, items []model.OrderItem)) *CartRepository_RemoveItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]model.OrderItem))
+	})
+	return _c
+}
+
+func (_c *CartRepository_RemoveItems_Call) Return(_a0 error) *CartRepository_RemoveItems_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CartRepository_RemoveItems_Call) RunAndReturn(run func(context.Context, string, []model.OrderItem) error) *CartRepository_RemoveItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCartRepository creates a new instance of CartRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCartRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CartRepository {
+	mock := &CartRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,258 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/ZanDattSu/star-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// IdempotencyRepository is an autogenerated mock type for the IdempotencyRepository type
+type IdempotencyRepository struct {
+	mock.Mock
+}
+
+type IdempotencyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IdempotencyRepository) EXPECT() *IdempotencyRepository_Expecter {
+	return &IdempotencyRepository_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function with given fields: ctx, record, response
+func (_m *IdempotencyRepository) Complete(ctx context.Context, record model.IdempotencyRecord, response []byte) error {
+	ret := _m.Called(ctx, record, response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdempotencyRecord, []byte) error); ok {
+		r0 = rf(ctx, record, response)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdempotencyRepository_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type IdempotencyRepository_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record model.IdempotencyRecord
+//   - response []byte
+func (_e *IdempotencyRepository_Expecter) Complete(ctx interface{}, record interface{}, response interface{}) *IdempotencyRepository_Complete_Call {
+	return &IdempotencyRepository_Complete_Call{Call: _e.mock.On("Complete", ctx, record, response)}
+}
+
+func (_c *IdempotencyRepository_Complete_Call) Run(run func(ctx context.Context, record model.IdempotencyRecord, response []byte)) *IdempotencyRepository_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.IdempotencyRecord), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_Complete_Call) Return(_a0 error) *IdempotencyRepository_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotencyRepository_Complete_Call) RunAndReturn(run func(context.Context, model.IdempotencyRecord, []byte) error) *IdempotencyRepository_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeExpired provides a mock function with given fields: ctx, now, limit
+func (_m *IdempotencyRepository) PurgeExpired(ctx context.Context, now time.Time, limit int) (int, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeExpired")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (int, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) int); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdempotencyRepository_PurgeExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeExpired'
+type IdempotencyRepository_PurgeExpired_Call struct {
+	*mock.Call
+}
+
+// PurgeExpired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *IdempotencyRepository_Expecter) PurgeExpired(ctx interface{}, now interface{}, limit interface{}) *IdempotencyRepository_PurgeExpired_Call {
+	return &IdempotencyRepository_PurgeExpired_Call{Call: _e.mock.On("PurgeExpired", ctx, now, limit)}
+}
+
+func (_c *IdempotencyRepository_PurgeExpired_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *IdempotencyRepository_PurgeExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_PurgeExpired_Call) Return(_a0 int, _a1 error) *IdempotencyRepository_PurgeExpired_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IdempotencyRepository_PurgeExpired_Call) RunAndReturn(run func(context.Context, time.Time, int) (int, error)) *IdempotencyRepository_PurgeExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function with given fields: ctx, record
+func (_m *IdempotencyRepository) Release(ctx context.Context, record model.IdempotencyRecord) error {
+	ret := _m.Called(ctx, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdempotencyRecord) error); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdempotencyRepository_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type IdempotencyRepository_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record model.IdempotencyRecord
+func (_e *IdempotencyRepository_Expecter) Release(ctx interface{}, record interface{}) *IdempotencyRepository_Release_Call {
+	return &IdempotencyRepository_Release_Call{Call: _e.mock.On("Release", ctx, record)}
+}
+
+func (_c *IdempotencyRepository_Release_Call) Run(run func(ctx context.Context, record model.IdempotencyRecord)) *IdempotencyRepository_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.IdempotencyRecord))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_Release_Call) Return(_a0 error) *IdempotencyRepository_Release_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotencyRepository_Release_Call) RunAndReturn(run func(context.Context, model.IdempotencyRecord) error) *IdempotencyRepository_Release_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reserve provides a mock function with given fields: ctx, record
+func (_m *IdempotencyRepository) Reserve(ctx context.Context, record model.IdempotencyRecord) (*model.IdempotencyRecord, bool, error) {
+	ret := _m.Called(ctx, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reserve")
+	}
+
+	var r0 *model.IdempotencyRecord
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdempotencyRecord) (*model.IdempotencyRecord, bool, error)); ok {
+		return rf(ctx, record)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.IdempotencyRecord) *model.IdempotencyRecord); ok {
+		r0 = rf(ctx, record)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IdempotencyRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.IdempotencyRecord) bool); ok {
+		r1 = rf(ctx, record)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.IdempotencyRecord) error); ok {
+		r2 = rf(ctx, record)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// IdempotencyRepository_Reserve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reserve'
+type IdempotencyRepository_Reserve_Call struct {
+	*mock.Call
+}
+
+// Reserve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record model.IdempotencyRecord
+func (_e *IdempotencyRepository_Expecter) Reserve(ctx interface{}, record interface{}) *IdempotencyRepository_Reserve_Call {
+	return &IdempotencyRepository_Reserve_Call{Call: _e.mock.On("Reserve", ctx, record)}
+}
+
+func (_c *IdempotencyRepository_Reserve_Call) Run(run func(ctx context.Context, record model.IdempotencyRecord)) *IdempotencyRepository_Reserve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.IdempotencyRecord))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_Reserve_Call) Return(existing *model.IdempotencyRecord, reserved bool, err error) *IdempotencyRepository_Reserve_Call {
+	_c.Call.Return(existing, reserved, err)
+	return _c
+}
+
+func (_c *IdempotencyRepository_Reserve_Call) RunAndReturn(run func(context.Context, model.IdempotencyRecord) (*model.IdempotencyRecord, bool, error)) *IdempotencyRepository_Reserve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIdempotencyRepository creates a new instance of IdempotencyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdempotencyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IdempotencyRepository {
+	mock := &IdempotencyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
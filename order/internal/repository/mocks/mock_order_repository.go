@@ -7,6 +7,8 @@ import (
 
 	model "github.com/ZanDattSu/star-factory/order/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // OrderRepository is an autogenerated mock type for the OrderRepository type
@@ -22,6 +24,67 @@ func (_m *OrderRepository) EXPECT() *OrderRepository_Expecter {
 	return &OrderRepository_Expecter{mock: &_m.Mock}
 }
 
+// ExpireOrders provides a mock function with given fields: ctx, createdBefore, limit, expire
+func (_m *OrderRepository) ExpireOrders(ctx context.Context, createdBefore time.Time, limit int, expire model.ExpireOrderFunc) ([]*model.Order, error) {
+	ret := _m.Called(ctx, createdBefore, limit, expire)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireOrders")
+	}
+
+	var r0 []*model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, model.ExpireOrderFunc) ([]*model.Order, error)); ok {
+		return rf(ctx, createdBefore, limit, expire)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, model.ExpireOrderFunc) []*model.Order); ok {
+		r0 = rf(ctx, createdBefore, limit, expire)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int, model.ExpireOrderFunc) error); ok {
+		r1 = rf(ctx, createdBefore, limit, expire)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_ExpireOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireOrders'
+type OrderRepository_ExpireOrders_Call struct {
+	*mock.Call
+}
+
+// ExpireOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+//   - limit int
+//   - expire model.ExpireOrderFunc
+func (_e *OrderRepository_Expecter) ExpireOrders(ctx interface{}, createdBefore interface{}, limit interface{}, expire interface{}) *OrderRepository_ExpireOrders_Call {
+	return &OrderRepository_ExpireOrders_Call{Call: _e.mock.On("ExpireOrders", ctx, createdBefore, limit, expire)}
+}
+
+func (_c *OrderRepository_ExpireOrders_Call) Run(run func(ctx context.Context, createdBefore time.Time, limit int, expire model.ExpireOrderFunc)) *OrderRepository_ExpireOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int), args[3].(model.ExpireOrderFunc))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_ExpireOrders_Call) Return(_a0 []*model.Order, _a1 error) *OrderRepository_ExpireOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepository_ExpireOrders_Call) RunAndReturn(run func(context.Context, time.Time, int, model.ExpireOrderFunc) ([]*model.Order, error)) *OrderRepository_ExpireOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOrder provides a mock function with given fields: ctx, uuid
 func (_m *OrderRepository) GetOrder(ctx context.Context, uuid string) (*model.Order, error) {
 	ret := _m.Called(ctx, uuid)
@@ -81,17 +144,142 @@ func (_c *OrderRepository_GetOrder_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// PutOrder provides a mock function with given fields: ctx, uuid, order
-func (_m *OrderRepository) PutOrder(ctx context.Context, uuid string, order *model.Order) error {
-	ret := _m.Called(ctx, uuid, order)
+// GetOrderStatusHistory provides a mock function with given fields: ctx, orderUUID
+func (_m *OrderRepository) GetOrderStatusHistory(ctx context.Context, orderUUID string) ([]*model.OrderStatusChange, error) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderStatusHistory")
+	}
+
+	var r0 []*model.OrderStatusChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.OrderStatusChange, error)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.OrderStatusChange); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OrderStatusChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_GetOrderStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderStatusHistory'
+type OrderRepository_GetOrderStatusHistory_Call struct {
+	*mock.Call
+}
+
+// GetOrderStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *OrderRepository_Expecter) GetOrderStatusHistory(ctx interface{}, orderUUID interface{}) *OrderRepository_GetOrderStatusHistory_Call {
+	return &OrderRepository_GetOrderStatusHistory_Call{Call: _e.mock.On("GetOrderStatusHistory", ctx, orderUUID)}
+}
+
+func (_c *OrderRepository_GetOrderStatusHistory_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderRepository_GetOrderStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_GetOrderStatusHistory_Call) Return(_a0 []*model.OrderStatusChange, _a1 error) *OrderRepository_GetOrderStatusHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepository_GetOrderStatusHistory_Call) RunAndReturn(run func(context.Context, string) ([]*model.OrderStatusChange, error)) *OrderRepository_GetOrderStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function with given fields: ctx, filter
+func (_m *OrderRepository) ListOrders(ctx context.Context, filter model.OrdersFilter) ([]*model.Order, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 []*model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersFilter) ([]*model.Order, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersFilter) []*model.Order); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.OrdersFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type OrderRepository_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.OrdersFilter
+func (_e *OrderRepository_Expecter) ListOrders(ctx interface{}, filter interface{}) *OrderRepository_ListOrders_Call {
+	return &OrderRepository_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, filter)}
+}
+
+func (_c *OrderRepository_ListOrders_Call) Run(run func(ctx context.Context, filter model.OrdersFilter)) *OrderRepository_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrdersFilter))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_ListOrders_Call) Return(_a0 []*model.Order, _a1 error) *OrderRepository_ListOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepository_ListOrders_Call) RunAndReturn(run func(context.Context, model.OrdersFilter) ([]*model.Order, error)) *OrderRepository_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutOrder provides a mock function with given fields: ctx, uuid, order, events
+func (_m *OrderRepository) PutOrder(ctx context.Context, uuid string, order *model.Order, events ...model.OutboxEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, uuid, order)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PutOrder")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *model.Order) error); ok {
-		r0 = rf(ctx, uuid, order)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.Order, ...model.OutboxEvent) error); ok {
+		r0 = rf(ctx, uuid, order, events...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -108,13 +296,21 @@ type OrderRepository_PutOrder_Call struct {
 //   - ctx context.Context
 //   - uuid string
 //   - order *model.Order
-func (_e *OrderRepository_Expecter) PutOrder(ctx interface{}, uuid interface{}, order interface{}) *OrderRepository_PutOrder_Call {
-	return &OrderRepository_PutOrder_Call{Call: _e.mock.On("PutOrder", ctx, uuid, order)}
+//   - events ...model.OutboxEvent
+func (_e *OrderRepository_Expecter) PutOrder(ctx interface{}, uuid interface{}, order interface{}, events ...interface{}) *OrderRepository_PutOrder_Call {
+	return &OrderRepository_PutOrder_Call{Call: _e.mock.On("PutOrder",
+		append([]interface{}{ctx, uuid, order}, events...)...)}
 }
 
-func (_c *OrderRepository_PutOrder_Call) Run(run func(ctx context.Context, uuid string, order *model.Order)) *OrderRepository_PutOrder_Call {
+func (_c *OrderRepository_PutOrder_Call) Run(run func(ctx context.Context, uuid string, order *model.Order, events ...model.OutboxEvent)) *OrderRepository_PutOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(*model.Order))
+		variadicArgs := make([]model.OutboxEvent, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(model.OutboxEvent)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(*model.Order), variadicArgs...)
 	})
 	return _c
 }
@@ -124,7 +320,7 @@ func (_c *OrderRepository_PutOrder_Call) Return(_a0 error) *OrderRepository_PutO
 	return _c
 }
 
-func (_c *OrderRepository_PutOrder_Call) RunAndReturn(run func(context.Context, string, *model.Order) error) *OrderRepository_PutOrder_Call {
+func (_c *OrderRepository_PutOrder_Call) RunAndReturn(run func(context.Context, string, *model.Order, ...model.OutboxEvent) error) *OrderRepository_PutOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -177,6 +373,69 @@ func (_c *OrderRepository_UpdateOrder_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// UpdateOrderStatus provides a mock function with given fields: ctx, order, change, events
+func (_m *OrderRepository) UpdateOrderStatus(ctx context.Context, order *model.Order, change model.OrderStatusChange, events ...model.OutboxEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, order, change)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Order, model.OrderStatusChange, ...model.OutboxEvent) error); ok {
+		r0 = rf(ctx, order, change, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderRepository_UpdateOrderStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrderStatus'
+type OrderRepository_UpdateOrderStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateOrderStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - order *model.Order
+//   - change model.OrderStatusChange
+//   - events ...model.OutboxEvent
+func (_e *OrderRepository_Expecter) UpdateOrderStatus(ctx interface{}, order interface{}, change interface{}, events ...interface{}) *OrderRepository_UpdateOrderStatus_Call {
+	return &OrderRepository_UpdateOrderStatus_Call{Call: _e.mock.On("UpdateOrderStatus",
+		append([]interface{}{ctx, order, change}, events...)...)}
+}
+
+func (_c *OrderRepository_UpdateOrderStatus_Call) Run(run func(ctx context.Context, order *model.Order, change model.OrderStatusChange, events ...model.OutboxEvent)) *OrderRepository_UpdateOrderStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]model.OutboxEvent, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(model.OutboxEvent)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*model.Order), args[2].(model.OrderStatusChange), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *OrderRepository_UpdateOrderStatus_Call) Return(_a0 error) *OrderRepository_UpdateOrderStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderRepository_UpdateOrderStatus_Call) RunAndReturn(run func(context.Context, *model.Order, model.OrderStatusChange, ...model.OutboxEvent) error) *OrderRepository_UpdateOrderStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewOrderRepository creates a new instance of OrderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOrderRepository(t interface {
@@ -0,0 +1,195 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/ZanDattSu/star-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// OutboxRepository is an autogenerated mock type for the OutboxRepository type
+type OutboxRepository struct {
+	mock.Mock
+}
+
+type OutboxRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OutboxRepository) EXPECT() *OutboxRepository_Expecter {
+	return &OutboxRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimPending provides a mock function with given fields: ctx, limit, lease
+func (_m *OutboxRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*model.OutboxEvent, error) {
+	ret := _m.Called(ctx, limit, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimPending")
+	}
+
+	var r0 []*model.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Duration) ([]*model.OutboxEvent, error)); ok {
+		return rf(ctx, limit, lease)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Duration) []*model.OutboxEvent); ok {
+		r0 = rf(ctx, limit, lease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Duration) error); ok {
+		r1 = rf(ctx, limit, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxRepository_ClaimPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimPending'
+type OutboxRepository_ClaimPending_Call struct {
+	*mock.Call
+}
+
+// ClaimPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - lease time.Duration
+func (_e *OutboxRepository_Expecter) ClaimPending(ctx interface{}, limit interface{}, lease interface{}) *OutboxRepository_ClaimPending_Call {
+	return &OutboxRepository_ClaimPending_Call{Call: _e.mock.On("ClaimPending", ctx, limit, lease)}
+}
+
+func (_c *OutboxRepository_ClaimPending_Call) Run(run func(ctx context.Context, limit int, lease time.Duration)) *OutboxRepository_ClaimPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *OutboxRepository_ClaimPending_Call) Return(_a0 []*model.OutboxEvent, _a1 error) *OutboxRepository_ClaimPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxRepository_ClaimPending_Call) RunAndReturn(run func(context.Context, int, time.Duration) ([]*model.OutboxEvent, error)) *OutboxRepository_ClaimPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkFailed provides a mock function with given fields: ctx, id, reason, retryAfter
+func (_m *OutboxRepository) MarkFailed(ctx context.Context, id int64, reason string, retryAfter time.Duration) error {
+	ret := _m.Called(ctx, id, reason, retryAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkFailed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, time.Duration) error); ok {
+		r0 = rf(ctx, id, reason, retryAfter)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxRepository_MarkFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFailed'
+type OutboxRepository_MarkFailed_Call struct {
+	*mock.Call
+}
+
+// MarkFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - reason string
+//   - retryAfter time.Duration
+func (_e *OutboxRepository_Expecter) MarkFailed(ctx interface{}, id interface{}, reason interface{}, retryAfter interface{}) *OutboxRepository_MarkFailed_Call {
+	return &OutboxRepository_MarkFailed_Call{Call: _e.mock.On("MarkFailed", ctx, id, reason, retryAfter)}
+}
+
+func (_c *OutboxRepository_MarkFailed_Call) Run(run func(ctx context.Context, id int64, reason string, retryAfter time.Duration)) *OutboxRepository_MarkFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *OutboxRepository_MarkFailed_Call) Return(_a0 error) *OutboxRepository_MarkFailed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxRepository_MarkFailed_Call) RunAndReturn(run func(context.Context, int64, string, time.Duration) error) *OutboxRepository_MarkFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSent provides a mock function with given fields: ctx, id
+func (_m *OutboxRepository) MarkSent(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxRepository_MarkSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSent'
+type OutboxRepository_MarkSent_Call struct {
+	*mock.Call
+}
+
+// MarkSent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *OutboxRepository_Expecter) MarkSent(ctx interface{}, id interface{}) *OutboxRepository_MarkSent_Call {
+	return &OutboxRepository_MarkSent_Call{Call: _e.mock.On("MarkSent", ctx, id)}
+}
+
+func (_c *OutboxRepository_MarkSent_Call) Run(run func(ctx context.Context, id int64)) *OutboxRepository_MarkSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *OutboxRepository_MarkSent_Call) Return(_a0 error) *OutboxRepository_MarkSent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxRepository_MarkSent_Call) RunAndReturn(run func(context.Context, int64) error) *OutboxRepository_MarkSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOutboxRepository creates a new instance of OutboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxRepository {
+	mock := &OutboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
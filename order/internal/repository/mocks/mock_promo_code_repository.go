@@ -0,0 +1,260 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/ZanDattSu/star-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PromoCodeRepository is an autogenerated mock type for the PromoCodeRepository type
+type PromoCodeRepository struct {
+	mock.Mock
+}
+
+type PromoCodeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PromoCodeRepository) EXPECT() *PromoCodeRepository_Expecter {
+	return &PromoCodeRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreatePromoCode provides a mock function with given fields: ctx, promo
+func (_m *PromoCodeRepository) CreatePromoCode(ctx context.Context, promo *model.PromoCode) error {
+	ret := _m.Called(ctx, promo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePromoCode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PromoCode) error); ok {
+		r0 = rf(ctx, promo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PromoCodeRepository_CreatePromoCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePromoCode'
+type PromoCodeRepository_CreatePromoCode_Call struct {
+	*mock.Call
+}
+
+// CreatePromoCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - promo *model.PromoCode
+func (_e *PromoCodeRepository_Expecter) CreatePromoCode(ctx interface{}, promo interface{}) *PromoCodeRepository_CreatePromoCode_Call {
+	return &PromoCodeRepository_CreatePromoCode_Call{Call: _e.mock.On("CreatePromoCode", ctx, promo)}
+}
+
+func (_c *PromoCodeRepository_CreatePromoCode_Call) Run(run func(ctx context.Context, promo *model.PromoCode)) *PromoCodeRepository_CreatePromoCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PromoCode))
+	})
+	return _c
+}
+
+func (_c *PromoCodeRepository_CreatePromoCode_Call) Return(_a0 error) *PromoCodeRepository_CreatePromoCode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PromoCodeRepository_CreatePromoCode_Call) RunAndReturn(run func(context.Context, *model.PromoCode) error) *PromoCodeRepository_CreatePromoCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeactivatePromoCode provides a mock function with given fields: ctx, code
+func (_m *PromoCodeRepository) DeactivatePromoCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivatePromoCode")
+	}
+
+	var r0 *model.PromoCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.PromoCode, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.PromoCode); ok {
+		r0 = rf(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PromoCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PromoCodeRepository_DeactivatePromoCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivatePromoCode'
+type PromoCodeRepository_DeactivatePromoCode_Call struct {
+	*mock.Call
+}
+
+// DeactivatePromoCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *PromoCodeRepository_Expecter) DeactivatePromoCode(ctx interface{}, code interface{}) *PromoCodeRepository_DeactivatePromoCode_Call {
+	return &PromoCodeRepository_DeactivatePromoCode_Call{Call: _e.mock.On("DeactivatePromoCode", ctx, code)}
+}
+
+func (_c *PromoCodeRepository_DeactivatePromoCode_Call) Run(run func(ctx context.Context, code string)) *PromoCodeRepository_DeactivatePromoCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PromoCodeRepository_DeactivatePromoCode_Call) Return(_a0 *model.PromoCode, _a1 error) *PromoCodeRepository_DeactivatePromoCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PromoCodeRepository_DeactivatePromoCode_Call) RunAndReturn(run func(context.Context, string) (*model.PromoCode, error)) *PromoCodeRepository_DeactivatePromoCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPromoCode provides a mock function with given fields: ctx, code
+func (_m *PromoCodeRepository) GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromoCode")
+	}
+
+	var r0 *model.PromoCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.PromoCode, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.PromoCode); ok {
+		r0 = rf(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PromoCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PromoCodeRepository_GetPromoCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromoCode'
+type PromoCodeRepository_GetPromoCode_Call struct {
+	*mock.Call
+}
+
+// GetPromoCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *PromoCodeRepository_Expecter) GetPromoCode(ctx interface{}, code interface{}) *PromoCodeRepository_GetPromoCode_Call {
+	return &PromoCodeRepository_GetPromoCode_Call{Call: _e.mock.On("GetPromoCode", ctx, code)}
+}
+
+func (_c *PromoCodeRepository_GetPromoCode_Call) Run(run func(ctx context.Context, code string)) *PromoCodeRepository_GetPromoCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PromoCodeRepository_GetPromoCode_Call) Return(_a0 *model.PromoCode, _a1 error) *PromoCodeRepository_GetPromoCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PromoCodeRepository_GetPromoCode_Call) RunAndReturn(run func(context.Context, string) (*model.PromoCode, error)) *PromoCodeRepository_GetPromoCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPromoCodes provides a mock function with given fields: ctx
+func (_m *PromoCodeRepository) ListPromoCodes(ctx context.Context) ([]*model.PromoCode, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPromoCodes")
+	}
+
+	var r0 []*model.PromoCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.PromoCode, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.PromoCode); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PromoCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PromoCodeRepository_ListPromoCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPromoCodes'
+type PromoCodeRepository_ListPromoCodes_Call struct {
+	*mock.Call
+}
+
+// ListPromoCodes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PromoCodeRepository_Expecter) ListPromoCodes(ctx interface{}) *PromoCodeRepository_ListPromoCodes_Call {
+	return &PromoCodeRepository_ListPromoCodes_Call{Call: _e.mock.On("ListPromoCodes", ctx)}
+}
+
+func (_c *PromoCodeRepository_ListPromoCodes_Call) Run(run func(ctx context.Context)) *PromoCodeRepository_ListPromoCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PromoCodeRepository_ListPromoCodes_Call) Return(_a0 []*model.PromoCode, _a1 error) *PromoCodeRepository_ListPromoCodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PromoCodeRepository_ListPromoCodes_Call) RunAndReturn(run func(context.Context) ([]*model.PromoCode, error)) *PromoCodeRepository_ListPromoCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPromoCodeRepository creates a new instance of PromoCodeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPromoCodeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PromoCodeRepository {
+	mock := &PromoCodeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
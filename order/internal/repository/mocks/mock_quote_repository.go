@@ -0,0 +1,143 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/ZanDattSu/star-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// QuoteRepository is an autogenerated mock type for the QuoteRepository type
+type QuoteRepository struct {
+	mock.Mock
+}
+
+type QuoteRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *QuoteRepository) EXPECT() *QuoteRepository_Expecter {
+	return &QuoteRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateQuote provides a mock function with given fields: ctx, quote
+func (_m *QuoteRepository) CreateQuote(ctx context.Context, quote *model.Quote) error {
+	ret := _m.Called(ctx, quote)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateQuote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Quote) error); ok {
+		r0 = rf(ctx, quote)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QuoteRepository_CreateQuote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQuote'
+type QuoteRepository_CreateQuote_Call struct {
+	*mock.Call
+}
+
+// CreateQuote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - quote *model.Quote
+func (_e *QuoteRepository_Expecter) CreateQuote(ctx interface{}, quote interface{}) *QuoteRepository_CreateQuote_Call {
+	return &QuoteRepository_CreateQuote_Call{Call: _e.mock.On("CreateQuote", ctx, quote)}
+}
+
+func (_c *QuoteRepository_CreateQuote_Call) Run(run func(ctx context.Context, quote *model.Quote)) *QuoteRepository_CreateQuote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Quote))
+	})
+	return _c
+}
+
+func (_c *QuoteRepository_CreateQuote_Call) Return(_a0 error) *QuoteRepository_CreateQuote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QuoteRepository_CreateQuote_Call) RunAndReturn(run func(context.Context, *model.Quote) error) *QuoteRepository_CreateQuote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQuote provides a mock function with given fields: ctx, token
+func (_m *QuoteRepository) GetQuote(ctx context.Context, token string) (*model.Quote, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuote")
+	}
+
+	var r0 *model.Quote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Quote, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Quote); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Quote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QuoteRepository_GetQuote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuote'
+type QuoteRepository_GetQuote_Call struct {
+	*mock.Call
+}
+
+// GetQuote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *QuoteRepository_Expecter) GetQuote(ctx interface{}, token interface{}) *QuoteRepository_GetQuote_Call {
+	return &QuoteRepository_GetQuote_Call{Call: _e.mock.On("GetQuote", ctx, token)}
+}
+
+func (_c *QuoteRepository_GetQuote_Call) Run(run func(ctx context.Context, token string)) *QuoteRepository_GetQuote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *QuoteRepository_GetQuote_Call) Return(_a0 *model.Quote, _a1 error) *QuoteRepository_GetQuote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QuoteRepository_GetQuote_Call) RunAndReturn(run func(context.Context, string) (*model.Quote, error)) *QuoteRepository_GetQuote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewQuoteRepository creates a new instance of QuoteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQuoteRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *QuoteRepository {
+	mock := &QuoteRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
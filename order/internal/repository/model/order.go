@@ -1,11 +1,31 @@
 package model
 
+import (
+	"time"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
 type Order struct {
 	OrderUUID       string        `json:"order_uuid"`
 	UserUUID        string        `json:"user_uuid"`
 	PartUuids       []string      `json:"part_uuids"`
-	TotalPrice      float64       `json:"total_price"`
+	Items           []OrderItem   `json:"items"`
+	Subtotal        money.Money   `json:"subtotal"`
+	Discount        money.Money   `json:"discount"`
+	TotalPrice      money.Money   `json:"total_price"`
+	PromoCode       *string       `json:"promo_code,omitempty"`
 	TransactionUUID *string       `json:"transaction_uuid,omitempty"`
+	ReservationUUID *string       `json:"reservation_uuid,omitempty"`
 	PaymentMethod   PaymentMethod `json:"payment_method,omitempty"`
 	Status          OrderStatus   `json:"status,omitempty"`
+	Version         int64         `json:"version"`
+	CreatedAt       time.Time     `json:"created_at"`
+}
+
+type OrderItem struct {
+	PartUUID  string      `json:"part_uuid"`
+	PartName  string      `json:"part_name"`
+	Quantity  int64       `json:"quantity"`
+	UnitPrice money.Money `json:"unit_price"`
 }
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+type OrderStatusChange struct {
+	OrderUUID  string      `json:"order_uuid"`
+	FromStatus OrderStatus `json:"from_status"`
+	ToStatus   OrderStatus `json:"to_status"`
+	Actor      string      `json:"actor"`
+	Reason     string      `json:"reason"`
+	ChangedAt  time.Time   `json:"changed_at"`
+}
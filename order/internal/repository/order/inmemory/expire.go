@@ -0,0 +1,43 @@
+package inmemory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/order/internal/repository/converter"
+	repoModel "github.com/ZanDattSu/star-factory/order/internal/repository/model"
+)
+
+func (r *repository) ExpireOrders(_ context.Context, createdBefore time.Time, limit int, expire model.ExpireOrderFunc) ([]*model.Order, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	candidates := make([]*model.Order, 0)
+	for _, stored := range r.orders {
+		if stored.Status == repoModel.OrderStatus(model.OrderStatusPENDINGPAYMENT) && stored.CreatedAt.Before(createdBefore) {
+			candidates = append(candidates, converter.OrderToModel(stored))
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b *model.Order) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	for _, order := range candidates {
+		change, events, err := expire(order)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = r.applyStatusChange(order, change, events); err != nil {
+			return nil, err
+		}
+	}
+
+	return candidates, nil
+}
@@ -0,0 +1,40 @@
+package inmemory
+
+import (
+	"time"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (s *SuiteRepository) TestExpireOrdersOnlyOldPending() {
+	now := time.Now()
+	orders := []*model.Order{
+		{OrderUUID: "old-pending", Status: model.OrderStatusPENDINGPAYMENT, CreatedAt: now.Add(-2 * time.Hour)},
+		{OrderUUID: "fresh-pending", Status: model.OrderStatusPENDINGPAYMENT, CreatedAt: now},
+		{OrderUUID: "old-paid", Status: model.OrderStatusPAID, CreatedAt: now.Add(-2 * time.Hour)},
+	}
+	for _, order := range orders {
+		s.Require().NoError(s.repo.PutOrder(s.ctx, order.OrderUUID, order))
+	}
+
+	expired, err := s.repo.ExpireOrders(s.ctx, now.Add(-time.Hour), 10, func(order *model.Order) (model.OrderStatusChange, []model.OutboxEvent, error) {
+		change, err := order.Cancel(model.ActorOrderExpiry, "payment timeout")
+		return change, nil, err
+	})
+	s.Require().NoError(err)
+	s.Require().Len(expired, 1)
+	s.Equal("old-pending", expired[0].OrderUUID)
+
+	got, err := s.repo.GetOrder(s.ctx, "old-pending")
+	s.Require().NoError(err)
+	s.Equal(model.OrderStatusCANCELLED, got.Status)
+
+	got, err = s.repo.GetOrder(s.ctx, "fresh-pending")
+	s.Require().NoError(err)
+	s.Equal(model.OrderStatusPENDINGPAYMENT, got.Status)
+
+	history, err := s.repo.GetOrderStatusHistory(s.ctx, "old-pending")
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Equal(model.ActorOrderExpiry, history[1].Actor)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 )
 
 func (s *SuiteRepository) TestPutAndGetOrderSuccess() {
@@ -11,7 +12,7 @@ func (s *SuiteRepository) TestPutAndGetOrderSuccess() {
 		OrderUUID:       "order-123",
 		UserUUID:        "user-999",
 		PartUuids:       []string{"part-1", "part-2"},
-		TotalPrice:      2500.50,
+		TotalPrice:      money.New(250050, money.DefaultCurrency),
 		PaymentMethod:   model.PaymentMethodCard,
 		Status:          model.OrderStatusPAID,
 		TransactionUUID: lo.ToPtr("txn-777"),
@@ -0,0 +1,68 @@
+package inmemory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/order/internal/repository/converter"
+)
+
+func (r *repository) ListOrders(_ context.Context, filter model.OrdersFilter) ([]*model.Order, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	orders := make([]*model.Order, 0)
+	for _, repoOrder := range r.orders {
+		order := converter.OrderToModel(repoOrder)
+		if matchesOrder(order, filter) {
+			orders = append(orders, order)
+		}
+	}
+
+	// Тот же порядок, что и в postgresql: (created_at, order_uuid) по убыванию
+	slices.SortFunc(orders, func(a, b *model.Order) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(b.OrderUUID, a.OrderUUID)
+	})
+
+	if filter.Limit > 0 && len(orders) > filter.Limit {
+		orders = orders[:filter.Limit]
+	}
+
+	return orders, nil
+}
+
+func matchesOrder(order *model.Order, filter model.OrdersFilter) bool {
+	if order.UserUUID != filter.UserUUID {
+		return false
+	}
+
+	if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, order.Status) {
+		return false
+	}
+
+	if len(filter.PaymentMethods) > 0 && !slices.Contains(filter.PaymentMethods, order.PaymentMethod) {
+		return false
+	}
+
+	if filter.CreatedFrom != nil && order.CreatedAt.Before(*filter.CreatedFrom) {
+		return false
+	}
+
+	if filter.CreatedTo != nil && !order.CreatedAt.Before(*filter.CreatedTo) {
+		return false
+	}
+
+	if filter.Cursor != nil {
+		c := order.CreatedAt.Compare(filter.Cursor.CreatedAt)
+		if c > 0 || (c == 0 && order.OrderUUID >= filter.Cursor.OrderUUID) {
+			return false
+		}
+	}
+
+	return true
+}
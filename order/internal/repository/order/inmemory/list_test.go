@@ -0,0 +1,41 @@
+package inmemory
+
+import (
+	"time"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (s *SuiteRepository) TestListOrdersFilterAndPagination() {
+	now := time.Now().UTC()
+
+	orders := []*model.Order{
+		{OrderUUID: "order-1", UserUUID: "user-1", Status: model.OrderStatusPAID, PaymentMethod: model.PaymentMethodCard, CreatedAt: now.Add(-3 * time.Hour)},
+		{OrderUUID: "order-2", UserUUID: "user-1", Status: model.OrderStatusPAID, PaymentMethod: model.PaymentMethodSbp, CreatedAt: now.Add(-2 * time.Hour)},
+		{OrderUUID: "order-3", UserUUID: "user-1", Status: model.OrderStatusCANCELLED, PaymentMethod: model.PaymentMethodCard, CreatedAt: now.Add(-1 * time.Hour)},
+		{OrderUUID: "order-4", UserUUID: "user-1", Status: model.OrderStatusPAID, PaymentMethod: model.PaymentMethodCard, CreatedAt: now},
+		{OrderUUID: "order-5", UserUUID: "user-2", Status: model.OrderStatusPAID, PaymentMethod: model.PaymentMethodCard, CreatedAt: now},
+	}
+	for _, o := range orders {
+		s.Require().NoError(s.repo.PutOrder(s.ctx, o.OrderUUID, o))
+	}
+
+	filter := model.OrdersFilter{
+		UserUUID: "user-1",
+		Statuses: []model.OrderStatus{model.OrderStatusPAID},
+		Limit:    2,
+	}
+
+	got, err := s.repo.ListOrders(s.ctx, filter)
+	s.Require().NoError(err)
+	s.Require().Len(got, 2)
+	s.Equal("order-4", got[0].OrderUUID)
+	s.Equal("order-2", got[1].OrderUUID)
+
+	filter.Cursor = model.NewOrderCursor(got[1])
+
+	got, err = s.repo.ListOrders(s.ctx, filter)
+	s.Require().NoError(err)
+	s.Require().Len(got, 1)
+	s.Equal("order-1", got[0].OrderUUID)
+}
@@ -5,11 +5,14 @@ import (
 
 	"github.com/ZanDattSu/star-factory/order/internal/model"
 	"github.com/ZanDattSu/star-factory/order/internal/repository/converter"
+	repoModel "github.com/ZanDattSu/star-factory/order/internal/repository/model"
 )
 
-func (r *repository) PutOrder(_ context.Context, uuid string, order *model.Order) error {
+func (r *repository) PutOrder(_ context.Context, uuid string, order *model.Order, events ...model.OutboxEvent) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.orders[uuid] = converter.OrderToRepoModel(order)
+	r.history[uuid] = []*repoModel.OrderStatusChange{converter.OrderStatusChangeToRepoModel(order.CreatedStatusChange())}
+	r.outbox = append(r.outbox, events...)
 	return nil
 }
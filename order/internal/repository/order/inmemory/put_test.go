@@ -1,6 +1,11 @@
 package inmemory
 
-import "github.com/ZanDattSu/star-factory/order/internal/model"
+import (
+	"time"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
 
 func (s *SuiteRepository) TestPutOrderOverridesExisting() {
 	order1 := &model.Order{
@@ -56,3 +61,40 @@ func (s *SuiteRepository) TestConcurrentAccess() {
 	<-done
 	s.True(true, "не должно паниковать при конкурентном доступе")
 }
+
+func (s *SuiteRepository) TestPutOrderKeepsItems() {
+	order := &model.Order{
+		OrderUUID: "order-items",
+		UserUUID:  "user-1",
+		Items: []model.OrderItem{
+			{PartUUID: "part-1", PartName: "Wing", Quantity: 4, UnitPrice: money.New(3050, money.DefaultCurrency)},
+			{PartUUID: "part-2", PartName: "Engine", Quantity: 1, UnitPrice: money.New(100000, money.DefaultCurrency)},
+		},
+		Status: model.OrderStatusPENDINGPAYMENT,
+	}
+
+	s.Require().NoError(s.repo.PutOrder(s.ctx, order.OrderUUID, order))
+
+	got, err := s.repo.GetOrder(s.ctx, order.OrderUUID)
+	s.Require().NoError(err)
+	s.Equal(order.Items, got.Items)
+}
+
+func (s *SuiteRepository) TestPutOrderRecordsCreatedStatus() {
+	order := &model.Order{
+		OrderUUID: "order-created",
+		UserUUID:  "user-1",
+		Status:    model.OrderStatusPENDINGPAYMENT,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	s.Require().NoError(s.repo.PutOrder(s.ctx, order.OrderUUID, order))
+
+	history, err := s.repo.GetOrderStatusHistory(s.ctx, order.OrderUUID)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Equal(model.OrderStatusUNSPECIFIED, history[0].FromStatus)
+	s.Equal(model.OrderStatusPENDINGPAYMENT, history[0].ToStatus)
+	s.Equal("user-1", history[0].Actor)
+	s.True(order.CreatedAt.Equal(history[0].ChangedAt))
+}
@@ -3,6 +3,7 @@ package inmemory
 import (
 	"sync"
 
+	"github.com/ZanDattSu/star-factory/order/internal/model"
 	repo "github.com/ZanDattSu/star-factory/order/internal/repository"
 	repoModel "github.com/ZanDattSu/star-factory/order/internal/repository/model"
 )
@@ -11,7 +12,10 @@ import (
 var _ repo.OrderRepository = (*repository)(nil)
 
 type repository struct {
-	orders map[string]*repoModel.Order
+	orders  map[string]*repoModel.Order
+	history map[string][]*repoModel.OrderStatusChange
+	// outbox события, сохранённые вместе со сменой статуса; relay для in-memory хранилища не предусмотрен.
+	outbox []model.OutboxEvent
 	mu     sync.RWMutex
 }
 
@@ -20,6 +24,7 @@ type repository struct {
 // избежать потери методов и облегчает тестирование и расширение реализации.
 func NewRepository() *repository {
 	return &repository{
-		orders: make(map[string]*repoModel.Order),
+		orders:  make(map[string]*repoModel.Order),
+		history: make(map[string][]*repoModel.OrderStatusChange),
 	}
 }
@@ -0,0 +1,52 @@
+package inmemory
+
+import (
+	"context"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/order/internal/repository/converter"
+)
+
+func (r *repository) UpdateOrderStatus(_ context.Context, order *model.Order, change model.OrderStatusChange, events ...model.OutboxEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.orders[order.OrderUUID]
+	if !ok {
+		return model.NewOrderNotFoundError(order.OrderUUID)
+	}
+
+	if stored.Version != order.Version {
+		return model.NewOrderVersionConflictError(order.OrderUUID, order.Version, stored.Version)
+	}
+
+	return r.applyStatusChange(order, change, events)
+}
+
+// applyStatusChange сохраняет заказ с увеличенной версией, запись истории и события outbox, дополненные OrderStatusChanged.
+// Вызывается под r.mu.
+func (r *repository) applyStatusChange(order *model.Order, change model.OrderStatusChange, events []model.OutboxEvent) error {
+	statusChanged, err := model.NewOrderStatusChangedOutboxEvent(model.NewOrderStatusChangedEvent(order, change))
+	if err != nil {
+		return err
+	}
+
+	order.Version++
+	r.orders[order.OrderUUID] = converter.OrderToRepoModel(order)
+	r.history[order.OrderUUID] = append(r.history[order.OrderUUID], converter.OrderStatusChangeToRepoModel(change))
+	r.outbox = append(r.outbox, append(events, statusChanged)...)
+
+	return nil
+}
+
+func (r *repository) GetOrderStatusHistory(_ context.Context, orderUUID string) ([]*model.OrderStatusChange, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	history := make([]*model.OrderStatusChange, 0, len(r.history[orderUUID]))
+	for _, change := range r.history[orderUUID] {
+		history = append(history, converter.OrderStatusChangeToModel(change))
+	}
+
+	return history, nil
+}
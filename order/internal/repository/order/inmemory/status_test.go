@@ -0,0 +1,69 @@
+package inmemory
+
+import (
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (s *SuiteRepository) TestUpdateOrderStatusRecordsHistory() {
+	order := &model.Order{
+		OrderUUID: "order-1",
+		UserUUID:  "user-1",
+		Status:    model.OrderStatusPENDINGPAYMENT,
+	}
+	s.Require().NoError(s.repo.PutOrder(s.ctx, order.OrderUUID, order))
+
+	change, err := order.TransitionTo(model.OrderStatusCANCELLED, "user-1", "cancelled by user")
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.UpdateOrderStatus(s.ctx, order, change))
+
+	got, err := s.repo.GetOrder(s.ctx, order.OrderUUID)
+	s.Require().NoError(err)
+	s.Equal(model.OrderStatusCANCELLED, got.Status)
+
+	history, err := s.repo.GetOrderStatusHistory(s.ctx, order.OrderUUID)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Equal(model.OrderStatusPENDINGPAYMENT, history[1].FromStatus)
+	s.Equal(model.OrderStatusCANCELLED, history[1].ToStatus)
+	s.Equal("user-1", history[1].Actor)
+
+	s.Require().Len(s.repo.outbox, 1)
+	s.Equal(model.OutboxEventOrderStatusChanged, s.repo.outbox[0].EventType)
+	s.Equal(order.OrderUUID, s.repo.outbox[0].Key)
+}
+
+func (s *SuiteRepository) TestUpdateOrderStatusConflict() {
+	order := &model.Order{
+		OrderUUID: "order-1",
+		UserUUID:  "user-1",
+		Status:    model.OrderStatusPENDINGPAYMENT,
+		Version:   1,
+	}
+	s.Require().NoError(s.repo.PutOrder(s.ctx, order.OrderUUID, order))
+
+	// Оба изменения прочитали заказ в версии 1, первое успевает раньше
+	paid, stale := *order, *order
+	change, err := paid.TransitionTo(model.OrderStatusPAID, "user-1", "")
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.UpdateOrderStatus(s.ctx, &paid, change))
+	s.Equal(int64(2), paid.Version)
+
+	change, err = stale.TransitionTo(model.OrderStatusCANCELLED, "user-1", "")
+	s.Require().NoError(err)
+
+	err = s.repo.UpdateOrderStatus(s.ctx, &stale, change)
+
+	var conflict *model.OrderVersionConflictError
+	s.Require().ErrorAs(err, &conflict)
+	s.Equal(int64(1), conflict.ExpectedVersion)
+	s.Equal(int64(2), conflict.ActualVersion)
+
+	got, err := s.repo.GetOrder(s.ctx, order.OrderUUID)
+	s.Require().NoError(err)
+	s.Equal(model.OrderStatusPAID, got.Status)
+
+	// Создание и первый переход; проигравшее изменение в историю не попало
+	history, err := s.repo.GetOrderStatusHistory(s.ctx, order.OrderUUID)
+	s.Require().NoError(err)
+	s.Len(history, 2)
+}
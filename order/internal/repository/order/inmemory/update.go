@@ -10,6 +10,28 @@ import (
 func (r *repository) UpdateOrder(_ context.Context, uuid string, order *model.Order) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	r.orders[uuid] = converter.OrderToRepoModel(order)
+
+	stored, ok := r.orders[uuid]
+	if !ok {
+		return model.NewOrderNotFoundError(uuid)
+	}
+
+	if stored.Version != order.Version {
+		return model.NewOrderVersionConflictError(uuid, order.Version, stored.Version)
+	}
+
+	// Как и в postgres, меняются только состав, стоимость и резерв; статус пишет UpdateOrderStatus
+	updated := *stored
+	updated.PartUuids = order.PartUuids
+	updated.Items = converter.OrderItemsToRepoModel(order.Items)
+	updated.Subtotal = order.Subtotal
+	updated.Discount = order.Discount
+	updated.TotalPrice = order.TotalPrice
+	updated.ReservationUUID = order.ReservationUUID
+	updated.Version++
+
+	r.orders[uuid] = &updated
+	order.Version = updated.Version
+
 	return nil
 }
@@ -0,0 +1,52 @@
+package inmemory
+
+import (
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+func (s *SuiteRepository) TestUpdateOrderIncrementsVersion() {
+	order := &model.Order{
+		OrderUUID: "order-update",
+		UserUUID:  "user-1",
+		Items:     []model.OrderItem{{PartUUID: "part-1", Quantity: 1, UnitPrice: money.New(100, money.DefaultCurrency)}},
+		Status:    model.OrderStatusPENDINGPAYMENT,
+		Version:   1,
+	}
+	s.Require().NoError(s.repo.PutOrder(s.ctx, order.OrderUUID, order))
+
+	edit := *order
+	edit.Items = []model.OrderItem{{PartUUID: "part-2", Quantity: 3, UnitPrice: money.New(50, money.DefaultCurrency)}}
+	edit.TotalPrice = money.New(150, money.DefaultCurrency)
+
+	s.Require().NoError(s.repo.UpdateOrder(s.ctx, edit.OrderUUID, &edit))
+	s.Equal(int64(2), edit.Version)
+
+	got, err := s.repo.GetOrder(s.ctx, order.OrderUUID)
+	s.Require().NoError(err)
+	s.Equal(int64(2), got.Version)
+	s.Equal(edit.Items, got.Items)
+	s.Equal(edit.TotalPrice, got.TotalPrice)
+}
+
+func (s *SuiteRepository) TestUpdateOrderStaleVersion() {
+	order := &model.Order{
+		OrderUUID: "order-stale",
+		UserUUID:  "user-1",
+		Status:    model.OrderStatusPENDINGPAYMENT,
+		Version:   1,
+	}
+	s.Require().NoError(s.repo.PutOrder(s.ctx, order.OrderUUID, order))
+
+	first, second := *order, *order
+	s.Require().NoError(s.repo.UpdateOrder(s.ctx, first.OrderUUID, &first))
+
+	var conflict *model.OrderVersionConflictError
+	s.Require().ErrorAs(s.repo.UpdateOrder(s.ctx, second.OrderUUID, &second), &conflict)
+	s.Equal(int64(1), second.Version)
+}
+
+func (s *SuiteRepository) TestUpdateOrderNotFound() {
+	var notFound *model.OrderNotFoundError
+	s.Require().ErrorAs(s.repo.UpdateOrder(s.ctx, "missing", &model.Order{OrderUUID: "missing", Version: 1}), &notFound)
+}
@@ -0,0 +1,75 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (r *repository) ExpireOrders(ctx context.Context, createdBefore time.Time, limit int, expire model.ExpireOrderFunc) ([]*model.Order, error) {
+	pendingStatusID, err := model.OrderStatusPENDINGPAYMENT.ID()
+	if err != nil {
+		return nil, fmt.Errorf("invalid order status: %w", err)
+	}
+
+	var expired []*model.Order
+	err = r.withTx(ctx, func(tx pgx.Tx) error {
+		// SKIP LOCKED позволяет нескольким экземплярам сервиса разбирать разные заказы
+		// и не ждать друг друга; блокировки держатся до конца транзакции
+		query := `
+			SELECT` + selectOrderColumns + `
+			FROM orders o
+			WHERE o.status_id = $1 AND o.created_at < $2
+			ORDER BY o.created_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		`
+
+		rows, err := tx.Query(ctx, query, pendingStatusID, createdBefore, limit)
+		if err != nil {
+			return fmt.Errorf("failed to query expired orders: %w", err)
+		}
+
+		orders := make([]*model.Order, 0, limit)
+		for rows.Next() {
+			order, err := scanOrder(rows)
+			if err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan expired order: %w", err)
+			}
+			orders = append(orders, order)
+		}
+		rows.Close()
+		if rows.Err() != nil {
+			return fmt.Errorf("rows error: %w", rows.Err())
+		}
+
+		expired = make([]*model.Order, 0, len(orders))
+		for _, order := range orders {
+			change, events, err := expire(order)
+			if err != nil {
+				return err
+			}
+
+			if err = updateOrderStatus(ctx, tx, order, change, events); err != nil {
+				return err
+			}
+			expired = append(expired, order)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, order := range expired {
+		order.Version++
+	}
+
+	return expired, nil
+}
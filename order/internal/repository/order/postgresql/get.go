@@ -9,41 +9,74 @@ import (
 	"github.com/ZanDattSu/star-factory/order/internal/model"
 )
 
-func (r *repository) GetOrder(ctx context.Context, uuid string) (*model.Order, error) {
-	const query = `
-		SELECT
+const selectOrderColumns = `
 			o.order_uuid,
 			o.user_uuid,
 			o.part_uuids,
-			o.total_price,
+			o.subtotal_minor,
+			o.discount_minor,
+			o.total_price_minor,
+			o.currency,
+			o.promo_code,
 			o.transaction_uuid,
+			o.reservation_uuid,
 			o.payment_method_id,
-			o.status_id
+			o.status_id,
+			o.version,
+			o.created_at
+`
+
+func (r *repository) GetOrder(ctx context.Context, uuid string) (*model.Order, error) {
+	query := `
+		SELECT` + selectOrderColumns + `
 		FROM orders o
 		WHERE o.order_uuid = $1
 	`
 
+	order, err := scanOrder(r.pool.QueryRow(ctx, query, uuid))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.NewOrderNotFoundError(uuid)
+		}
+		return nil, err
+	}
+
+	if err = r.loadOrderItems(ctx, order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// scanOrder читает строку, выбранную с колонками selectOrderColumns.
+func scanOrder(row pgx.Row) (*model.Order, error) {
 	var (
 		order           model.Order
 		paymentMethodID int
 		statusID        int
 	)
-	err := r.pool.QueryRow(ctx, query, uuid).Scan(
+	err := row.Scan(
 		&order.OrderUUID,
 		&order.UserUUID,
 		&order.PartUuids,
-		&order.TotalPrice,
+		&order.Subtotal.Amount,
+		&order.Discount.Amount,
+		&order.TotalPrice.Amount,
+		&order.TotalPrice.Currency,
+		&order.PromoCode,
 		&order.TransactionUUID,
+		&order.ReservationUUID,
 		&paymentMethodID,
 		&statusID,
+		&order.Version,
+		&order.CreatedAt,
 	)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, model.NewOrderNotFoundError(uuid)
-		}
 		return nil, err
 	}
 
+	order.Subtotal.Currency = order.TotalPrice.Currency
+	order.Discount.Currency = order.TotalPrice.Currency
 	order.PaymentMethod, _ = model.PaymentMethodFromID(paymentMethodID) //nolint:gosec
 	order.Status, _ = model.OrderStatusFromID(statusID)                 //nolint:gosec
 
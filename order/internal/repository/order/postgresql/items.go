@@ -0,0 +1,99 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+// insertOrderItems сохраняет позиции заказа в рамках переданной транзакции.
+func insertOrderItems(ctx context.Context, tx pgx.Tx, orderUUID string, items []model.OrderItem) error {
+	const query = `
+		INSERT INTO order_items(order_uuid,
+		                        part_uuid,
+		                        part_name,
+		                        quantity,
+		                        unit_price_minor,
+		                        currency)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	for _, item := range items {
+		_, err := tx.Exec(ctx, query,
+			orderUUID,
+			item.PartUUID,
+			item.PartName,
+			item.Quantity,
+			item.UnitPrice.Amount,
+			item.UnitPrice.Currency,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert item %s of order %s: %w", item.PartUUID, orderUUID, err)
+		}
+	}
+
+	return nil
+}
+
+// loadOrderItems подгружает позиции для переданных заказов одним запросом.
+func (r *repository) loadOrderItems(ctx context.Context, orders ...*model.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	byUUID := make(map[string]*model.Order, len(orders))
+	orderUUIDs := make([]string, 0, len(orders))
+	for _, order := range orders {
+		byUUID[order.OrderUUID] = order
+		orderUUIDs = append(orderUUIDs, order.OrderUUID)
+	}
+
+	const query = `
+		SELECT i.order_uuid,
+		       i.part_uuid,
+		       i.part_name,
+		       i.quantity,
+		       i.unit_price_minor,
+		       i.currency
+		FROM order_items i
+		WHERE i.order_uuid = ANY($1)
+		ORDER BY i.id
+	`
+
+	rows, err := r.pool.Query(ctx, query, orderUUIDs)
+	if err != nil {
+		return fmt.Errorf("failed to query order items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			orderUUID string
+			item      model.OrderItem
+		)
+		err = rows.Scan(
+			&orderUUID,
+			&item.PartUUID,
+			&item.PartName,
+			&item.Quantity,
+			&item.UnitPrice.Amount,
+			&item.UnitPrice.Currency,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan order item: %w", err)
+		}
+
+		if order, ok := byUUID[orderUUID]; ok {
+			order.Items = append(order.Items, item)
+		}
+	}
+
+	if rows.Err() != nil {
+		return fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (r *repository) ListOrders(ctx context.Context, filter model.OrdersFilter) ([]*model.Order, error) {
+	conditions := []string{"o.user_uuid = $1"}
+	args := []any{filter.UserUUID}
+
+	addCondition := func(format string, values ...any) {
+		placeholders := make([]any, 0, len(values))
+		for _, v := range values {
+			args = append(args, v)
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+		}
+		conditions = append(conditions, fmt.Sprintf(format, placeholders...))
+	}
+
+	if len(filter.Statuses) > 0 {
+		statusIDs := make([]int, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			id, err := status.ID()
+			if err != nil {
+				return nil, fmt.Errorf("invalid order status: %w", err)
+			}
+			statusIDs = append(statusIDs, id)
+		}
+		addCondition("o.status_id = ANY(%s)", statusIDs)
+	}
+
+	if len(filter.PaymentMethods) > 0 {
+		paymentMethodIDs := make([]int, 0, len(filter.PaymentMethods))
+		for _, method := range filter.PaymentMethods {
+			id, err := method.ID()
+			if err != nil {
+				return nil, fmt.Errorf("invalid payment method: %w", err)
+			}
+			paymentMethodIDs = append(paymentMethodIDs, id)
+		}
+		addCondition("o.payment_method_id = ANY(%s)", paymentMethodIDs)
+	}
+
+	if filter.CreatedFrom != nil {
+		addCondition("o.created_at >= %s", *filter.CreatedFrom)
+	}
+
+	if filter.CreatedTo != nil {
+		addCondition("o.created_at < %s", *filter.CreatedTo)
+	}
+
+	// Keyset-пагинация: берём строки строго после курсора в порядке (created_at, order_uuid) DESC
+	if filter.Cursor != nil {
+		addCondition("(o.created_at, o.order_uuid) < (%s, %s)", filter.Cursor.CreatedAt, filter.Cursor.OrderUUID)
+	}
+
+	args = append(args, filter.Limit)
+	query := `
+		SELECT` + selectOrderColumns + `
+		FROM orders o
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY o.created_at DESC, o.order_uuid DESC
+		LIMIT $` + fmt.Sprint(len(args))
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query orders: %w", err)
+	}
+	defer rows.Close()
+
+	orders := make([]*model.Order, 0, filter.Limit)
+	for rows.Next() {
+		order, err := scanOrder(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan order: %w", err)
+		}
+		orders = append(orders, order)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	if err = r.loadOrderItems(ctx, orders...); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
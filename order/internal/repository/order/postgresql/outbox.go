@@ -0,0 +1,37 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+// insertOutboxEvents сохраняет события в outbox в рамках переданной транзакции.
+func insertOutboxEvents(ctx context.Context, tx pgx.Tx, events []model.OutboxEvent) error {
+	const query = `
+		INSERT INTO outbox(event_uuid,
+		                   event_type,
+		                   event_key,
+		                   payload,
+		                   created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	for _, event := range events {
+		_, err := tx.Exec(ctx, query,
+			event.EventUUID,
+			event.EventType,
+			event.Key,
+			event.Payload,
+			event.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert outbox event %s: %w", event.EventUUID, err)
+		}
+	}
+
+	return nil
+}
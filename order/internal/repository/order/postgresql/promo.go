@@ -0,0 +1,33 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+// redeemPromoCode засчитывает использование промокода в рамках транзакции создания заказа.
+// Условие проверяется в самом UPDATE, поэтому параллельные заказы не превысят лимит.
+func redeemPromoCode(ctx context.Context, tx pgx.Tx, code string) error {
+	const query = `
+		UPDATE promo_codes
+		SET used_count = used_count + 1
+		WHERE code = $1
+		  AND active
+		  AND (expires_at IS NULL OR expires_at > NOW())
+		  AND (max_uses IS NULL OR used_count < max_uses)
+	`
+
+	cmdTag, err := tx.Exec(ctx, query, code)
+	if err != nil {
+		return fmt.Errorf("failed to redeem promo code %s: %w", code, err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return model.NewConflictError(fmt.Sprintf("promo code %q is no longer available", code))
+	}
+
+	return nil
+}
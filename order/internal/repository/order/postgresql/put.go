@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
+
 	"github.com/ZanDattSu/star-factory/order/internal/model"
 )
 
-func (r *repository) PutOrder(ctx context.Context, _ string, order *model.Order) error {
+func (r *repository) PutOrder(ctx context.Context, _ string, order *model.Order, events ...model.OutboxEvent) error {
 	paymentMethodID, err := order.PaymentMethod.ID()
 	if err != nil {
 		return fmt.Errorf("invalid payment method: %w", err)
@@ -22,25 +24,61 @@ func (r *repository) PutOrder(ctx context.Context, _ string, order *model.Order)
 		INSERT INTO orders(order_uuid,
 		                   user_uuid,
 		                   part_uuids,
-		                   total_price,
+		                   subtotal_minor,
+		                   discount_minor,
+		                   total_price_minor,
+		                   currency,
+		                   promo_code,
 		                   transaction_uuid,
+		                   reservation_uuid,
 		                   payment_method_id,
-		                   status_id)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		                   status_id,
+		                   version,
+		                   created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 	`
 
-	_, err = r.pool.Exec(ctx, query,
-		order.OrderUUID,
-		order.UserUUID,
-		order.PartUuids,
-		order.TotalPrice,
-		order.TransactionUUID,
-		paymentMethodID,
-		statusID,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to insert order %s: %w", order.OrderUUID, err)
-	}
+	return r.withTx(ctx, func(tx pgx.Tx) error {
+		if order.PromoCode != nil {
+			if err := redeemPromoCode(ctx, tx, *order.PromoCode); err != nil {
+				return err
+			}
+		}
+
+		if order.QuoteToken != nil {
+			if err := useQuote(ctx, tx, *order.QuoteToken, order.OrderUUID); err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.Exec(ctx, query,
+			order.OrderUUID,
+			order.UserUUID,
+			order.PartUuids,
+			order.Subtotal.Amount,
+			order.Discount.Amount,
+			order.TotalPrice.Amount,
+			order.TotalPrice.Currency,
+			order.PromoCode,
+			order.TransactionUUID,
+			order.ReservationUUID,
+			paymentMethodID,
+			statusID,
+			order.Version,
+			order.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert order %s: %w", order.OrderUUID, err)
+		}
+
+		if err = insertOrderItems(ctx, tx, order.OrderUUID, order.Items); err != nil {
+			return err
+		}
+
+		if err = insertStatusHistory(ctx, tx, order.CreatedStatusChange()); err != nil {
+			return err
+		}
 
-	return nil
+		return insertOutboxEvents(ctx, tx, events)
+	})
 }
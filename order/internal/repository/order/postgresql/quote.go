@@ -0,0 +1,31 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+// useQuote отмечает расчёт использованным в рамках транзакции создания заказа.
+// Условие проверяется в самом UPDATE, поэтому по одному токену не оформить два заказа.
+func useQuote(ctx context.Context, tx pgx.Tx, token, orderUUID string) error {
+	const query = `
+		UPDATE order_quotes
+		SET order_uuid = $2
+		WHERE token = $1
+		  AND order_uuid IS NULL
+	`
+
+	cmdTag, err := tx.Exec(ctx, query, token, orderUUID)
+	if err != nil {
+		return fmt.Errorf("failed to use quote %s: %w", token, err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return model.NewConflictError(fmt.Sprintf("quote %s has already been used, request a new one", token))
+	}
+
+	return nil
+}
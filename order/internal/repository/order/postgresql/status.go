@@ -0,0 +1,162 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (r *repository) UpdateOrderStatus(ctx context.Context, order *model.Order, change model.OrderStatusChange, events ...model.OutboxEvent) error {
+	err := r.withTx(ctx, func(tx pgx.Tx) error {
+		return updateOrderStatus(ctx, tx, order, change, events)
+	})
+	if err != nil {
+		return err
+	}
+
+	order.Version++
+
+	return nil
+}
+
+// updateOrderStatus сохраняет переход статуса, запись истории и события outbox в рамках переданной транзакции.
+// К событиям всегда добавляется OrderStatusChanged, чтобы ни один переход не остался без публикации.
+// Версию в order не меняет: транзакция ещё может откатиться, увеличивает её вызывающий после коммита.
+func updateOrderStatus(ctx context.Context, tx pgx.Tx, order *model.Order, change model.OrderStatusChange, events []model.OutboxEvent) error {
+	paymentMethodID, err := order.PaymentMethod.ID()
+	if err != nil {
+		return fmt.Errorf("invalid payment method: %w", err)
+	}
+
+	toStatusID, err := change.ToStatus.ID()
+	if err != nil {
+		return fmt.Errorf("invalid order status: %w", err)
+	}
+
+	// Условие на версию защищает от гонки двух одновременных изменений заказа:
+	// статус меняется только вместе с версией, поэтому отдельно проверять его не нужно
+	const updateQuery = `
+		UPDATE orders
+		SET transaction_uuid = ($3),
+		    payment_method_id = ($4),
+		    status_id = ($5),
+		    version = version + 1
+		WHERE order_uuid = ($1) AND version = ($2)
+	`
+
+	cmdTag, err := tx.Exec(ctx, updateQuery,
+		order.OrderUUID,
+		order.Version,
+		order.TransactionUUID,
+		paymentMethodID,
+		toStatusID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update order %s status: %w", order.OrderUUID, err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return versionMismatch(ctx, tx, order)
+	}
+
+	if err = insertStatusHistory(ctx, tx, change); err != nil {
+		return err
+	}
+
+	statusChanged, err := model.NewOrderStatusChangedOutboxEvent(model.NewOrderStatusChangedEvent(order, change))
+	if err != nil {
+		return err
+	}
+
+	return insertOutboxEvents(ctx, tx, append(events, statusChanged))
+}
+
+// insertStatusHistory добавляет запись в историю статусов заказа в рамках переданной транзакции.
+func insertStatusHistory(ctx context.Context, tx pgx.Tx, change model.OrderStatusChange) error {
+	fromStatusID, err := change.FromStatus.ID()
+	if err != nil {
+		return fmt.Errorf("invalid order status: %w", err)
+	}
+
+	toStatusID, err := change.ToStatus.ID()
+	if err != nil {
+		return fmt.Errorf("invalid order status: %w", err)
+	}
+
+	const query = `
+		INSERT INTO order_status_history(order_uuid,
+		                                 from_status_id,
+		                                 to_status_id,
+		                                 actor,
+		                                 reason,
+		                                 changed_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err = tx.Exec(ctx, query,
+		change.OrderUUID,
+		fromStatusID,
+		toStatusID,
+		change.Actor,
+		change.Reason,
+		change.ChangedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert status history for order %s: %w", change.OrderUUID, err)
+	}
+
+	return nil
+}
+
+func (r *repository) GetOrderStatusHistory(ctx context.Context, orderUUID string) ([]*model.OrderStatusChange, error) {
+	const query = `
+		SELECT h.order_uuid,
+		       h.from_status_id,
+		       h.to_status_id,
+		       h.actor,
+		       h.reason,
+		       h.changed_at
+		FROM order_status_history h
+		WHERE h.order_uuid = $1
+		ORDER BY h.changed_at, h.id
+	`
+
+	rows, err := r.pool.Query(ctx, query, orderUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query status history: %w", err)
+	}
+	defer rows.Close()
+
+	history := make([]*model.OrderStatusChange, 0)
+	for rows.Next() {
+		var (
+			change       model.OrderStatusChange
+			fromStatusID int
+			toStatusID   int
+		)
+		err = rows.Scan(
+			&change.OrderUUID,
+			&fromStatusID,
+			&toStatusID,
+			&change.Actor,
+			&change.Reason,
+			&change.ChangedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan status history: %w", err)
+		}
+
+		change.FromStatus, _ = model.OrderStatusFromID(fromStatusID) //nolint:gosec
+		change.ToStatus, _ = model.OrderStatusFromID(toStatusID)     //nolint:gosec
+
+		history = append(history, &change)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return history, nil
+}
@@ -0,0 +1,39 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+// withTx выполняет action в транзакции: коммитит при успехе и откатывает при ошибке.
+func (r *repository) withTx(ctx context.Context, action func(tx pgx.Tx) error) error {
+	committed := false
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(ctx); err != nil {
+				logger.Error(ctx, "Failed to rollback transaction", zap.Error(err))
+			}
+		}
+	}()
+
+	if err = action(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	committed = true
+	return nil
+}
@@ -2,48 +2,73 @@ package postgresql
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
+
 	"github.com/ZanDattSu/star-factory/order/internal/model"
 )
 
 func (r *repository) UpdateOrder(ctx context.Context, _ string, order *model.Order) error {
-	paymentMethodID, err := order.PaymentMethod.ID()
-	if err != nil {
-		return fmt.Errorf("invalid payment method: %w", err)
-	}
+	// Условие на версию отсекает правку, сделанную по устаревшему состоянию заказа
+	const query = `
+		UPDATE orders
+		SET part_uuids = ($3),
+		    subtotal_minor = ($4),
+		    discount_minor = ($5),
+		    total_price_minor = ($6),
+		    currency = ($7),
+		    reservation_uuid = ($8),
+		    version = version + 1
+		WHERE order_uuid = ($1) AND version = ($2)
+	`
+
+	err := r.withTx(ctx, func(tx pgx.Tx) error {
+		cmdTag, err := tx.Exec(ctx, query,
+			order.OrderUUID,
+			order.Version,
+			order.PartUuids,
+			order.Subtotal.Amount,
+			order.Discount.Amount,
+			order.TotalPrice.Amount,
+			order.TotalPrice.Currency,
+			order.ReservationUUID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update order %s: %w", order.OrderUUID, err)
+		}
+		if cmdTag.RowsAffected() == 0 {
+			return versionMismatch(ctx, tx, order)
+		}
 
-	statusID, err := order.Status.ID()
+		_, err = tx.Exec(ctx, `DELETE FROM order_items WHERE order_uuid = $1`, order.OrderUUID)
+		if err != nil {
+			return fmt.Errorf("failed to delete items of order %s: %w", order.OrderUUID, err)
+		}
+
+		return insertOrderItems(ctx, tx, order.OrderUUID, order.Items)
+	})
 	if err != nil {
-		return fmt.Errorf("invalid order status: %w", err)
+		return err
 	}
 
-	const query = `
-		UPDATE orders o
-		SET user_uuid = ($2),
-		    part_uuids = ($3),
-		    total_price = ($4),
-		    transaction_uuid = ($5),
-		    payment_method_id = ($6),
-		    status_id = ($7)
-		WHERE order_uuid = ($1)
-	`
+	order.Version++
+
+	return nil
+}
 
-	cmdTag, err := r.pool.Exec(ctx, query,
-		order.OrderUUID,
-		order.UserUUID,
-		order.PartUuids,
-		order.TotalPrice,
-		order.TransactionUUID,
-		paymentMethodID,
-		statusID,
-	)
+// versionMismatch объясняет, почему UPDATE с условием на версию не затронул ни одной строки.
+func versionMismatch(ctx context.Context, tx pgx.Tx, order *model.Order) error {
+	var current int64
+
+	err := tx.QueryRow(ctx, `SELECT version FROM orders WHERE order_uuid = $1`, order.OrderUUID).Scan(&current)
 	if err != nil {
-		return fmt.Errorf("failed to update order %s: %w", order.OrderUUID, err)
-	}
-	if cmdTag.RowsAffected() == 0 {
-		return fmt.Errorf("order %s not found", order.OrderUUID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.NewOrderNotFoundError(order.OrderUUID)
+		}
+		return fmt.Errorf("failed to get version of order %s: %w", order.OrderUUID, err)
 	}
 
-	return nil
+	return model.NewOrderVersionConflictError(order.OrderUUID, order.Version, current)
 }
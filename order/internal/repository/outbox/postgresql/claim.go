@@ -0,0 +1,74 @@
+package postgresql
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (r *repository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*model.OutboxEvent, error) {
+	// SKIP LOCKED позволяет нескольким экземплярам relay разбирать outbox без блокировок друг друга.
+	// Берётся только самое раннее неотправленное событие каждого ключа (заказа): пока оно не опубликовано,
+	// следующие события заказа ждут, и потребители не увидят OrderPaid раньше OrderCreated
+	const query = `
+		UPDATE outbox
+		SET attempts = attempts + 1,
+		    next_attempt_at = NOW() + make_interval(secs => $2)
+		WHERE id IN (
+			SELECT o.id
+			FROM outbox o
+			WHERE o.sent_at IS NULL
+			  AND o.next_attempt_at <= NOW()
+			  AND NOT EXISTS (
+				SELECT 1
+				FROM outbox prev
+				WHERE prev.event_key = o.event_key
+				  AND prev.sent_at IS NULL
+				  AND prev.id < o.id
+			  )
+			ORDER BY o.id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, event_uuid, event_type, event_key, payload, attempts, created_at
+	`
+
+	rows, err := r.pool.Query(ctx, query, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]*model.OutboxEvent, 0, limit)
+	for rows.Next() {
+		var event model.OutboxEvent
+		err = rows.Scan(
+			&event.ID,
+			&event.EventUUID,
+			&event.EventType,
+			&event.Key,
+			&event.Payload,
+			&event.Attempts,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+		events = append(events, &event)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	// RETURNING не гарантирует порядок, а публиковать события нужно в порядке записи
+	slices.SortFunc(events, func(a, b *model.OutboxEvent) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return events, nil
+}
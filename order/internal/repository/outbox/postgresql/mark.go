@@ -0,0 +1,39 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func (r *repository) MarkSent(ctx context.Context, id int64) error {
+	const query = `
+		UPDATE outbox
+		SET sent_at = NOW(),
+		    last_error = NULL
+		WHERE id = $1
+	`
+
+	_, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox event %d as sent: %w", id, err)
+	}
+
+	return nil
+}
+
+func (r *repository) MarkFailed(ctx context.Context, id int64, reason string, retryAfter time.Duration) error {
+	const query = `
+		UPDATE outbox
+		SET last_error = $2,
+		    next_attempt_at = NOW() + make_interval(secs => $3)
+		WHERE id = $1
+	`
+
+	_, err := r.pool.Exec(ctx, query, id, reason, retryAfter.Seconds())
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox event %d as failed: %w", id, err)
+	}
+
+	return nil
+}
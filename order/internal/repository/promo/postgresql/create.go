@@ -0,0 +1,56 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+func (r *repository) CreatePromoCode(ctx context.Context, promo *model.PromoCode) error {
+	const query = `
+		INSERT INTO promo_codes(code,
+		                        discount_type,
+		                        percent_off,
+		                        amount_off_minor,
+		                        currency,
+		                        categories,
+		                        max_uses,
+		                        used_count,
+		                        expires_at,
+		                        active,
+		                        created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (code) DO NOTHING
+	`
+
+	currency := promo.AmountOff.Currency
+	if currency == "" {
+		currency = money.DefaultCurrency
+	}
+
+	cmdTag, err := r.pool.Exec(ctx, query,
+		promo.Code,
+		string(promo.DiscountType),
+		promo.PercentOff,
+		promo.AmountOff.Amount,
+		currency,
+		lo.Map(promo.Categories, func(c model.Category, _ int) string { return string(c) }),
+		promo.MaxUses,
+		promo.UsedCount,
+		promo.ExpiresAt,
+		promo.Active,
+		promo.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert promo code %s: %w", promo.Code, err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return model.NewConflictError(fmt.Sprintf("promo code %q already exists", promo.Code))
+	}
+
+	return nil
+}
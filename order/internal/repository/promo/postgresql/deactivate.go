@@ -0,0 +1,28 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (r *repository) DeactivatePromoCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	query := `
+		UPDATE promo_codes p
+		SET active = FALSE
+		WHERE p.code = $1
+		RETURNING` + selectPromoCodeColumns
+
+	promo, err := scanPromoCode(r.pool.QueryRow(ctx, query, code))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.NewPromoCodeNotFoundError(code)
+		}
+		return nil, err
+	}
+
+	return promo, nil
+}
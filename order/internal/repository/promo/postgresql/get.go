@@ -0,0 +1,73 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/samber/lo"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+const selectPromoCodeColumns = `
+			p.code,
+			p.discount_type,
+			p.percent_off,
+			p.amount_off_minor,
+			p.currency,
+			p.categories,
+			p.max_uses,
+			p.used_count,
+			p.expires_at,
+			p.active,
+			p.created_at
+`
+
+func (r *repository) GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	query := `
+		SELECT` + selectPromoCodeColumns + `
+		FROM promo_codes p
+		WHERE p.code = $1
+	`
+
+	promo, err := scanPromoCode(r.pool.QueryRow(ctx, query, code))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.NewPromoCodeNotFoundError(code)
+		}
+		return nil, err
+	}
+
+	return promo, nil
+}
+
+// scanPromoCode читает строку, выбранную с колонками selectPromoCodeColumns.
+func scanPromoCode(row pgx.Row) (*model.PromoCode, error) {
+	var (
+		promo        model.PromoCode
+		discountType string
+		categories   []string
+	)
+	err := row.Scan(
+		&promo.Code,
+		&discountType,
+		&promo.PercentOff,
+		&promo.AmountOff.Amount,
+		&promo.AmountOff.Currency,
+		&categories,
+		&promo.MaxUses,
+		&promo.UsedCount,
+		&promo.ExpiresAt,
+		&promo.Active,
+		&promo.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	promo.DiscountType = model.DiscountType(discountType)
+	promo.Categories = lo.Map(categories, func(c string, _ int) model.Category { return model.Category(c) })
+
+	return &promo, nil
+}
@@ -0,0 +1,37 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (r *repository) ListPromoCodes(ctx context.Context) ([]*model.PromoCode, error) {
+	query := `
+		SELECT` + selectPromoCodeColumns + `
+		FROM promo_codes p
+		ORDER BY p.created_at DESC, p.code
+	`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query promo codes: %w", err)
+	}
+	defer rows.Close()
+
+	var promos []*model.PromoCode
+	for rows.Next() {
+		promo, err := scanPromoCode(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan promo code: %w", err)
+		}
+		promos = append(promos, promo)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows error: %w", rows.Err())
+	}
+
+	return promos, nil
+}
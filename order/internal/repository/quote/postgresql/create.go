@@ -0,0 +1,49 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/order/internal/repository/converter"
+)
+
+func (r *repository) CreateQuote(ctx context.Context, quote *model.Quote) error {
+	items, err := json.Marshal(converter.OrderItemsToRepoModel(quote.Items))
+	if err != nil {
+		return fmt.Errorf("failed to marshal quote items: %w", err)
+	}
+
+	const query = `
+		INSERT INTO order_quotes(token,
+		                         user_uuid,
+		                         items,
+		                         subtotal_minor,
+		                         discount_minor,
+		                         total_minor,
+		                         currency,
+		                         promo_code,
+		                         expires_at,
+		                         created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err = r.pool.Exec(ctx, query,
+		quote.Token,
+		quote.UserUUID,
+		items,
+		quote.Subtotal.Amount,
+		quote.Discount.Amount,
+		quote.Total.Amount,
+		quote.Total.Currency,
+		quote.PromoCode,
+		quote.ExpiresAt,
+		quote.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert quote %s: %w", quote.Token, err)
+	}
+
+	return nil
+}
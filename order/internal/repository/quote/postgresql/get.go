@@ -0,0 +1,67 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/order/internal/repository/converter"
+	repoModel "github.com/ZanDattSu/star-factory/order/internal/repository/model"
+)
+
+func (r *repository) GetQuote(ctx context.Context, token string) (*model.Quote, error) {
+	const query = `
+		SELECT q.token,
+		       q.user_uuid,
+		       q.items,
+		       q.subtotal_minor,
+		       q.discount_minor,
+		       q.total_minor,
+		       q.currency,
+		       q.promo_code,
+		       q.order_uuid,
+		       q.expires_at,
+		       q.created_at
+		FROM order_quotes q
+		WHERE q.token = $1
+	`
+
+	var (
+		quote model.Quote
+		items []byte
+	)
+	err := r.pool.QueryRow(ctx, query, token).Scan(
+		&quote.Token,
+		&quote.UserUUID,
+		&items,
+		&quote.Subtotal.Amount,
+		&quote.Discount.Amount,
+		&quote.Total.Amount,
+		&quote.Total.Currency,
+		&quote.PromoCode,
+		&quote.OrderUUID,
+		&quote.ExpiresAt,
+		&quote.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.NewQuoteNotFoundError(token)
+		}
+		return nil, fmt.Errorf("failed to get quote %s: %w", token, err)
+	}
+
+	var repoItems []repoModel.OrderItem
+	if err = json.Unmarshal(items, &repoItems); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal items of quote %s: %w", token, err)
+	}
+
+	quote.Items = converter.OrderItemsToModel(repoItems)
+	quote.Subtotal.Currency = quote.Total.Currency
+	quote.Discount.Currency = quote.Total.Currency
+
+	return &quote, nil
+}
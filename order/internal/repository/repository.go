@@ -2,12 +2,81 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/ZanDattSu/star-factory/order/internal/model"
 )
 
 type OrderRepository interface {
 	GetOrder(ctx context.Context, uuid string) (*model.Order, error)
-	PutOrder(ctx context.Context, uuid string, order *model.Order) error
+	// PutOrder сохраняет новый заказ, первую запись истории статусов и события outbox в одной транзакции.
+	// Если у заказа есть промокод, в той же транзакции засчитывается его использование;
+	// если код уже нельзя применить (лимит, срок, отключён), возвращает ConflictError.
+	// Если заказ оформлен по расчёту, расчёт там же отмечается использованным; если по нему уже
+	// оформили заказ, возвращает ConflictError.
+	PutOrder(ctx context.Context, uuid string, order *model.Order, events ...model.OutboxEvent) error
+	// UpdateOrder сохраняет позиции, стоимость и резерв заказа и увеличивает его версию.
+	// Если версия в хранилище отличается от order.Version, возвращает OrderVersionConflictError.
+	// Статус и оплата здесь не меняются, для них есть UpdateOrderStatus.
 	UpdateOrder(ctx context.Context, uuid string, order *model.Order) error
+	ListOrders(ctx context.Context, filter model.OrdersFilter) ([]*model.Order, error)
+	// UpdateOrderStatus атомарно сохраняет заказ, запись истории статусов и события outbox.
+	// Событие OrderStatusChanged репозиторий добавляет сам. Версия заказа увеличивается,
+	// а если в хранилище она уже отличается от order.Version, возвращается OrderVersionConflictError.
+	UpdateOrderStatus(ctx context.Context, order *model.Order, change model.OrderStatusChange, events ...model.OutboxEvent) error
+	GetOrderStatusHistory(ctx context.Context, orderUUID string) ([]*model.OrderStatusChange, error)
+	// ExpireOrders блокирует до limit заказов в статусе PENDING_PAYMENT, созданных раньше createdBefore,
+	// и в той же транзакции сохраняет переход, который вернул expire. Заказы, заблокированные
+	// другим экземпляром сервиса, пропускаются. Возвращает заказы с применённым переходом.
+	ExpireOrders(ctx context.Context, createdBefore time.Time, limit int, expire model.ExpireOrderFunc) ([]*model.Order, error)
+}
+
+type OutboxRepository interface {
+	// ClaimPending выбирает до limit готовых к отправке событий и откладывает их следующую попытку на lease,
+	// чтобы параллельный relay не взял те же записи. Для каждого ключа возвращается только самое раннее
+	// неотправленное событие, поэтому события одного заказа публикуются строго по порядку.
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*model.OutboxEvent, error)
+	MarkSent(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, reason string, retryAfter time.Duration) error
+}
+
+type PromoCodeRepository interface {
+	// CreatePromoCode сохраняет новый код. Если код уже существует, возвращает ConflictError.
+	CreatePromoCode(ctx context.Context, promo *model.PromoCode) error
+	GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error)
+	ListPromoCodes(ctx context.Context) ([]*model.PromoCode, error)
+	// DeactivatePromoCode отключает код и возвращает его. Оформленные заказы не меняются.
+	DeactivatePromoCode(ctx context.Context, code string) (*model.PromoCode, error)
+}
+
+type QuoteRepository interface {
+	CreateQuote(ctx context.Context, quote *model.Quote) error
+	// GetQuote возвращает расчёт по токену, в том числе истёкший и использованный;
+	// если его нет, возвращает QuoteNotFoundError.
+	GetQuote(ctx context.Context, token string) (*model.Quote, error)
+}
+
+// CartRepository хранит корзины пользователей. Каждое изменение продлевает срок жизни корзины.
+type CartRepository interface {
+	// AddItem увеличивает количество детали в корзине на quantity и возвращает новое количество.
+	AddItem(ctx context.Context, userUUID, partUUID string, quantity int64) (int64, error)
+	// RemoveItem убирает деталь из корзины. Отсутствие детали ошибкой не считается.
+	RemoveItem(ctx context.Context, userUUID, partUUID string) error
+	// GetCart возвращает корзину; у несуществующей или истёкшей корзины позиций нет.
+	GetCart(ctx context.Context, userUUID string) (*model.Cart, error)
+	// RemoveItems атомарно уменьшает количество деталей корзины на количество из items
+	// и убирает детали, которых не осталось. Добавленное после чтения корзины остаётся в ней.
+	RemoveItems(ctx context.Context, userUUID string, items []model.OrderItem) error
+}
+
+type IdempotencyRepository interface {
+	// Reserve сохраняет record, если ключ ещё не использовался или его запись истекла, и возвращает reserved = true.
+	// Иначе возвращает уже сохранённую запись и reserved = false.
+	Reserve(ctx context.Context, record model.IdempotencyRecord) (existing *model.IdempotencyRecord, reserved bool, err error)
+	// Complete сохраняет ответ записи, зарезервированной как record, и продлевает её хранение.
+	Complete(ctx context.Context, record model.IdempotencyRecord, response []byte) error
+	// Release удаляет незавершённую запись, чтобы клиент мог повторить запрос с тем же ключом.
+	Release(ctx context.Context, record model.IdempotencyRecord) error
+	// PurgeExpired удаляет не более limit записей, истёкших к now, и возвращает их число.
+	PurgeExpired(ctx context.Context, now time.Time, limit int) (int, error)
 }
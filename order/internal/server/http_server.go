@@ -23,7 +23,15 @@ type HTTPServer struct {
 	server *http.Server
 }
 
-func NewHTTPServer(address string, api orderV1.Handler, authClient authV1.AuthServiceClient) (*HTTPServer, error) {
+// orderEventsPath поток событий заказа; обслуживается отдельно от OpenAPI, без таймаута ответа.
+const orderEventsPath = "/api/v1/orders/{order_uuid}/events"
+
+func NewHTTPServer(
+	address string,
+	api orderV1.Handler,
+	orderEvents http.HandlerFunc,
+	authClient authV1.AuthServiceClient,
+) (*HTTPServer, error) {
 	openAPIHandler, err := orderV1.NewServer(api)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create OpenAPI server: %w", err)
@@ -33,12 +41,18 @@ func NewHTTPServer(address string, api orderV1.Handler, authClient authV1.AuthSe
 
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
-	r.Use(middleware.Timeout(responseTimeout))
 
 	r.Group(func(r chi.Router) {
 		auth := httpmiddleware.NewAuthMiddleware(authClient)
 		r.Use(auth.Handle)
-		r.Mount("/", openAPIHandler)
+
+		// SSE-соединение живёт долго, поэтому таймаут ответа на него не распространяется
+		r.Get(orderEventsPath, orderEvents)
+
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.Timeout(responseTimeout))
+			r.Mount("/", openAPIHandler)
+		})
 	})
 
 	server := &http.Server{
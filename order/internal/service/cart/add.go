@@ -0,0 +1,50 @@
+package cart
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	inventoryV1 "github.com/ZanDattSu/star-factory/order/internal/client/grpc/inventory/v1"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+func (s *service) AddCartItem(ctx context.Context, userUUID, partUUID string, quantity int64) (*model.Cart, error) {
+	if quantity <= 0 {
+		return nil, model.NewBadRequestError(fmt.Sprintf("quantity of part %s must be positive", partUUID))
+	}
+
+	// Цены и остатки проверяются при оформлении, здесь только отсекаем несуществующие детали
+	parts, err := s.inventoryClient.ListParts(ctx, model.PartsFilter{Uuids: []string{partUUID}})
+	if err == nil && len(parts) == 0 {
+		err = inventoryV1.NewPartsNotFoundError([]string{partUUID})
+	}
+	if err != nil {
+		logger.Warn(ctx, "Failed to add part to cart: part lookup failed",
+			zap.String("user_uuid", userUUID),
+			zap.String("part_uuid", partUUID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	total, err := s.repository.AddItem(ctx, userUUID, partUUID, quantity)
+	if err != nil {
+		logger.Error(ctx, "Failed to add part to cart",
+			zap.String("user_uuid", userUUID),
+			zap.String("part_uuid", partUUID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Part added to cart",
+		zap.String("user_uuid", userUUID),
+		zap.String("part_uuid", partUUID),
+		zap.Int64("quantity", total),
+	)
+
+	return s.repository.GetCart(ctx, userUUID)
+}
@@ -0,0 +1,55 @@
+package cart
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	inventoryV1 "github.com/ZanDattSu/star-factory/order/internal/client/grpc/inventory/v1"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (s *SuiteService) TestAddCartItemSuccess() {
+	userUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+	cart := &model.Cart{
+		UserUUID: userUUID,
+		Items:    []model.OrderItem{{PartUUID: partUUID, Quantity: 3}},
+	}
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, model.PartsFilter{Uuids: []string{partUUID}}).
+		Return([]*model.Part{{Uuid: partUUID}}, nil).
+		Once()
+	s.cartRepository.On("AddItem", s.ctx, userUUID, partUUID, int64(2)).Return(int64(3), nil).Once()
+	s.cartRepository.On("GetCart", s.ctx, userUUID).Return(cart, nil).Once()
+
+	res, err := s.service.AddCartItem(s.ctx, userUUID, partUUID, 2)
+
+	s.Require().NoError(err)
+	s.Require().Equal(cart, res)
+}
+
+func (s *SuiteService) TestAddCartItemNonPositiveQuantity() {
+	_, err := s.service.AddCartItem(s.ctx, gofakeit.UUID(), gofakeit.UUID(), 0)
+
+	badRequest := &model.BadRequestError{}
+	s.Require().ErrorAs(err, &badRequest)
+
+	s.cartRepository.AssertNotCalled(s.T(), "AddItem", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestAddCartItemUnknownPart() {
+	partUUID := gofakeit.UUID()
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, model.PartsFilter{Uuids: []string{partUUID}}).
+		Return([]*model.Part{}, nil).
+		Once()
+
+	_, err := s.service.AddCartItem(s.ctx, gofakeit.UUID(), partUUID, 1)
+
+	notFound := &inventoryV1.PartsNotFoundError{}
+	s.Require().ErrorAs(err, &notFound)
+
+	s.cartRepository.AssertNotCalled(s.T(), "AddItem", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
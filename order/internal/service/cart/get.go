@@ -0,0 +1,11 @@
+package cart
+
+import (
+	"context"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (s *service) GetCart(ctx context.Context, userUUID string) (*model.Cart, error) {
+	return s.repository.GetCart(ctx, userUUID)
+}
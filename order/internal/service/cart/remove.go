@@ -0,0 +1,23 @@
+package cart
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+func (s *service) RemoveCartItem(ctx context.Context, userUUID, partUUID string) (*model.Cart, error) {
+	if err := s.repository.RemoveItem(ctx, userUUID, partUUID); err != nil {
+		logger.Error(ctx, "Failed to remove part from cart",
+			zap.String("user_uuid", userUUID),
+			zap.String("part_uuid", partUUID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return s.repository.GetCart(ctx, userUUID)
+}
@@ -0,0 +1,22 @@
+package cart
+
+import (
+	gRPCClient "github.com/ZanDattSu/star-factory/order/internal/client/grpc"
+	"github.com/ZanDattSu/star-factory/order/internal/repository"
+	srvc "github.com/ZanDattSu/star-factory/order/internal/service"
+)
+
+// Компиляторная проверка: убеждаемся, что *service реализует интерфейс CartService.
+var _ srvc.CartService = (*service)(nil)
+
+type service struct {
+	repository      repository.CartRepository
+	inventoryClient gRPCClient.InventoryClient
+}
+
+func NewService(repository repository.CartRepository, inventoryClient gRPCClient.InventoryClient) *service {
+	return &service{
+		repository:      repository,
+		inventoryClient: inventoryClient,
+	}
+}
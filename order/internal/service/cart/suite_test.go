@@ -0,0 +1,37 @@
+package cart
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	clientMocks "github.com/ZanDattSu/star-factory/order/internal/client/grpc/mocks"
+	"github.com/ZanDattSu/star-factory/order/internal/repository/mocks"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+type SuiteService struct {
+	suite.Suite
+
+	ctx context.Context //nolint:containedctx
+
+	cartRepository  *mocks.CartRepository
+	inventoryClient *clientMocks.InventoryClient
+
+	service *service
+}
+
+func (s *SuiteService) SetupTest() {
+	s.ctx = context.Background()
+
+	s.cartRepository = mocks.NewCartRepository(s.T())
+	s.inventoryClient = clientMocks.NewInventoryClient(s.T())
+
+	s.service = NewService(s.cartRepository, s.inventoryClient)
+	logger.SetNopLogger()
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(SuiteService))
+}
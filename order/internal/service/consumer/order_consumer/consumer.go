@@ -17,6 +17,7 @@ type service struct {
 	shipAssembledDecoder  kafkaConverter.ShipAssembledDecoder
 	orderService          serv.OrderService
 	orderRepository       repository.OrderRepository
+	statusNotifier        serv.OrderStatusNotifier
 }
 
 func NewService(
@@ -24,12 +25,14 @@ func NewService(
 	shipAssembledDecoder kafkaConverter.ShipAssembledDecoder,
 	orderService serv.OrderService,
 	orderRepository repository.OrderRepository,
+	statusNotifier serv.OrderStatusNotifier,
 ) *service {
 	return &service{
 		shipAssembledConsumer: shipAssembledConsumer,
 		shipAssembledDecoder:  shipAssembledDecoder,
 		orderService:          orderService,
 		orderRepository:       orderRepository,
+		statusNotifier:        statusNotifier,
 	}
 }
 
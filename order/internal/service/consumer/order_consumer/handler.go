@@ -3,6 +3,7 @@ package order_consumer
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"go.uber.org/zap"
 
@@ -49,24 +50,34 @@ func (s *service) handleShipAssembled(ctx context.Context, msg consumer.Message)
 		zap.Int("build_time_sec", int(event.BuildTime.Seconds())),
 	)
 
-	order, err := s.orderRepository.GetOrder(ctx, event.OrderUuid)
-	if err != nil {
-		logger.Error(ctx, "Failed to get order",
+	// Заказ могут параллельно отменить или вернуть: при конфликте версий перечитываем его и пробуем снова
+	var skipped bool
+	err = model.RetryOnVersionConflict(model.MaxVersionConflictRetries, func() error {
+		order, err := s.orderRepository.GetOrder(ctx, event.OrderUuid)
+		if err != nil {
+			return fmt.Errorf("failed to get order: %w", err)
+		}
+
+		logger.Debug(ctx, "Order found, updating status to ASSEMBLED",
 			zap.String("order_uuid", event.OrderUuid),
-			zap.String("event_uuid", event.EventUuid),
-			zap.Error(err),
+			zap.String("current_status", string(order.Status)),
 		)
-		return err
-	}
 
-	logger.Debug(ctx, "Order found, updating status to ASSEMBLED",
-		zap.String("order_uuid", event.OrderUuid),
-		zap.String("current_status", string(order.Status)),
-	)
-
-	order.Status = model.OrderStatusASSEMBLED
+		change, err := order.TransitionTo(model.OrderStatusASSEMBLED, model.ActorAssembly, "ship assembled")
+		if err != nil {
+			// Повторная доставка события или заказ в неподходящем статусе: пропускаем сообщение
+			logger.Warn(ctx, "Skipping ShipAssembled event: transition not allowed",
+				zap.String("order_uuid", event.OrderUuid),
+				zap.String("event_uuid", event.EventUuid),
+				zap.String("current_status", string(order.Status)),
+				zap.Error(err),
+			)
+			skipped = true
+			return nil
+		}
 
-	err = s.orderRepository.UpdateOrder(ctx, order.OrderUUID, order)
+		return s.orderRepository.UpdateOrderStatus(ctx, order, change)
+	})
 	if err != nil {
 		logger.Error(ctx, "Failed to update order status to ASSEMBLED",
 			zap.String("order_uuid", event.OrderUuid),
@@ -75,6 +86,11 @@ func (s *service) handleShipAssembled(ctx context.Context, msg consumer.Message)
 		)
 		return err
 	}
+	if skipped {
+		return nil
+	}
+
+	s.statusNotifier.Notify(event.OrderUuid)
 
 	logger.Info(ctx, "Order status updated to ASSEMBLED",
 		zap.String("order_uuid", event.OrderUuid),
@@ -0,0 +1,55 @@
+package idempotency_expiry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+// PurgeExpiredKeys удаляет порцию истёкших ключей идемпотентности.
+func (s *service) PurgeExpiredKeys(ctx context.Context) (int, error) {
+	purged, err := s.idempotencyRepository.PurgeExpired(ctx, time.Now().UTC(), s.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge idempotency keys: %w", err)
+	}
+
+	if purged > 0 {
+		logger.Info(ctx, "Expired idempotency keys purged", zap.Int("count", purged))
+	}
+
+	return purged, nil
+}
+
+func (s *service) RunPurgeSweeper(ctx context.Context) error {
+	logger.Info(ctx, "Starting idempotency key purge sweeper",
+		zap.Duration("interval", s.purgeInterval),
+	)
+
+	ticker := time.NewTicker(s.purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		// Добираем истёкшие ключи порциями, пока выборка заполняется целиком
+		for {
+			purged, err := s.PurgeExpiredKeys(ctx)
+			if err != nil {
+				logger.Error(ctx, "Failed to purge idempotency keys", zap.Error(err))
+				break
+			}
+			if purged < s.batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "Idempotency key purge sweeper stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
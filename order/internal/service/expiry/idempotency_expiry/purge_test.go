@@ -0,0 +1,50 @@
+package idempotency_expiry
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *SuitePurge) TestPurgeExpiredKeys() {
+	before := time.Now().UTC()
+
+	s.idempotencyRepository.
+		On("PurgeExpired", s.ctx, mock.MatchedBy(func(now time.Time) bool {
+			return !now.Before(before)
+		}), 10).
+		Return(3, nil).
+		Once()
+
+	purged, err := s.service.PurgeExpiredKeys(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(3, purged)
+}
+
+func (s *SuitePurge) TestPurgeExpiredKeysRepositoryError() {
+	s.idempotencyRepository.
+		On("PurgeExpired", s.ctx, mock.Anything, 10).
+		Return(0, errors.New("db down")).
+		Once()
+
+	_, err := s.service.PurgeExpiredKeys(s.ctx)
+	s.Require().Error(err)
+}
+
+func (s *SuitePurge) TestRunPurgeSweeperDrainsFullBatches() {
+	ctx, cancel := context.WithCancel(s.ctx)
+
+	s.idempotencyRepository.
+		On("PurgeExpired", ctx, mock.Anything, 10).
+		Return(10, nil).
+		Once()
+	s.idempotencyRepository.
+		On("PurgeExpired", ctx, mock.Anything, 10).
+		Run(func(mock.Arguments) { cancel() }).
+		Return(4, nil).
+		Once()
+
+	s.Require().NoError(s.service.RunPurgeSweeper(ctx))
+}
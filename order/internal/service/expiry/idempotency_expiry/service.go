@@ -0,0 +1,29 @@
+package idempotency_expiry
+
+import (
+	"time"
+
+	"github.com/ZanDattSu/star-factory/order/internal/repository"
+	serv "github.com/ZanDattSu/star-factory/order/internal/service"
+)
+
+var _ serv.IdempotencyExpiryService = (*service)(nil)
+
+type service struct {
+	idempotencyRepository repository.IdempotencyRepository
+
+	purgeInterval time.Duration
+	batchSize     int
+}
+
+func NewService(
+	idempotencyRepository repository.IdempotencyRepository,
+	purgeInterval time.Duration,
+	batchSize int,
+) *service {
+	return &service{
+		idempotencyRepository: idempotencyRepository,
+		purgeInterval:         purgeInterval,
+		batchSize:             batchSize,
+	}
+}
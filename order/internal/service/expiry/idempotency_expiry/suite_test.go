@@ -0,0 +1,39 @@
+package idempotency_expiry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ZanDattSu/star-factory/order/internal/repository/mocks"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+type SuitePurge struct {
+	suite.Suite
+
+	ctx context.Context //nolint:containedctx
+
+	idempotencyRepository *mocks.IdempotencyRepository
+
+	service *service
+}
+
+func (s *SuitePurge) SetupTest() {
+	s.ctx = context.Background()
+
+	s.idempotencyRepository = mocks.NewIdempotencyRepository(s.T())
+
+	s.service = NewService(
+		s.idempotencyRepository,
+		time.Hour,
+		10,
+	)
+	logger.SetNopLogger()
+}
+
+func TestPurgeSuite(t *testing.T) {
+	suite.Run(t, new(SuitePurge))
+}
@@ -0,0 +1,111 @@
+package order_expiry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+// ExpireOrders отменяет заказы, не оплаченные в течение ttl, и освобождает их резервы на складе.
+func (s *service) ExpireOrders(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+
+	expired, err := s.orderRepository.ExpireOrders(ctx, now.Add(-s.ttl), s.batchSize, func(order *model.Order) (model.OrderStatusChange, []model.OutboxEvent, error) {
+		return expireOrder(order, now)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire orders: %w", err)
+	}
+
+	for _, order := range expired {
+		s.statusNotifier.Notify(order.OrderUUID)
+		s.releaseReservation(ctx, order)
+
+		logger.Info(ctx, "Order expired",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.String("user_uuid", order.UserUUID),
+			zap.Time("created_at", order.CreatedAt),
+		)
+	}
+
+	return len(expired), nil
+}
+
+// expireOrder отменяет заказ так же, как CancelOrder, и готовит события OrderExpired и OrderCancelled.
+func expireOrder(order *model.Order, now time.Time) (model.OrderStatusChange, []model.OutboxEvent, error) {
+	change, err := order.Cancel(model.ActorOrderExpiry, "payment timeout")
+	if err != nil {
+		return model.OrderStatusChange{}, nil, err
+	}
+
+	event, err := model.NewOrderExpiredOutboxEvent(model.OrderExpiredEvent{
+		EventUuid: uuid.NewString(),
+		OrderUuid: order.OrderUUID,
+		UserUuid:  order.UserUUID,
+		ExpiredAt: now,
+	})
+	if err != nil {
+		return model.OrderStatusChange{}, nil, err
+	}
+
+	cancelledEvent, err := model.NewOrderCancelledOutboxEvent(model.NewOrderCancelledEvent(order, change))
+	if err != nil {
+		return model.OrderStatusChange{}, nil, err
+	}
+
+	return change, []model.OutboxEvent{event, cancelledEvent}, nil
+}
+
+// releaseReservation возвращает зарезервированные под заказ детали на склад.
+// Ошибка не прерывает обработку: резерв всё равно снимется по TTL в inventory.
+func (s *service) releaseReservation(ctx context.Context, order *model.Order) {
+	if order.ReservationUUID == nil {
+		return
+	}
+
+	err := s.inventoryClient.ReleaseReservation(ctx, *order.ReservationUUID)
+	if err != nil {
+		logger.Error(ctx, "Failed to release reservation of expired order",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.String("reservation_uuid", *order.ReservationUUID),
+			zap.Error(err),
+		)
+	}
+}
+
+func (s *service) RunExpirySweeper(ctx context.Context) error {
+	logger.Info(ctx, "Starting order expiry sweeper",
+		zap.Duration("ttl", s.ttl),
+		zap.Duration("interval", s.sweepInterval),
+	)
+
+	ticker := time.NewTicker(s.sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		// Добираем просроченные заказы порциями, пока выборка заполняется целиком
+		for {
+			expired, err := s.ExpireOrders(ctx)
+			if err != nil {
+				logger.Error(ctx, "Failed to expire orders", zap.Error(err))
+				break
+			}
+			if expired < s.batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "Order expiry sweeper stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package order_expiry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func pendingOrder() *model.Order {
+	reservationUUID := gofakeit.UUID()
+	return &model.Order{
+		OrderUUID:       gofakeit.UUID(),
+		UserUUID:        gofakeit.UUID(),
+		ReservationUUID: &reservationUUID,
+		Status:          model.OrderStatusPENDINGPAYMENT,
+		CreatedAt:       time.Now().Add(-time.Hour),
+	}
+}
+
+// applyExpire имитирует репозиторий: применяет expire к заказам и запоминает сохранённые события.
+func applyExpire(orders []*model.Order, events *[]model.OutboxEvent) func(context.Context, time.Time, int, model.ExpireOrderFunc) ([]*model.Order, error) {
+	return func(_ context.Context, _ time.Time, _ int, expire model.ExpireOrderFunc) ([]*model.Order, error) {
+		for _, order := range orders {
+			_, orderEvents, err := expire(order)
+			if err != nil {
+				return nil, err
+			}
+			*events = append(*events, orderEvents...)
+		}
+		return orders, nil
+	}
+}
+
+func (s *SuiteExpiry) TestExpireOrdersCancelsAndReleasesReservation() {
+	order := pendingOrder()
+	var events []model.OutboxEvent
+
+	s.orderRepository.
+		On("ExpireOrders", s.ctx,
+			mock.MatchedBy(func(before time.Time) bool {
+				return time.Since(before) >= 30*time.Minute
+			}),
+			10,
+			mock.Anything,
+		).
+		Return(applyExpire([]*model.Order{order}, &events)).
+		Once()
+
+	s.inventoryClient.
+		On("ReleaseReservation", s.ctx, *order.ReservationUUID).
+		Return(nil).
+		Once()
+
+	expired, err := s.service.ExpireOrders(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Equal(1, expired)
+	s.Require().Equal(model.OrderStatusCANCELLED, order.Status)
+
+	s.Require().Len(events, 2)
+	s.Require().Equal(model.OutboxEventOrderExpired, events[0].EventType)
+	s.Require().Equal(order.OrderUUID, events[0].Key)
+
+	var payload model.OrderExpiredEvent
+	s.Require().NoError(json.Unmarshal(events[0].Payload, &payload))
+	s.Require().Equal(order.UserUUID, payload.UserUuid)
+
+	s.Require().Equal(model.OutboxEventOrderCancelled, events[1].EventType)
+
+	var cancelled model.OrderCancelledEvent
+	s.Require().NoError(json.Unmarshal(events[1].Payload, &cancelled))
+	s.Require().Equal(model.ActorOrderExpiry, cancelled.Actor)
+	s.Require().Equal(model.OrderStatusPENDINGPAYMENT, cancelled.PreviousStatus)
+}
+
+func (s *SuiteExpiry) TestExpireOrdersReleaseFailureDoesNotFail() {
+	order := pendingOrder()
+	var events []model.OutboxEvent
+
+	s.orderRepository.
+		On("ExpireOrders", s.ctx, mock.Anything, 10, mock.Anything).
+		Return(applyExpire([]*model.Order{order}, &events)).
+		Once()
+
+	s.inventoryClient.
+		On("ReleaseReservation", s.ctx, *order.ReservationUUID).
+		Return(errors.New("inventory is down")).
+		Once()
+
+	expired, err := s.service.ExpireOrders(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Equal(1, expired)
+}
+
+func (s *SuiteExpiry) TestExpireOrdersRepositoryError() {
+	s.orderRepository.
+		On("ExpireOrders", s.ctx, mock.Anything, 10, mock.Anything).
+		Return(nil, errors.New("db is down")).
+		Once()
+
+	expired, err := s.service.ExpireOrders(s.ctx)
+
+	s.Require().Error(err)
+	s.Require().Zero(expired)
+	s.inventoryClient.AssertNotCalled(s.T(), "ReleaseReservation", mock.Anything, mock.Anything)
+}
+
+func (s *SuiteExpiry) TestExpireOrderRejectsPaidOrder() {
+	order := pendingOrder()
+	order.Status = model.OrderStatusPAID
+
+	_, _, err := expireOrder(order, time.Now())
+
+	var conflict *model.ConflictError
+	s.Require().ErrorAs(err, &conflict)
+	s.Require().Equal(model.OrderStatusPAID, order.Status)
+}
@@ -0,0 +1,39 @@
+package order_expiry
+
+import (
+	"time"
+
+	gRPCClient "github.com/ZanDattSu/star-factory/order/internal/client/grpc"
+	"github.com/ZanDattSu/star-factory/order/internal/repository"
+	serv "github.com/ZanDattSu/star-factory/order/internal/service"
+)
+
+var _ serv.OrderExpiryService = (*service)(nil)
+
+type service struct {
+	orderRepository repository.OrderRepository
+	inventoryClient gRPCClient.InventoryClient
+	statusNotifier  serv.OrderStatusNotifier
+
+	ttl           time.Duration
+	sweepInterval time.Duration
+	batchSize     int
+}
+
+func NewService(
+	orderRepository repository.OrderRepository,
+	inventoryClient gRPCClient.InventoryClient,
+	statusNotifier serv.OrderStatusNotifier,
+	ttl time.Duration,
+	sweepInterval time.Duration,
+	batchSize int,
+) *service {
+	return &service{
+		orderRepository: orderRepository,
+		inventoryClient: inventoryClient,
+		statusNotifier:  statusNotifier,
+		ttl:             ttl,
+		sweepInterval:   sweepInterval,
+		batchSize:       batchSize,
+	}
+}
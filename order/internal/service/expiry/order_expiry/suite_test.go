@@ -0,0 +1,46 @@
+package order_expiry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	clientMocks "github.com/ZanDattSu/star-factory/order/internal/client/grpc/mocks"
+	"github.com/ZanDattSu/star-factory/order/internal/repository/mocks"
+	"github.com/ZanDattSu/star-factory/order/internal/service/notifier/status_notifier"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+type SuiteExpiry struct {
+	suite.Suite
+
+	ctx context.Context //nolint:containedctx
+
+	orderRepository *mocks.OrderRepository
+	inventoryClient *clientMocks.InventoryClient
+
+	service *service
+}
+
+func (s *SuiteExpiry) SetupTest() {
+	s.ctx = context.Background()
+
+	s.orderRepository = mocks.NewOrderRepository(s.T())
+	s.inventoryClient = clientMocks.NewInventoryClient(s.T())
+
+	s.service = NewService(
+		s.orderRepository,
+		s.inventoryClient,
+		status_notifier.NewNotifier(),
+		30*time.Minute,
+		time.Minute,
+		10,
+	)
+	logger.SetNopLogger()
+}
+
+func TestExpirySuite(t *testing.T) {
+	suite.Run(t, new(SuiteExpiry))
+}
@@ -0,0 +1,217 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/ZanDattSu/star-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CartService is an autogenerated mock type for the CartService type
+type CartService struct {
+	mock.Mock
+}
+
+type CartService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CartService) EXPECT() *CartService_Expecter {
+	return &CartService_Expecter{mock: &_m.Mock}
+}
+
+// AddCartItem provides a mock function with given fields: ctx, userUUID, partUUID, quantity
+func (_m *CartService) AddCartItem(ctx context.Context, userUUID string, partUUID string, quantity int64) (*model.Cart, error) {
+	ret := _m.Called(ctx, userUUID, partUUID, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCartItem")
+	}
+
+	var r0 *model.Cart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) (*model.Cart, error)); ok {
+		return rf(ctx, userUUID, partUUID, quantity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) *model.Cart); ok {
+		r0 = rf(ctx, userUUID, partUUID, quantity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Cart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64) error); ok {
+		r1 = rf(ctx, userUUID, partUUID, quantity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CartService_AddCartItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCartItem'
+type CartService_AddCartItem_Call struct {
+	*mock.Call
+}
+
+// AddCartItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - partUUID string
+//   - quantity int64
+func (_e *CartService_Expecter) AddCartItem(ctx interface{}, userUUID interface{}, partUUID interface{}, quantity interface{}) *CartService_AddCartItem_Call {
+	return &CartService_AddCartItem_Call{Call: _e.mock.On("AddCartItem", ctx, userUUID, partUUID, quantity)}
+}
+
+func (_c *CartService_AddCartItem_Call) Run(run func(ctx context.Context, userUUID string, partUUID string, quantity int64)) *CartService_AddCartItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *CartService_AddCartItem_Call) Return(_a0 *model.Cart, _a1 error) *CartService_AddCartItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CartService_AddCartItem_Call) RunAndReturn(run func(context.Context, string, string, int64) (*model.Cart, error)) *CartService_AddCartItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCart provides a mock function with given fields: ctx, userUUID
+func (_m *CartService) GetCart(ctx context.Context, userUUID string) (*model.Cart, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCart")
+	}
+
+	var r0 *model.Cart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Cart, error)); ok {
+		return rf(ctx, userUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Cart); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Cart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CartService_GetCart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCart'
+type CartService_GetCart_Call struct {
+	*mock.Call
+}
+
+// GetCart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+func (_e *CartService_Expecter) GetCart(ctx interface{}, userUUID interface{}) *CartService_GetCart_Call {
+	return &CartService_GetCart_Call{Call: _e.mock.On("GetCart", ctx, userUUID)}
+}
+
+func (_c *CartService_GetCart_Call) Run(run func(ctx context.Context, userUUID string)) *CartService_GetCart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CartService_GetCart_Call) Return(_a0 *model.Cart, _a1 error) *CartService_GetCart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CartService_GetCart_Call) RunAndReturn(run func(context.Context, string) (*model.Cart, error)) *CartService_GetCart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveCartItem provides a mock function with given fields: ctx, userUUID, partUUID
+func (_m *CartService) RemoveCartItem(ctx context.Context, userUUID string, partUUID string) (*model.Cart, error) {
+	ret := _m.Called(ctx, userUUID, partUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCartItem")
+	}
+
+	var r0 *model.Cart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.Cart, error)); ok {
+		return rf(ctx, userUUID, partUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Cart); ok {
+		r0 = rf(ctx, userUUID, partUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Cart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userUUID, partUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CartService_RemoveCartItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCartItem'
+type CartService_RemoveCartItem_Call struct {
+	*mock.Call
+}
+
+// RemoveCartItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - partUUID string
+func (_e *CartService_Expecter) RemoveCartItem(ctx interface{}, userUUID interface{}, partUUID interface{}) *CartService_RemoveCartItem_Call {
+	return &CartService_RemoveCartItem_Call{Call: _e.mock.On("RemoveCartItem", ctx, userUUID, partUUID)}
+}
+
+func (_c *CartService_RemoveCartItem_Call) Run(run func(ctx context.Context, userUUID string, partUUID string)) *CartService_RemoveCartItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *CartService_RemoveCartItem_Call) Return(_a0 *model.Cart, _a1 error) *CartService_RemoveCartItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CartService_RemoveCartItem_Call) RunAndReturn(run func(context.Context, string, string) (*model.Cart, error)) *CartService_RemoveCartItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCartService creates a new instance of CartService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCartService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CartService {
+	mock := &CartService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,138 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IdempotencyExpiryService is an autogenerated mock type for the IdempotencyExpiryService type
+type IdempotencyExpiryService struct {
+	mock.Mock
+}
+
+type IdempotencyExpiryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IdempotencyExpiryService) EXPECT() *IdempotencyExpiryService_Expecter {
+	return &IdempotencyExpiryService_Expecter{mock: &_m.Mock}
+}
+
+// PurgeExpiredKeys provides a mock function with given fields: ctx
+func (_m *IdempotencyExpiryService) PurgeExpiredKeys(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeExpiredKeys")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdempotencyExpiryService_PurgeExpiredKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeExpiredKeys'
+type IdempotencyExpiryService_PurgeExpiredKeys_Call struct {
+	*mock.Call
+}
+
+// PurgeExpiredKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IdempotencyExpiryService_Expecter) PurgeExpiredKeys(ctx interface{}) *IdempotencyExpiryService_PurgeExpiredKeys_Call {
+	return &IdempotencyExpiryService_PurgeExpiredKeys_Call{Call: _e.mock.On("PurgeExpiredKeys", ctx)}
+}
+
+func (_c *IdempotencyExpiryService_PurgeExpiredKeys_Call) Run(run func(ctx context.Context)) *IdempotencyExpiryService_PurgeExpiredKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IdempotencyExpiryService_PurgeExpiredKeys_Call) Return(_a0 int, _a1 error) *IdempotencyExpiryService_PurgeExpiredKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IdempotencyExpiryService_PurgeExpiredKeys_Call) RunAndReturn(run func(context.Context) (int, error)) *IdempotencyExpiryService_PurgeExpiredKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunPurgeSweeper provides a mock function with given fields: ctx
+func (_m *IdempotencyExpiryService) RunPurgeSweeper(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunPurgeSweeper")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdempotencyExpiryService_RunPurgeSweeper_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunPurgeSweeper'
+type IdempotencyExpiryService_RunPurgeSweeper_Call struct {
+	*mock.Call
+}
+
+// RunPurgeSweeper is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IdempotencyExpiryService_Expecter) RunPurgeSweeper(ctx interface{}) *IdempotencyExpiryService_RunPurgeSweeper_Call {
+	return &IdempotencyExpiryService_RunPurgeSweeper_Call{Call: _e.mock.On("RunPurgeSweeper", ctx)}
+}
+
+func (_c *IdempotencyExpiryService_RunPurgeSweeper_Call) Run(run func(ctx context.Context)) *IdempotencyExpiryService_RunPurgeSweeper_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IdempotencyExpiryService_RunPurgeSweeper_Call) Return(_a0 error) *IdempotencyExpiryService_RunPurgeSweeper_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotencyExpiryService_RunPurgeSweeper_Call) RunAndReturn(run func(context.Context) error) *IdempotencyExpiryService_RunPurgeSweeper_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIdempotencyExpiryService creates a new instance of IdempotencyExpiryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdempotencyExpiryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IdempotencyExpiryService {
+	mock := &IdempotencyExpiryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
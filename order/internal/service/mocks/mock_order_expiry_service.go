@@ -0,0 +1,138 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrderExpiryService is an autogenerated mock type for the OrderExpiryService type
+type OrderExpiryService struct {
+	mock.Mock
+}
+
+type OrderExpiryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderExpiryService) EXPECT() *OrderExpiryService_Expecter {
+	return &OrderExpiryService_Expecter{mock: &_m.Mock}
+}
+
+// ExpireOrders provides a mock function with given fields: ctx
+func (_m *OrderExpiryService) ExpireOrders(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireOrders")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderExpiryService_ExpireOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireOrders'
+type OrderExpiryService_ExpireOrders_Call struct {
+	*mock.Call
+}
+
+// ExpireOrders is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OrderExpiryService_Expecter) ExpireOrders(ctx interface{}) *OrderExpiryService_ExpireOrders_Call {
+	return &OrderExpiryService_ExpireOrders_Call{Call: _e.mock.On("ExpireOrders", ctx)}
+}
+
+func (_c *OrderExpiryService_ExpireOrders_Call) Run(run func(ctx context.Context)) *OrderExpiryService_ExpireOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OrderExpiryService_ExpireOrders_Call) Return(_a0 int, _a1 error) *OrderExpiryService_ExpireOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderExpiryService_ExpireOrders_Call) RunAndReturn(run func(context.Context) (int, error)) *OrderExpiryService_ExpireOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunExpirySweeper provides a mock function with given fields: ctx
+func (_m *OrderExpiryService) RunExpirySweeper(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunExpirySweeper")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderExpiryService_RunExpirySweeper_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunExpirySweeper'
+type OrderExpiryService_RunExpirySweeper_Call struct {
+	*mock.Call
+}
+
+// RunExpirySweeper is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OrderExpiryService_Expecter) RunExpirySweeper(ctx interface{}) *OrderExpiryService_RunExpirySweeper_Call {
+	return &OrderExpiryService_RunExpirySweeper_Call{Call: _e.mock.On("RunExpirySweeper", ctx)}
+}
+
+func (_c *OrderExpiryService_RunExpirySweeper_Call) Run(run func(ctx context.Context)) *OrderExpiryService_RunExpirySweeper_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OrderExpiryService_RunExpirySweeper_Call) Return(_a0 error) *OrderExpiryService_RunExpirySweeper_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderExpiryService_RunExpirySweeper_Call) RunAndReturn(run func(context.Context) error) *OrderExpiryService_RunExpirySweeper_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderExpiryService creates a new instance of OrderExpiryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderExpiryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderExpiryService {
+	mock := &OrderExpiryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
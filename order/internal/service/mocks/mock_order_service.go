@@ -22,17 +22,17 @@ func (_m *OrderService) EXPECT() *OrderService_Expecter {
 	return &OrderService_Expecter{mock: &_m.Mock}
 }
 
-// CancelOrder provides a mock function with given fields: ctx, orderUUID
-func (_m *OrderService) CancelOrder(ctx context.Context, orderUUID string) error {
-	ret := _m.Called(ctx, orderUUID)
+// CancelOrder provides a mock function with given fields: ctx, userUUID, orderUUID
+func (_m *OrderService) CancelOrder(ctx context.Context, userUUID string, orderUUID string) error {
+	ret := _m.Called(ctx, userUUID, orderUUID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CancelOrder")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, orderUUID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userUUID, orderUUID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -47,14 +47,15 @@ type OrderService_CancelOrder_Call struct {
 
 // CancelOrder is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userUUID string
 //   - orderUUID string
-func (_e *OrderService_Expecter) CancelOrder(ctx interface{}, orderUUID interface{}) *OrderService_CancelOrder_Call {
-	return &OrderService_CancelOrder_Call{Call: _e.mock.On("CancelOrder", ctx, orderUUID)}
+func (_e *OrderService_Expecter) CancelOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}) *OrderService_CancelOrder_Call {
+	return &OrderService_CancelOrder_Call{Call: _e.mock.On("CancelOrder", ctx, userUUID, orderUUID)}
 }
 
-func (_c *OrderService_CancelOrder_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderService_CancelOrder_Call {
+func (_c *OrderService_CancelOrder_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string)) *OrderService_CancelOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -64,39 +65,106 @@ func (_c *OrderService_CancelOrder_Call) Return(_a0 error) *OrderService_CancelO
 	return _c
 }
 
-func (_c *OrderService_CancelOrder_Call) RunAndReturn(run func(context.Context, string) error) *OrderService_CancelOrder_Call {
+func (_c *OrderService_CancelOrder_Call) RunAndReturn(run func(context.Context, string, string) error) *OrderService_CancelOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckoutCart provides a mock function with given fields: ctx, userUUID, promoCode, quoteToken, idempotencyKey
+func (_m *OrderService) CheckoutCart(ctx context.Context, userUUID string, promoCode string, quoteToken string, idempotencyKey string) (string, model.OrderPrice, error) {
+	ret := _m.Called(ctx, userUUID, promoCode, quoteToken, idempotencyKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckoutCart")
+	}
+
+	var r0 string
+	var r1 model.OrderPrice
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (string, model.OrderPrice, error)); ok {
+		return rf(ctx, userUUID, promoCode, quoteToken, idempotencyKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) string); ok {
+		r0 = rf(ctx, userUUID, promoCode, quoteToken, idempotencyKey)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) model.OrderPrice); ok {
+		r1 = rf(ctx, userUUID, promoCode, quoteToken, idempotencyKey)
+	} else {
+		r1 = ret.Get(1).(model.OrderPrice)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string, string) error); ok {
+		r2 = rf(ctx, userUUID, promoCode, quoteToken, idempotencyKey)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrderService_CheckoutCart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckoutCart'
+type OrderService_CheckoutCart_Call struct {
+	*mock.Call
+}
+
+// CheckoutCart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - promoCode string
+//   - quoteToken string
+//   - idempotencyKey string
+func (_e *OrderService_Expecter) CheckoutCart(ctx interface{}, userUUID interface{}, promoCode interface{}, quoteToken interface{}, idempotencyKey interface{}) *OrderService_CheckoutCart_Call {
+	return &OrderService_CheckoutCart_Call{Call: _e.mock.On("CheckoutCart", ctx, userUUID, promoCode, quoteToken, idempotencyKey)}
+}
+
+func (_c *OrderService_CheckoutCart_Call) Run(run func(ctx context.Context, userUUID string, promoCode string, quoteToken string, idempotencyKey string)) *OrderService_CheckoutCart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *OrderService_CheckoutCart_Call) Return(_a0 string, _a1 model.OrderPrice, _a2 error) *OrderService_CheckoutCart_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrderService_CheckoutCart_Call) RunAndReturn(run func(context.Context, string, string, string, string) (string, model.OrderPrice, error)) *OrderService_CheckoutCart_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateOrder provides a mock function with given fields: ctx, userUUID, partUuids
-func (_m *OrderService) CreateOrder(ctx context.Context, userUUID string, partUuids []string) (string, float64, error) {
-	ret := _m.Called(ctx, userUUID, partUuids)
+// CreateOrder provides a mock function with given fields: ctx, userUUID, req, idempotencyKey
+func (_m *OrderService) CreateOrder(ctx context.Context, userUUID string, req model.OrderRequest, idempotencyKey string) (string, model.OrderPrice, error) {
+	ret := _m.Called(ctx, userUUID, req, idempotencyKey)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateOrder")
 	}
 
 	var r0 string
-	var r1 float64
+	var r1 model.OrderPrice
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (string, float64, error)); ok {
-		return rf(ctx, userUUID, partUuids)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrderRequest, string) (string, model.OrderPrice, error)); ok {
+		return rf(ctx, userUUID, req, idempotencyKey)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string) string); ok {
-		r0 = rf(ctx, userUUID, partUuids)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.OrderRequest, string) string); ok {
+		r0 = rf(ctx, userUUID, req, idempotencyKey)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, []string) float64); ok {
-		r1 = rf(ctx, userUUID, partUuids)
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.OrderRequest, string) model.OrderPrice); ok {
+		r1 = rf(ctx, userUUID, req, idempotencyKey)
 	} else {
-		r1 = ret.Get(1).(float64)
+		r1 = ret.Get(1).(model.OrderPrice)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, string, []string) error); ok {
-		r2 = rf(ctx, userUUID, partUuids)
+	if rf, ok := ret.Get(2).(func(context.Context, string, model.OrderRequest, string) error); ok {
+		r2 = rf(ctx, userUUID, req, idempotencyKey)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -112,31 +180,32 @@ type OrderService_CreateOrder_Call struct {
 // CreateOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userUUID string
-//   - partUuids []string
-func (_e *OrderService_Expecter) CreateOrder(ctx interface{}, userUUID interface{}, partUuids interface{}) *OrderService_CreateOrder_Call {
-	return &OrderService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userUUID, partUuids)}
+//   - req model.OrderRequest
+//   - idempotencyKey string
+func (_e *OrderService_Expecter) CreateOrder(ctx interface{}, userUUID interface{}, req interface{}, idempotencyKey interface{}) *OrderService_CreateOrder_Call {
+	return &OrderService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userUUID, req, idempotencyKey)}
 }
 
-func (_c *OrderService_CreateOrder_Call) Run(run func(ctx context.Context, userUUID string, partUuids []string)) *OrderService_CreateOrder_Call {
+func (_c *OrderService_CreateOrder_Call) Run(run func(ctx context.Context, userUUID string, req model.OrderRequest, idempotencyKey string)) *OrderService_CreateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+		run(args[0].(context.Context), args[1].(string), args[2].(model.OrderRequest), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *OrderService_CreateOrder_Call) Return(_a0 string, _a1 float64, _a2 error) *OrderService_CreateOrder_Call {
+func (_c *OrderService_CreateOrder_Call) Return(_a0 string, _a1 model.OrderPrice, _a2 error) *OrderService_CreateOrder_Call {
 	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *OrderService_CreateOrder_Call) RunAndReturn(run func(context.Context, string, []string) (string, float64, error)) *OrderService_CreateOrder_Call {
+func (_c *OrderService_CreateOrder_Call) RunAndReturn(run func(context.Context, string, model.OrderRequest, string) (string, model.OrderPrice, error)) *OrderService_CreateOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrder provides a mock function with given fields: ctx, orderUUID
-func (_m *OrderService) GetOrder(ctx context.Context, orderUUID string) (*model.Order, error) {
-	ret := _m.Called(ctx, orderUUID)
+// GetOrder provides a mock function with given fields: ctx, userUUID, orderUUID
+func (_m *OrderService) GetOrder(ctx context.Context, userUUID string, orderUUID string) (*model.Order, error) {
+	ret := _m.Called(ctx, userUUID, orderUUID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetOrder")
@@ -144,19 +213,19 @@ func (_m *OrderService) GetOrder(ctx context.Context, orderUUID string) (*model.
 
 	var r0 *model.Order
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Order, error)); ok {
-		return rf(ctx, orderUUID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.Order, error)); ok {
+		return rf(ctx, userUUID, orderUUID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Order); ok {
-		r0 = rf(ctx, orderUUID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Order); ok {
+		r0 = rf(ctx, userUUID, orderUUID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Order)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, orderUUID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userUUID, orderUUID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -171,14 +240,15 @@ type OrderService_GetOrder_Call struct {
 
 // GetOrder is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userUUID string
 //   - orderUUID string
-func (_e *OrderService_Expecter) GetOrder(ctx interface{}, orderUUID interface{}) *OrderService_GetOrder_Call {
-	return &OrderService_GetOrder_Call{Call: _e.mock.On("GetOrder", ctx, orderUUID)}
+func (_e *OrderService_Expecter) GetOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}) *OrderService_GetOrder_Call {
+	return &OrderService_GetOrder_Call{Call: _e.mock.On("GetOrder", ctx, userUUID, orderUUID)}
 }
 
-func (_c *OrderService_GetOrder_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderService_GetOrder_Call {
+func (_c *OrderService_GetOrder_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string)) *OrderService_GetOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -188,14 +258,141 @@ func (_c *OrderService_GetOrder_Call) Return(_a0 *model.Order, _a1 error) *Order
 	return _c
 }
 
-func (_c *OrderService_GetOrder_Call) RunAndReturn(run func(context.Context, string) (*model.Order, error)) *OrderService_GetOrder_Call {
+func (_c *OrderService_GetOrder_Call) RunAndReturn(run func(context.Context, string, string) (*model.Order, error)) *OrderService_GetOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PayOrder provides a mock function with given fields: ctx, paymentMethod, orderUUID
-func (_m *OrderService) PayOrder(ctx context.Context, paymentMethod model.PaymentMethod, orderUUID string) (string, error) {
-	ret := _m.Called(ctx, paymentMethod, orderUUID)
+// GetOrderStatusHistory provides a mock function with given fields: ctx, userUUID, orderUUID
+func (_m *OrderService) GetOrderStatusHistory(ctx context.Context, userUUID string, orderUUID string) ([]*model.OrderStatusChange, error) {
+	ret := _m.Called(ctx, userUUID, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderStatusHistory")
+	}
+
+	var r0 []*model.OrderStatusChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*model.OrderStatusChange, error)); ok {
+		return rf(ctx, userUUID, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*model.OrderStatusChange); ok {
+		r0 = rf(ctx, userUUID, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OrderStatusChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userUUID, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_GetOrderStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderStatusHistory'
+type OrderService_GetOrderStatusHistory_Call struct {
+	*mock.Call
+}
+
+// GetOrderStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - orderUUID string
+func (_e *OrderService_Expecter) GetOrderStatusHistory(ctx interface{}, userUUID interface{}, orderUUID interface{}) *OrderService_GetOrderStatusHistory_Call {
+	return &OrderService_GetOrderStatusHistory_Call{Call: _e.mock.On("GetOrderStatusHistory", ctx, userUUID, orderUUID)}
+}
+
+func (_c *OrderService_GetOrderStatusHistory_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string)) *OrderService_GetOrderStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OrderService_GetOrderStatusHistory_Call) Return(_a0 []*model.OrderStatusChange, _a1 error) *OrderService_GetOrderStatusHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_GetOrderStatusHistory_Call) RunAndReturn(run func(context.Context, string, string) ([]*model.OrderStatusChange, error)) *OrderService_GetOrderStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function with given fields: ctx, filter, cursor
+func (_m *OrderService) ListOrders(ctx context.Context, filter model.OrdersFilter, cursor string) ([]*model.Order, string, error) {
+	ret := _m.Called(ctx, filter, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 []*model.Order
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersFilter, string) ([]*model.Order, string, error)); ok {
+		return rf(ctx, filter, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersFilter, string) []*model.Order); ok {
+		r0 = rf(ctx, filter, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.OrdersFilter, string) string); ok {
+		r1 = rf(ctx, filter, cursor)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.OrdersFilter, string) error); ok {
+		r2 = rf(ctx, filter, cursor)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrderService_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type OrderService_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.OrdersFilter
+//   - cursor string
+func (_e *OrderService_Expecter) ListOrders(ctx interface{}, filter interface{}, cursor interface{}) *OrderService_ListOrders_Call {
+	return &OrderService_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, filter, cursor)}
+}
+
+func (_c *OrderService_ListOrders_Call) Run(run func(ctx context.Context, filter model.OrdersFilter, cursor string)) *OrderService_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrdersFilter), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OrderService_ListOrders_Call) Return(_a0 []*model.Order, _a1 string, _a2 error) *OrderService_ListOrders_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrderService_ListOrders_Call) RunAndReturn(run func(context.Context, model.OrdersFilter, string) ([]*model.Order, string, error)) *OrderService_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PayOrder provides a mock function with given fields: ctx, userUUID, paymentMethod, orderUUID, idempotencyKey
+func (_m *OrderService) PayOrder(ctx context.Context, userUUID string, paymentMethod model.PaymentMethod, orderUUID string, idempotencyKey string) (string, error) {
+	ret := _m.Called(ctx, userUUID, paymentMethod, orderUUID, idempotencyKey)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
@@ -203,17 +400,17 @@ func (_m *OrderService) PayOrder(ctx context.Context, paymentMethod model.Paymen
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.PaymentMethod, string) (string, error)); ok {
-		return rf(ctx, paymentMethod, orderUUID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PaymentMethod, string, string) (string, error)); ok {
+		return rf(ctx, userUUID, paymentMethod, orderUUID, idempotencyKey)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, model.PaymentMethod, string) string); ok {
-		r0 = rf(ctx, paymentMethod, orderUUID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PaymentMethod, string, string) string); ok {
+		r0 = rf(ctx, userUUID, paymentMethod, orderUUID, idempotencyKey)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, model.PaymentMethod, string) error); ok {
-		r1 = rf(ctx, paymentMethod, orderUUID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.PaymentMethod, string, string) error); ok {
+		r1 = rf(ctx, userUUID, paymentMethod, orderUUID, idempotencyKey)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -228,15 +425,17 @@ type OrderService_PayOrder_Call struct {
 
 // PayOrder is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userUUID string
 //   - paymentMethod model.PaymentMethod
 //   - orderUUID string
-func (_e *OrderService_Expecter) PayOrder(ctx interface{}, paymentMethod interface{}, orderUUID interface{}) *OrderService_PayOrder_Call {
-	return &OrderService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, paymentMethod, orderUUID)}
+//   - idempotencyKey string
+func (_e *OrderService_Expecter) PayOrder(ctx interface{}, userUUID interface{}, paymentMethod interface{}, orderUUID interface{}, idempotencyKey interface{}) *OrderService_PayOrder_Call {
+	return &OrderService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, userUUID, paymentMethod, orderUUID, idempotencyKey)}
 }
 
-func (_c *OrderService_PayOrder_Call) Run(run func(ctx context.Context, paymentMethod model.PaymentMethod, orderUUID string)) *OrderService_PayOrder_Call {
+func (_c *OrderService_PayOrder_Call) Run(run func(ctx context.Context, userUUID string, paymentMethod model.PaymentMethod, orderUUID string, idempotencyKey string)) *OrderService_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.PaymentMethod), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(model.PaymentMethod), args[3].(string), args[4].(string))
 	})
 	return _c
 }
@@ -246,7 +445,199 @@ func (_c *OrderService_PayOrder_Call) Return(_a0 string, _a1 error) *OrderServic
 	return _c
 }
 
-func (_c *OrderService_PayOrder_Call) RunAndReturn(run func(context.Context, model.PaymentMethod, string) (string, error)) *OrderService_PayOrder_Call {
+func (_c *OrderService_PayOrder_Call) RunAndReturn(run func(context.Context, string, model.PaymentMethod, string, string) (string, error)) *OrderService_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QuoteOrder provides a mock function with given fields: ctx, userUUID, items, promoCode
+func (_m *OrderService) QuoteOrder(ctx context.Context, userUUID string, items []model.OrderItem, promoCode string) (*model.Quote, error) {
+	ret := _m.Called(ctx, userUUID, items, promoCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuoteOrder")
+	}
+
+	var r0 *model.Quote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.OrderItem, string) (*model.Quote, error)); ok {
+		return rf(ctx, userUUID, items, promoCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.OrderItem, string) *model.Quote); ok {
+		r0 = rf(ctx, userUUID, items, promoCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Quote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []model.OrderItem, string) error); ok {
+		r1 = rf(ctx, userUUID, items, promoCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_QuoteOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QuoteOrder'
+type OrderService_QuoteOrder_Call struct {
+	*mock.Call
+}
+
+// QuoteOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - items []model.OrderItem
+//   - promoCode string
+func (_e *OrderService_Expecter) QuoteOrder(ctx interface{}, userUUID interface{}, items interface{}, promoCode interface{}) *OrderService_QuoteOrder_Call {
+	return &OrderService_QuoteOrder_Call{Call: _e.mock.On("QuoteOrder", ctx, userUUID, items, promoCode)}
+}
+
+func (_c *OrderService_QuoteOrder_Call) Run(run func(ctx context.Context, userUUID string, items []model.OrderItem, promoCode string)) *OrderService_QuoteOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]model.OrderItem), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *OrderService_QuoteOrder_Call) Return(_a0 *model.Quote, _a1 error) *OrderService_QuoteOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_QuoteOrder_Call) RunAndReturn(run func(context.Context, string, []model.OrderItem, string) (*model.Quote, error)) *OrderService_QuoteOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrderItems provides a mock function with given fields: ctx, userUUID, orderUUID, items, version
+func (_m *OrderService) UpdateOrderItems(ctx context.Context, userUUID string, orderUUID string, items []model.OrderItem, version int64) (*model.Order, error) {
+	ret := _m.Called(ctx, userUUID, orderUUID, items, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderItems")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []model.OrderItem, int64) (*model.Order, error)); ok {
+		return rf(ctx, userUUID, orderUUID, items, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []model.OrderItem, int64) *model.Order); ok {
+		r0 = rf(ctx, userUUID, orderUUID, items, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []model.OrderItem, int64) error); ok {
+		r1 = rf(ctx, userUUID, orderUUID, items, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_UpdateOrderItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrderItems'
+type OrderService_UpdateOrderItems_Call struct {
+	*mock.Call
+}
+
+// UpdateOrderItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - orderUUID string
+//   - items []model.OrderItem
+//   - version int64
+func (_e *OrderService_Expecter) UpdateOrderItems(ctx interface{}, userUUID interface{}, orderUUID interface{}, items interface{}, version interface{}) *OrderService_UpdateOrderItems_Call {
+	return &OrderService_UpdateOrderItems_Call{Call: _e.mock.On("UpdateOrderItems", ctx, userUUID, orderUUID, items, version)}
+}
+
+func (_c *OrderService_UpdateOrderItems_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string, items []model.OrderItem, version int64)) *OrderService_UpdateOrderItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]model.OrderItem), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *OrderService_UpdateOrderItems_Call) Return(_a0 *model.Order, _a1 error) *OrderService_UpdateOrderItems_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_UpdateOrderItems_Call) RunAndReturn(run func(context.Context, string, string, []model.OrderItem, int64) (*model.Order, error)) *OrderService_UpdateOrderItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchOrderStatus provides a mock function with given fields: ctx, userUUID, orderUUID
+func (_m *OrderService) WatchOrderStatus(ctx context.Context, userUUID string, orderUUID string) (<-chan struct{}, func(), error) {
+	ret := _m.Called(ctx, userUUID, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchOrderStatus")
+	}
+
+	var r0 <-chan struct{}
+	var r1 func()
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (<-chan struct{}, func(), error)); ok {
+		return rf(ctx, userUUID, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) <-chan struct{}); ok {
+		r0 = rf(ctx, userUUID, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) func()); ok {
+		r1 = rf(ctx, userUUID, orderUUID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, userUUID, orderUUID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OrderService_WatchOrderStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchOrderStatus'
+type OrderService_WatchOrderStatus_Call struct {
+	*mock.Call
+}
+
+// WatchOrderStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - orderUUID string
+func (_e *OrderService_Expecter) WatchOrderStatus(ctx interface{}, userUUID interface{}, orderUUID interface{}) *OrderService_WatchOrderStatus_Call {
+	return &OrderService_WatchOrderStatus_Call{Call: _e.mock.On("WatchOrderStatus", ctx, userUUID, orderUUID)}
+}
+
+func (_c *OrderService_WatchOrderStatus_Call) Run(run func(ctx context.Context, userUUID string, orderUUID string)) *OrderService_WatchOrderStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OrderService_WatchOrderStatus_Call) Return(_a0 <-chan struct{}, _a1 func(), _a2 error) *OrderService_WatchOrderStatus_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *OrderService_WatchOrderStatus_Call) RunAndReturn(run func(context.Context, string, string) (<-chan struct{}, func(), error)) *OrderService_WatchOrderStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
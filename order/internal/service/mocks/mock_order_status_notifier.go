@@ -0,0 +1,125 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// OrderStatusNotifier is an autogenerated mock type for the OrderStatusNotifier type
+type OrderStatusNotifier struct {
+	mock.Mock
+}
+
+type OrderStatusNotifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderStatusNotifier) EXPECT() *OrderStatusNotifier_Expecter {
+	return &OrderStatusNotifier_Expecter{mock: &_m.Mock}
+}
+
+// Notify provides a mock function with given fields: orderUUID
+func (_m *OrderStatusNotifier) Notify(orderUUID string) {
+	_m.Called(orderUUID)
+}
+
+// OrderStatusNotifier_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
+type OrderStatusNotifier_Notify_Call struct {
+	*mock.Call
+}
+
+// Notify is a helper method to define mock.On call
+//   - orderUUID string
+func (_e *OrderStatusNotifier_Expecter) Notify(orderUUID interface{}) *OrderStatusNotifier_Notify_Call {
+	return &OrderStatusNotifier_Notify_Call{Call: _e.mock.On("Notify", orderUUID)}
+}
+
+func (_c *OrderStatusNotifier_Notify_Call) Run(run func(orderUUID string)) *OrderStatusNotifier_Notify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *OrderStatusNotifier_Notify_Call) Return() *OrderStatusNotifier_Notify_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *OrderStatusNotifier_Notify_Call) RunAndReturn(run func(string)) *OrderStatusNotifier_Notify_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: orderUUID
+func (_m *OrderStatusNotifier) Subscribe(orderUUID string) (<-chan struct{}, func()) {
+	ret := _m.Called(orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan struct{}
+	var r1 func()
+	if rf, ok := ret.Get(0).(func(string) (<-chan struct{}, func())); ok {
+		return rf(orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(string) <-chan struct{}); ok {
+		r0 = rf(orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) func()); ok {
+		r1 = rf(orderUUID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// OrderStatusNotifier_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type OrderStatusNotifier_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - orderUUID string
+func (_e *OrderStatusNotifier_Expecter) Subscribe(orderUUID interface{}) *OrderStatusNotifier_Subscribe_Call {
+	return &OrderStatusNotifier_Subscribe_Call{Call: _e.mock.On("Subscribe", orderUUID)}
+}
+
+func (_c *OrderStatusNotifier_Subscribe_Call) Run(run func(orderUUID string)) *OrderStatusNotifier_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *OrderStatusNotifier_Subscribe_Call) Return(_a0 <-chan struct{}, _a1 func()) *OrderStatusNotifier_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderStatusNotifier_Subscribe_Call) RunAndReturn(run func(string) (<-chan struct{}, func())) *OrderStatusNotifier_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderStatusNotifier creates a new instance of OrderStatusNotifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderStatusNotifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderStatusNotifier {
+	mock := &OrderStatusNotifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
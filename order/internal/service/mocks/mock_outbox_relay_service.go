@@ -0,0 +1,82 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OutboxRelayService is an autogenerated mock type for the OutboxRelayService type
+type OutboxRelayService struct {
+	mock.Mock
+}
+
+type OutboxRelayService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OutboxRelayService) EXPECT() *OutboxRelayService_Expecter {
+	return &OutboxRelayService_Expecter{mock: &_m.Mock}
+}
+
+// RunRelay provides a mock function with given fields: ctx
+func (_m *OutboxRelayService) RunRelay(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunRelay")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxRelayService_RunRelay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunRelay'
+type OutboxRelayService_RunRelay_Call struct {
+	*mock.Call
+}
+
+// RunRelay is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OutboxRelayService_Expecter) RunRelay(ctx interface{}) *OutboxRelayService_RunRelay_Call {
+	return &OutboxRelayService_RunRelay_Call{Call: _e.mock.On("RunRelay", ctx)}
+}
+
+func (_c *OutboxRelayService_RunRelay_Call) Run(run func(ctx context.Context)) *OutboxRelayService_RunRelay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OutboxRelayService_RunRelay_Call) Return(_a0 error) *OutboxRelayService_RunRelay_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxRelayService_RunRelay_Call) RunAndReturn(run func(context.Context) error) *OutboxRelayService_RunRelay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOutboxRelayService creates a new instance of OutboxRelayService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxRelayService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxRelayService {
+	mock := &OutboxRelayService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,276 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/ZanDattSu/star-factory/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PromoCodeService is an autogenerated mock type for the PromoCodeService type
+type PromoCodeService struct {
+	mock.Mock
+}
+
+type PromoCodeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PromoCodeService) EXPECT() *PromoCodeService_Expecter {
+	return &PromoCodeService_Expecter{mock: &_m.Mock}
+}
+
+// CreatePromoCode provides a mock function with given fields: ctx, userUUID, promo
+func (_m *PromoCodeService) CreatePromoCode(ctx context.Context, userUUID string, promo model.PromoCode) (*model.PromoCode, error) {
+	ret := _m.Called(ctx, userUUID, promo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePromoCode")
+	}
+
+	var r0 *model.PromoCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PromoCode) (*model.PromoCode, error)); ok {
+		return rf(ctx, userUUID, promo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PromoCode) *model.PromoCode); ok {
+		r0 = rf(ctx, userUUID, promo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PromoCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.PromoCode) error); ok {
+		r1 = rf(ctx, userUUID, promo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PromoCodeService_CreatePromoCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePromoCode'
+type PromoCodeService_CreatePromoCode_Call struct {
+	*mock.Call
+}
+
+// CreatePromoCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - promo model.PromoCode
+func (_e *PromoCodeService_Expecter) CreatePromoCode(ctx interface{}, userUUID interface{}, promo interface{}) *PromoCodeService_CreatePromoCode_Call {
+	return &PromoCodeService_CreatePromoCode_Call{Call: _e.mock.On("CreatePromoCode", ctx, userUUID, promo)}
+}
+
+func (_c *PromoCodeService_CreatePromoCode_Call) Run(run func(ctx context.Context, userUUID string, promo model.PromoCode)) *PromoCodeService_CreatePromoCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.PromoCode))
+	})
+	return _c
+}
+
+func (_c *PromoCodeService_CreatePromoCode_Call) Return(_a0 *model.PromoCode, _a1 error) *PromoCodeService_CreatePromoCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PromoCodeService_CreatePromoCode_Call) RunAndReturn(run func(context.Context, string, model.PromoCode) (*model.PromoCode, error)) *PromoCodeService_CreatePromoCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeactivatePromoCode provides a mock function with given fields: ctx, userUUID, code
+func (_m *PromoCodeService) DeactivatePromoCode(ctx context.Context, userUUID string, code string) (*model.PromoCode, error) {
+	ret := _m.Called(ctx, userUUID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivatePromoCode")
+	}
+
+	var r0 *model.PromoCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.PromoCode, error)); ok {
+		return rf(ctx, userUUID, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.PromoCode); ok {
+		r0 = rf(ctx, userUUID, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PromoCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userUUID, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PromoCodeService_DeactivatePromoCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivatePromoCode'
+type PromoCodeService_DeactivatePromoCode_Call struct {
+	*mock.Call
+}
+
+// DeactivatePromoCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - code string
+func (_e *PromoCodeService_Expecter) DeactivatePromoCode(ctx interface{}, userUUID interface{}, code interface{}) *PromoCodeService_DeactivatePromoCode_Call {
+	return &PromoCodeService_DeactivatePromoCode_Call{Call: _e.mock.On("DeactivatePromoCode", ctx, userUUID, code)}
+}
+
+func (_c *PromoCodeService_DeactivatePromoCode_Call) Run(run func(ctx context.Context, userUUID string, code string)) *PromoCodeService_DeactivatePromoCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PromoCodeService_DeactivatePromoCode_Call) Return(_a0 *model.PromoCode, _a1 error) *PromoCodeService_DeactivatePromoCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PromoCodeService_DeactivatePromoCode_Call) RunAndReturn(run func(context.Context, string, string) (*model.PromoCode, error)) *PromoCodeService_DeactivatePromoCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPromoCode provides a mock function with given fields: ctx, userUUID, code
+func (_m *PromoCodeService) GetPromoCode(ctx context.Context, userUUID string, code string) (*model.PromoCode, error) {
+	ret := _m.Called(ctx, userUUID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromoCode")
+	}
+
+	var r0 *model.PromoCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.PromoCode, error)); ok {
+		return rf(ctx, userUUID, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.PromoCode); ok {
+		r0 = rf(ctx, userUUID, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PromoCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userUUID, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PromoCodeService_GetPromoCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromoCode'
+type PromoCodeService_GetPromoCode_Call struct {
+	*mock.Call
+}
+
+// GetPromoCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - code string
+func (_e *PromoCodeService_Expecter) GetPromoCode(ctx interface{}, userUUID interface{}, code interface{}) *PromoCodeService_GetPromoCode_Call {
+	return &PromoCodeService_GetPromoCode_Call{Call: _e.mock.On("GetPromoCode", ctx, userUUID, code)}
+}
+
+func (_c *PromoCodeService_GetPromoCode_Call) Run(run func(ctx context.Context, userUUID string, code string)) *PromoCodeService_GetPromoCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PromoCodeService_GetPromoCode_Call) Return(_a0 *model.PromoCode, _a1 error) *PromoCodeService_GetPromoCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PromoCodeService_GetPromoCode_Call) RunAndReturn(run func(context.Context, string, string) (*model.PromoCode, error)) *PromoCodeService_GetPromoCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPromoCodes provides a mock function with given fields: ctx, userUUID
+func (_m *PromoCodeService) ListPromoCodes(ctx context.Context, userUUID string) ([]*model.PromoCode, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPromoCodes")
+	}
+
+	var r0 []*model.PromoCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.PromoCode, error)); ok {
+		return rf(ctx, userUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.PromoCode); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PromoCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PromoCodeService_ListPromoCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPromoCodes'
+type PromoCodeService_ListPromoCodes_Call struct {
+	*mock.Call
+}
+
+// ListPromoCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+func (_e *PromoCodeService_Expecter) ListPromoCodes(ctx interface{}, userUUID interface{}) *PromoCodeService_ListPromoCodes_Call {
+	return &PromoCodeService_ListPromoCodes_Call{Call: _e.mock.On("ListPromoCodes", ctx, userUUID)}
+}
+
+func (_c *PromoCodeService_ListPromoCodes_Call) Run(run func(ctx context.Context, userUUID string)) *PromoCodeService_ListPromoCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PromoCodeService_ListPromoCodes_Call) Return(_a0 []*model.PromoCode, _a1 error) *PromoCodeService_ListPromoCodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PromoCodeService_ListPromoCodes_Call) RunAndReturn(run func(context.Context, string) ([]*model.PromoCode, error)) *PromoCodeService_ListPromoCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPromoCodeService creates a new instance of PromoCodeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPromoCodeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PromoCodeService {
+	mock := &PromoCodeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,62 @@
+package status_notifier
+
+import (
+	"sync"
+
+	serv "github.com/ZanDattSu/star-factory/order/internal/service"
+)
+
+// Компиляторная проверка: убеждаемся, что *notifier реализует интерфейс OrderStatusNotifier.
+var _ serv.OrderStatusNotifier = (*notifier)(nil)
+
+// notifier рассылает сигналы о смене статуса только внутри процесса.
+// Переходы, выполненные другими репликами, подписчик увидит при очередной сверке с историей статусов.
+type notifier struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan struct{}]struct{}
+}
+
+func NewNotifier() *notifier {
+	return &notifier{
+		subscribers: make(map[string]map[chan struct{}]struct{}),
+	}
+}
+
+func (n *notifier) Subscribe(orderUUID string) (<-chan struct{}, func()) {
+	// Буфер на один сигнал: несколько переходов подряд схлопываются, подписчик всё равно перечитает историю
+	ch := make(chan struct{}, 1)
+
+	n.mu.Lock()
+	if n.subscribers[orderUUID] == nil {
+		n.subscribers[orderUUID] = make(map[chan struct{}]struct{})
+	}
+	n.subscribers[orderUUID][ch] = struct{}{}
+	n.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			n.mu.Lock()
+			defer n.mu.Unlock()
+
+			delete(n.subscribers[orderUUID], ch)
+			if len(n.subscribers[orderUUID]) == 0 {
+				delete(n.subscribers, orderUUID)
+			}
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+func (n *notifier) Notify(orderUUID string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for ch := range n.subscribers[orderUUID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
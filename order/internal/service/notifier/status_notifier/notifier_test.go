@@ -0,0 +1,35 @@
+package status_notifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifyWakesOnlyOrderSubscribers(t *testing.T) {
+	n := NewNotifier()
+
+	first, unsubscribeFirst := n.Subscribe("order-1")
+	defer unsubscribeFirst()
+	other, unsubscribeOther := n.Subscribe("order-2")
+	defer unsubscribeOther()
+
+	n.Notify("order-1")
+	n.Notify("order-1")
+
+	require.Len(t, first, 1)
+	require.Empty(t, other)
+}
+
+func TestUnsubscribeRemovesSubscriber(t *testing.T) {
+	n := NewNotifier()
+
+	ch, unsubscribe := n.Subscribe("order-1")
+	unsubscribe()
+	unsubscribe()
+
+	n.Notify("order-1")
+
+	require.Empty(t, ch)
+	require.Empty(t, n.subscribers)
+}
@@ -0,0 +1,91 @@
+package order
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+func (s *SuiteService) TestCreateOrderRejectsIncompleteShip() {
+	s.service.blueprint = model.ShipBlueprint{
+		Categories: []model.CategoryRule{
+			{Category: model.CategoryEngine, Min: 1, Max: 1},
+			{Category: model.CategoryWing, Min: 2},
+		},
+		Compatibility: []model.CompatibilityRule{
+			{Key: "fuel_type", Categories: []model.Category{model.CategoryEngine, model.CategoryFuel}},
+		},
+	}
+
+	engine := &model.Part{
+		Uuid:     gofakeit.UUID(),
+		Category: model.CategoryEngine,
+		Price:    money.New(1000, money.DefaultCurrency),
+		Metadata: map[string]*model.Value{"fuel_type": model.NewStringValue("hydrazine")},
+	}
+	fuel := &model.Part{
+		Uuid:     gofakeit.UUID(),
+		Category: model.CategoryFuel,
+		Price:    money.New(1000, money.DefaultCurrency),
+		Metadata: map[string]*model.Value{"fuel_type": model.NewStringValue("methane")},
+	}
+	wing := &model.Part{
+		Uuid:     gofakeit.UUID(),
+		Category: model.CategoryWing,
+		Price:    money.New(1000, money.DefaultCurrency),
+	}
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, mock.Anything).
+		Return([]*model.Part{engine, fuel, wing}, nil).
+		Once()
+
+	_, _, err := s.service.CreateOrder(s.ctx, gofakeit.UUID(), model.OrderRequest{Items: []model.OrderItem{
+		{PartUUID: engine.Uuid, Quantity: 2},
+		{PartUUID: fuel.Uuid, Quantity: 1},
+		{PartUUID: wing.Uuid, Quantity: 1},
+	}}, "")
+
+	violation := &model.BlueprintViolationError{}
+	s.Require().ErrorAs(err, &violation)
+	s.Require().Len(violation.Violations, 3)
+	s.Require().Contains(violation.Violations, "ENGINE: at most 1 allowed, got 2")
+	s.Require().Contains(violation.Violations, "WING: need at least 2, got 1")
+
+	s.inventoryClient.AssertNotCalled(s.T(), "ReserveParts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestCreateOrderCompleteShip() {
+	s.service.blueprint = model.DefaultShipBlueprint()
+
+	var (
+		parts []*model.Part
+		items []model.OrderItem
+	)
+	for _, category := range []model.Category{model.CategoryEngine, model.CategoryFuel, model.CategoryPorthole, model.CategoryWing} {
+		part := &model.Part{Uuid: gofakeit.UUID(), Category: category, Price: money.New(1000, money.DefaultCurrency)}
+		parts = append(parts, part)
+
+		quantity := int64(1)
+		if category == model.CategoryWing {
+			quantity = 2
+		}
+		items = append(items, model.OrderItem{PartUUID: part.Uuid, Quantity: quantity})
+	}
+
+	s.inventoryClient.On("ListParts", s.ctx, mock.Anything).Return(parts, nil).Once()
+	s.inventoryClient.
+		On("ReserveParts", s.ctx, mock.AnythingOfType("string"), mock.Anything, 30*time.Minute).
+		Return(gofakeit.UUID(), nil).
+		Once()
+	s.orderRepository.On("PutOrder", s.ctx, mock.AnythingOfType("string"), mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, price, err := s.service.CreateOrder(s.ctx, gofakeit.UUID(), model.OrderRequest{Items: items}, "")
+
+	s.Require().NoError(err)
+	s.Require().Equal(money.New(5000, money.DefaultCurrency), price.Total)
+}
@@ -10,7 +10,26 @@ import (
 	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
 )
 
-func (s *service) CancelOrder(ctx context.Context, orderUUID string) error {
+// CancelOrder повторяет отмену, если заказ успели изменить между чтением и записью:
+// каждый повтор перечитывает заказ и заново проверяет, можно ли его отменить.
+func (s *service) CancelOrder(ctx context.Context, userUUID, orderUUID string) error {
+	var refunding *model.Order
+	err := model.RetryOnVersionConflict(model.MaxVersionConflictRetries, func() error {
+		var err error
+		refunding, err = s.cancelOrder(ctx, userUUID, orderUUID)
+		return err
+	})
+	if err != nil || refunding == nil {
+		return err
+	}
+
+	// Деньги возвращаются только после того, как заказ сохранён в REFUNDING под проверкой версии
+	return s.refundOrder(ctx, refunding, userUUID)
+}
+
+// cancelOrder отменяет неоплаченный заказ. Оплаченный заказ переводится в REFUNDING и возвращается,
+// чтобы вернуть по нему оплату. Заказ, возврат которого прервался, возвращается как есть.
+func (s *service) cancelOrder(ctx context.Context, userUUID, orderUUID string) (*model.Order, error) {
 	logger.Info(ctx, "Cancelling order",
 		zap.String("order_uuid", orderUUID),
 	)
@@ -21,7 +40,11 @@ func (s *service) CancelOrder(ctx context.Context, orderUUID string) error {
 			zap.String("order_uuid", orderUUID),
 			zap.Error(err),
 		)
-		return model.NewOrderNotFoundError(orderUUID)
+		return nil, model.NewOrderNotFoundError(orderUUID)
+	}
+
+	if err = checkOwnership(ctx, order, userUUID); err != nil {
+		return nil, err
 	}
 
 	logger.Debug(ctx, "Order found, checking status",
@@ -29,34 +52,50 @@ func (s *service) CancelOrder(ctx context.Context, orderUUID string) error {
 		zap.String("current_status", string(order.Status)),
 	)
 
-	switch order.Status {
-	case model.OrderStatusPENDINGPAYMENT:
-		order.Status = model.OrderStatusCANCELLED
-		err = s.repository.UpdateOrder(ctx, order.OrderUUID, order)
-		if err != nil {
-			logger.Error(ctx, "Failed to update order status to cancelled",
-				zap.String("order_uuid", orderUUID),
-				zap.Error(err),
-			)
-			return fmt.Errorf("failed to update order status to cancelled: %w", err)
-		}
-		logger.Info(ctx, "Order cancelled successfully",
+	// Возврат идемпотентен по транзакции, поэтому прерванный возврат можно провести повторно
+	if order.Status == model.OrderStatusREFUNDING {
+		return order, nil
+	}
+
+	// Оплаченный, но ещё не собранный заказ отменяется через возврат оплаты
+	if order.Status == model.OrderStatusPAID {
+		return s.markRefunding(ctx, order, userUUID)
+	}
+
+	change, err := order.Cancel(userUUID, "cancelled by user")
+	if err != nil {
+		logger.Warn(ctx, "Cannot cancel order in current status",
 			zap.String("order_uuid", orderUUID),
-			zap.String("user_uuid", order.UserUUID),
+			zap.String("status", string(order.Status)),
 		)
-		return nil
-	case model.OrderStatusPAID:
-		logger.Warn(ctx, "Cannot cancel paid order",
+		return nil, err
+	}
+
+	cancelledEvent, err := model.NewOrderCancelledOutboxEvent(model.NewOrderCancelledEvent(order, change))
+	if err != nil {
+		logger.Error(ctx, "Failed to build order cancelled event",
 			zap.String("order_uuid", orderUUID),
-			zap.String("status", string(order.Status)),
+			zap.Error(err),
 		)
-		return model.NewConflictError("cannot cancel a paid order")
-	case model.OrderStatusCANCELLED:
-		logger.Warn(ctx, "Order already cancelled",
+		return nil, err
+	}
+
+	err = s.repository.UpdateOrderStatus(ctx, order, change, cancelledEvent)
+	if err != nil {
+		logger.Error(ctx, "Failed to update order status to cancelled",
 			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
 		)
-		return model.NewConflictError("cannot cancel a canceled order")
+		return nil, fmt.Errorf("failed to update order status to cancelled: %w", err)
 	}
 
-	return nil
+	s.statusNotifier.Notify(order.OrderUUID)
+	s.releaseReservation(ctx, order)
+
+	logger.Info(ctx, "Order cancelled successfully",
+		zap.String("order_uuid", orderUUID),
+		zap.String("user_uuid", order.UserUUID),
+	)
+
+	return nil, nil
 }
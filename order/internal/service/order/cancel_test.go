@@ -1,8 +1,12 @@
 package order
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 
+	"github.com/brianvoe/gofakeit/v7"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/ZanDattSu/star-factory/order/internal/model"
@@ -11,21 +15,39 @@ import (
 func (s *SuiteService) TestCancelOrderSuccess() {
 	order := RandomOrder()
 	order.Status = model.OrderStatusPENDINGPAYMENT
+	reservationUUID := gofakeit.UUID()
+	order.ReservationUUID = &reservationUUID
 
 	s.orderRepository.
 		On("GetOrder", s.ctx, order.OrderUUID).
 		Return(order, nil).Once()
 
+	s.inventoryClient.
+		On("ReleaseReservation", s.ctx, reservationUUID).
+		Return(nil).Once()
+
 	s.orderRepository.
-		On("UpdateOrder",
+		On("UpdateOrderStatus",
 			s.ctx,
-			order.OrderUUID,
 			mock.MatchedBy(func(o *model.Order) bool {
 				return o.Status == model.OrderStatusCANCELLED
 			}),
+			mock.MatchedBy(func(c model.OrderStatusChange) bool {
+				return c.FromStatus == model.OrderStatusPENDINGPAYMENT &&
+					c.ToStatus == model.OrderStatusCANCELLED &&
+					c.Actor == order.UserUUID
+			}),
+			mock.MatchedBy(func(e model.OutboxEvent) bool {
+				var event model.OrderCancelledEvent
+				return e.EventType == model.OutboxEventOrderCancelled &&
+					e.Key == order.OrderUUID &&
+					json.Unmarshal(e.Payload, &event) == nil &&
+					event.PreviousStatus == model.OrderStatusPENDINGPAYMENT &&
+					event.Actor == order.UserUUID
+			}),
 		).Return(nil).Once()
 
-	err := s.service.CancelOrder(s.ctx, order.OrderUUID)
+	err := s.service.CancelOrder(s.ctx, order.UserUUID, order.OrderUUID)
 	s.Require().NoError(err)
 }
 
@@ -37,7 +59,7 @@ func (s *SuiteService) TestCancelOrderNotFound() {
 		Return(nil, &model.OrderNotFoundError{}).
 		Once()
 
-	err := s.service.CancelOrder(s.ctx, order.OrderUUID)
+	err := s.service.CancelOrder(s.ctx, order.UserUUID, order.OrderUUID)
 
 	s.Require().Error(err)
 
@@ -48,23 +70,218 @@ func (s *SuiteService) TestCancelOrderNotFound() {
 	s.Require().Contains(err.Error(), fmt.Sprintf("order with UUID %q not found", order.OrderUUID))
 }
 
-func (s *SuiteService) TestCancelOrderConflictPaid() {
+func (s *SuiteService) TestCancelOrderPaidRefunds() {
+	order := RandomOrder()
+	order.Status = model.OrderStatusPAID
+	transactionUUID := gofakeit.UUID()
+	order.TransactionUUID = &transactionUUID
+	reservationUUID := gofakeit.UUID()
+	order.ReservationUUID = &reservationUUID
+	refundUUID := gofakeit.UUID()
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).
+		Once()
+
+	// REFUNDING сохраняется до возврата денег
+	s.orderRepository.
+		On("UpdateOrderStatus",
+			s.ctx,
+			mock.MatchedBy(func(o *model.Order) bool {
+				return o.Status == model.OrderStatusREFUNDING
+			}),
+			mock.MatchedBy(func(c model.OrderStatusChange) bool {
+				return c.FromStatus == model.OrderStatusPAID &&
+					c.ToStatus == model.OrderStatusREFUNDING &&
+					c.Actor == order.UserUUID
+			}),
+			mock.MatchedBy(func(e model.OutboxEvent) bool {
+				var event model.OrderCancelledEvent
+				return e.EventType == model.OutboxEventOrderCancelled &&
+					json.Unmarshal(e.Payload, &event) == nil &&
+					event.PreviousStatus == model.OrderStatusPAID
+			}),
+		).
+		Run(func(mock.Arguments) {
+			s.paymentClient.AssertNotCalled(s.T(), "RefundPayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		}).
+		Return(nil).Once()
+
+	s.paymentClient.
+		On("RefundPayment", s.ctx, order.OrderUUID, order.UserUUID, transactionUUID, order.TotalPrice).
+		Return(refundUUID, nil).
+		Once()
+
+	s.orderRepository.
+		On("UpdateOrderStatus",
+			s.ctx,
+			mock.MatchedBy(func(o *model.Order) bool {
+				return o.Status == model.OrderStatusREFUNDED
+			}),
+			mock.MatchedBy(func(c model.OrderStatusChange) bool {
+				return c.FromStatus == model.OrderStatusREFUNDING &&
+					c.ToStatus == model.OrderStatusREFUNDED &&
+					c.Actor == order.UserUUID
+			}),
+			mock.MatchedBy(func(e model.OutboxEvent) bool {
+				var event model.OrderRefundedEvent
+				return e.EventType == model.OutboxEventOrderRefunded &&
+					json.Unmarshal(e.Payload, &event) == nil &&
+					event.RefundUuid == refundUUID &&
+					event.TransactionUuid == transactionUUID &&
+					event.Amount == order.TotalPrice
+			}),
+		).Return(nil).Once()
+
+	// Детали подтверждённого резерва возвращаются на склад
+	s.inventoryClient.
+		On("ReleaseReservation", s.ctx, reservationUUID).
+		Return(nil).Once()
+
+	err := s.service.CancelOrder(s.ctx, order.UserUUID, order.OrderUUID)
+	s.Require().NoError(err)
+}
+
+func (s *SuiteService) TestCancelOrderPaidRefundFailed() {
+	order := RandomOrder()
+	order.Status = model.OrderStatusPAID
+	transactionUUID := gofakeit.UUID()
+	order.TransactionUUID = &transactionUUID
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).
+		Once()
+
+	s.orderRepository.
+		On("UpdateOrderStatus", s.ctx, order, mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	s.paymentClient.
+		On("RefundPayment", s.ctx, order.OrderUUID, order.UserUUID, transactionUUID, order.TotalPrice).
+		Return("", errors.New("payment service unavailable")).
+		Once()
+
+	err := s.service.CancelOrder(s.ctx, order.UserUUID, order.OrderUUID)
+
+	// Заказ остаётся в REFUNDING, чтобы его не собрали, а возврат можно было провести повторно
+	s.Require().Error(err)
+	s.Require().Equal(model.OrderStatusREFUNDING, order.Status)
+	s.inventoryClient.AssertNotCalled(s.T(), "ReleaseReservation", mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestCancelOrderRefundingResumesRefund() {
+	order := RandomOrder()
+	order.Status = model.OrderStatusREFUNDING
+	transactionUUID := gofakeit.UUID()
+	order.TransactionUUID = &transactionUUID
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).
+		Once()
+
+	s.paymentClient.
+		On("RefundPayment", s.ctx, order.OrderUUID, order.UserUUID, transactionUUID, order.TotalPrice).
+		Return(gofakeit.UUID(), nil).
+		Once()
+
+	s.orderRepository.
+		On("UpdateOrderStatus", s.ctx, order,
+			mock.MatchedBy(func(c model.OrderStatusChange) bool { return c.ToStatus == model.OrderStatusREFUNDED }),
+			mock.Anything,
+		).
+		Return(nil).
+		Once()
+
+	err := s.service.CancelOrder(s.ctx, order.UserUUID, order.OrderUUID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.OrderStatusREFUNDED, order.Status)
+}
+
+func (s *SuiteService) TestCancelOrderPaidConcurrentCancelRefundsOnce() {
 	order := RandomOrder()
 	order.Status = model.OrderStatusPAID
+	order.Version = 1
+	transactionUUID := gofakeit.UUID()
+	order.TransactionUUID = &transactionUUID
+
+	// Параллельная отмена успела перевести заказ в REFUNDING и завершить возврат
+	stale, fresh := *order, *order
+	fresh.Status = model.OrderStatusREFUNDED
+	fresh.Version = 3
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(&stale, nil).Once()
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(&fresh, nil).Once()
+
+	s.orderRepository.
+		On("UpdateOrderStatus", s.ctx, &stale, mock.Anything, mock.Anything).
+		Return(model.NewOrderVersionConflictError(order.OrderUUID, 1, 3)).Once()
+
+	err := s.service.CancelOrder(s.ctx, order.UserUUID, order.OrderUUID)
+
+	var conflict *model.ConflictError
+	s.Require().ErrorAs(err, &conflict)
+	s.Require().Contains(conflict.Error(), "cannot cancel a refunded order")
+	s.paymentClient.AssertNotCalled(s.T(), "RefundPayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestCancelOrderRefundingFinishedConcurrently() {
+	order := RandomOrder()
+	order.Status = model.OrderStatusREFUNDING
+	order.Version = 2
+	transactionUUID := gofakeit.UUID()
+	order.TransactionUUID = &transactionUUID
+
+	// Пока возврат проводился, параллельная отмена уже перевела заказ в REFUNDED
+	stale, fresh := *order, *order
+	fresh.Status = model.OrderStatusREFUNDED
+	fresh.Version = 3
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(&stale, nil).Once()
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(&fresh, nil).Once()
+
+	s.paymentClient.
+		On("RefundPayment", s.ctx, order.OrderUUID, order.UserUUID, transactionUUID, order.TotalPrice).
+		Return(gofakeit.UUID(), nil).
+		Once()
+
+	s.orderRepository.
+		On("UpdateOrderStatus", s.ctx, &stale, mock.Anything, mock.Anything).
+		Return(model.NewOrderVersionConflictError(order.OrderUUID, 2, 3)).Once()
+
+	err := s.service.CancelOrder(s.ctx, order.UserUUID, order.OrderUUID)
+	s.Require().NoError(err)
+}
+
+func (s *SuiteService) TestCancelOrderConflictAssembled() {
+	order := RandomOrder()
+	order.Status = model.OrderStatusASSEMBLED
 
 	s.orderRepository.
 		On("GetOrder", s.ctx, order.OrderUUID).
 		Return(order, nil).
 		Once()
 
-	err := s.service.CancelOrder(s.ctx, order.OrderUUID)
+	err := s.service.CancelOrder(s.ctx, order.UserUUID, order.OrderUUID)
 
 	s.Require().Error(err)
 
 	var conflict *model.ConflictError
 	s.Require().ErrorAs(err, &conflict)
 	s.Require().Equal(409, conflict.Code)
-	s.Require().Contains(conflict.Error(), "cannot cancel a paid order")
+	s.Require().Contains(conflict.Error(), "cannot cancel an assembled order")
+	s.paymentClient.AssertNotCalled(s.T(), "RefundPayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (s *SuiteService) TestCancelOrderConflictAlreadyCancelled() {
@@ -76,7 +293,7 @@ func (s *SuiteService) TestCancelOrderConflictAlreadyCancelled() {
 		Return(order, nil).
 		Once()
 
-	err := s.service.CancelOrder(s.ctx, order.OrderUUID)
+	err := s.service.CancelOrder(s.ctx, order.UserUUID, order.OrderUUID)
 
 	s.Require().Error(err)
 
@@ -85,3 +302,67 @@ func (s *SuiteService) TestCancelOrderConflictAlreadyCancelled() {
 	s.Require().Equal(409, conflict.Code)
 	s.Require().Contains(conflict.Error(), "cannot cancel a canceled order")
 }
+
+func (s *SuiteService) TestCancelOrderForbidden() {
+	order := RandomOrder()
+	order.Status = model.OrderStatusPENDINGPAYMENT
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).
+		Once()
+
+	err := s.service.CancelOrder(s.ctx, gofakeit.UUID(), order.OrderUUID)
+
+	var forbidden *model.ForbiddenError
+	s.Require().ErrorAs(err, &forbidden)
+	s.orderRepository.AssertNotCalled(s.T(), "UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestCancelOrderRetriesOnVersionConflict() {
+	order := RandomOrder()
+	order.Status = model.OrderStatusPENDINGPAYMENT
+	order.Version = 1
+
+	// Первое чтение видит версию 1, но до записи заказ успели изменить
+	stale, fresh := *order, *order
+	fresh.Version = 2
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(&stale, nil).Once()
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(&fresh, nil).Once()
+
+	s.orderRepository.
+		On("UpdateOrderStatus", s.ctx, mock.MatchedBy(func(o *model.Order) bool { return o.Version == 1 }), mock.Anything, mock.Anything).
+		Return(model.NewOrderVersionConflictError(order.OrderUUID, 1, 2)).Once()
+	s.orderRepository.
+		On("UpdateOrderStatus", s.ctx, mock.MatchedBy(func(o *model.Order) bool { return o.Version == 2 }), mock.Anything, mock.Anything).
+		Return(nil).Once()
+
+	err := s.service.CancelOrder(s.ctx, order.UserUUID, order.OrderUUID)
+	s.Require().NoError(err)
+}
+
+func (s *SuiteService) TestCancelOrderGivesUpAfterVersionConflicts() {
+	order := RandomOrder()
+	order.Status = model.OrderStatusPENDINGPAYMENT
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(func(_ context.Context, _ string) (*model.Order, error) {
+			copied := *order
+			return &copied, nil
+		}).Times(model.MaxVersionConflictRetries)
+
+	s.orderRepository.
+		On("UpdateOrderStatus", s.ctx, mock.Anything, mock.Anything, mock.Anything).
+		Return(model.NewOrderVersionConflictError(order.OrderUUID, 0, 1)).Times(model.MaxVersionConflictRetries)
+
+	err := s.service.CancelOrder(s.ctx, order.UserUUID, order.OrderUUID)
+
+	var conflict *model.OrderVersionConflictError
+	s.Require().ErrorAs(err, &conflict)
+}
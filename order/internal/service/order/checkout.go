@@ -0,0 +1,78 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+// checkoutRequest параметры оформления корзины, по которым считается fingerprint для Idempotency-Key.
+// Состав корзины в fingerprint не входит: после оформления корзина очищается,
+// и повтор запроса должен вернуть уже созданный заказ.
+type checkoutRequest struct {
+	PromoCode  string `json:"promo_code,omitempty"`
+	QuoteToken string `json:"quote_token,omitempty"`
+}
+
+func (s *service) CheckoutCart(ctx context.Context, userUUID, promoCode, quoteToken, idempotencyKey string) (string, model.OrderPrice, error) {
+	req := idempotentRequest{
+		userUUID:  userUUID,
+		operation: model.IdempotentCheckout,
+		key:       idempotencyKey,
+		body: checkoutRequest{
+			PromoCode:  promoCode,
+			QuoteToken: quoteToken,
+		},
+	}
+
+	res, err := withIdempotency(ctx, s, req, func() (createOrderResult, error) {
+		orderUUID, price, err := s.checkoutCart(ctx, userUUID, promoCode, quoteToken)
+		return newCreateOrderResult(orderUUID, price), err
+	})
+	if err != nil {
+		return "", model.OrderPrice{}, err
+	}
+
+	return res.OrderUUID, res.price(), nil
+}
+
+func (s *service) checkoutCart(ctx context.Context, userUUID, promoCode, quoteToken string) (string, model.OrderPrice, error) {
+	cart, err := s.cartRepository.GetCart(ctx, userUUID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get cart for checkout",
+			zap.String("user_uuid", userUUID),
+			zap.Error(err),
+		)
+		return "", model.OrderPrice{}, fmt.Errorf("failed to get cart: %w", err)
+	}
+
+	if cart.IsEmpty() {
+		return "", model.OrderPrice{}, model.NewBadRequestError("cart is empty")
+	}
+
+	// С токеном расчёта корзина должна совпадать с рассчитанными позициями, это проверит quotedOrder
+	orderUUID, price, err := s.createOrder(ctx, userUUID, model.OrderRequest{
+		Items:      cart.Items,
+		PromoCode:  promoCode,
+		QuoteToken: quoteToken,
+	})
+	if err != nil {
+		return "", model.OrderPrice{}, err
+	}
+
+	// Убираем только оформленное количество: добавленное после чтения корзины остаётся в ней.
+	// Заказ уже оформлен, поэтому ошибку очистки корзины только логируем
+	if err = s.cartRepository.RemoveItems(ctx, userUUID, cart.Items); err != nil {
+		logger.Error(ctx, "Failed to clear cart after checkout",
+			zap.String("user_uuid", userUUID),
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+	}
+
+	return orderUUID, price, nil
+}
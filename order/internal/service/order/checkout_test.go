@@ -0,0 +1,99 @@
+package order
+
+import (
+	"time"
+
+	"encoding/json"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+func (s *SuiteService) TestCheckoutCartSuccess() {
+	userUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+	price := money.New(2500, money.DefaultCurrency)
+
+	s.cartRepository.
+		On("GetCart", s.ctx, userUUID).
+		Return(&model.Cart{UserUUID: userUUID, Items: []model.OrderItem{{PartUUID: partUUID, Quantity: 2}}}, nil).
+		Once()
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, model.PartsFilter{Uuids: []string{partUUID}}).
+		Return([]*model.Part{{Uuid: partUUID, Name: gofakeit.ProductName(), Price: price}}, nil).
+		Once()
+
+	s.inventoryClient.
+		On("ReserveParts", s.ctx, mock.AnythingOfType("string"), []model.ReservationItem{{PartUUID: partUUID, Quantity: 2}}, 30*time.Minute).
+		Return(gofakeit.UUID(), nil).
+		Once()
+
+	s.orderRepository.
+		On("PutOrder", s.ctx, mock.AnythingOfType("string"), mock.MatchedBy(func(order *model.Order) bool {
+			return order.UserUUID == userUUID && order.Items[0].Quantity == 2
+		}), mock.Anything).
+		Return(nil).
+		Once()
+
+	// Из корзины вычитается оформленное количество, а не удаляется вся позиция
+	s.cartRepository.
+		On("RemoveItems", s.ctx, userUUID, mock.MatchedBy(func(items []model.OrderItem) bool {
+			return len(items) == 1 && items[0].PartUUID == partUUID && items[0].Quantity == 2
+		})).
+		Return(nil).
+		Once()
+
+	orderUUID, orderPrice, err := s.service.CheckoutCart(s.ctx, userUUID, "", "", "")
+
+	s.Require().NoError(err)
+	s.Require().NotEmpty(orderUUID)
+	s.Require().Equal(money.New(5000, money.DefaultCurrency), orderPrice.Total)
+}
+
+func (s *SuiteService) TestCheckoutCartEmpty() {
+	userUUID := gofakeit.UUID()
+
+	s.cartRepository.On("GetCart", s.ctx, userUUID).Return(&model.Cart{UserUUID: userUUID}, nil).Once()
+
+	_, _, err := s.service.CheckoutCart(s.ctx, userUUID, "", "", "")
+
+	badRequest := &model.BadRequestError{}
+	s.Require().ErrorAs(err, &badRequest)
+
+	s.cartRepository.AssertNotCalled(s.T(), "RemoveItems", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Повтор после очистки корзины возвращает уже созданный заказ, а не ошибку пустой корзины
+func (s *SuiteService) TestCheckoutCartIdempotentReplay() {
+	userUUID := gofakeit.UUID()
+	key := gofakeit.UUID()
+
+	fingerprint, err := requestFingerprint(checkoutRequest{})
+	s.Require().NoError(err)
+
+	stored, err := json.Marshal(newCreateOrderResult(gofakeit.UUID(), model.OrderPrice{
+		Subtotal: money.New(5000, money.DefaultCurrency),
+		Discount: money.Zero(money.DefaultCurrency),
+		Total:    money.New(5000, money.DefaultCurrency),
+	}))
+	s.Require().NoError(err)
+
+	s.idempotencyRepository.
+		On("Reserve", s.ctx, mock.MatchedBy(func(r model.IdempotencyRecord) bool {
+			return r.Operation == model.IdempotentCheckout && r.Key == key
+		})).
+		Return(&model.IdempotencyRecord{Fingerprint: fingerprint, Response: stored}, false, nil).
+		Once()
+
+	orderUUID, price, err := s.service.CheckoutCart(s.ctx, userUUID, "", "", key)
+
+	s.Require().NoError(err)
+	s.Require().NotEmpty(orderUUID)
+	s.Require().Equal(money.New(5000, money.DefaultCurrency), price.Total)
+
+	s.cartRepository.AssertNotCalled(s.T(), "GetCart", mock.Anything, mock.Anything)
+}
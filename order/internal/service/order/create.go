@@ -2,95 +2,151 @@ package order
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
-	inventoryV1 "github.com/ZanDattSu/star-factory/order/internal/client/grpc/inventory/v1"
 	"github.com/ZanDattSu/star-factory/order/internal/model"
 	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 )
 
-var partsNotFound = "one or more parts not found"
+// createOrderResult ответ CreateOrder, сохраняемый для повторов с Idempotency-Key.
+type createOrderResult struct {
+	OrderUUID  string      `json:"order_uuid"`
+	Subtotal   money.Money `json:"subtotal"`
+	Discount   money.Money `json:"discount"`
+	TotalPrice money.Money `json:"total_price"`
+}
+
+func newCreateOrderResult(orderUUID string, price model.OrderPrice) createOrderResult {
+	return createOrderResult{
+		OrderUUID:  orderUUID,
+		Subtotal:   price.Subtotal,
+		Discount:   price.Discount,
+		TotalPrice: price.Total,
+	}
+}
+
+func (r createOrderResult) price() model.OrderPrice {
+	return model.OrderPrice{
+		Subtotal: r.Subtotal,
+		Discount: r.Discount,
+		Total:    r.TotalPrice,
+	}
+}
+
+func (s *service) CreateOrder(
+	ctx context.Context,
+	userUUID string,
+	orderReq model.OrderRequest,
+	idempotencyKey string,
+) (string, model.OrderPrice, error) {
+	orderReq.Items = model.MergeOrderItems(orderReq.Items)
 
-func (s *service) CreateOrder(ctx context.Context, userUUID string, partUuids []string) (string, float64, error) {
+	// Fingerprint для Idempotency-Key считается по составу заказа
+	req := idempotentRequest{
+		userUUID:  userUUID,
+		operation: model.IdempotentCreateOrder,
+		key:       idempotencyKey,
+		body:      orderReq,
+	}
+
+	res, err := withIdempotency(ctx, s, req, func() (createOrderResult, error) {
+		orderUUID, price, err := s.createOrder(ctx, userUUID, orderReq)
+		return newCreateOrderResult(orderUUID, price), err
+	})
+	if err != nil {
+		return "", model.OrderPrice{}, err
+	}
+
+	return res.OrderUUID, res.price(), nil
+}
+
+func (s *service) createOrder(ctx context.Context, userUUID string, orderReq model.OrderRequest) (string, model.OrderPrice, error) {
 	logger.Info(ctx, "Creating new order",
 		zap.String("user_uuid", userUUID),
-		zap.Int("parts_count", len(partUuids)),
+		zap.Int("items_count", len(orderReq.Items)),
+		zap.Bool("quoted", orderReq.QuoteToken != ""),
 	)
 
-	if len(partUuids) == 0 {
-		logger.Warn(ctx, "Failed to create order: empty parts list",
-			zap.String("user_uuid", userUUID),
-		)
-		return "", 0, fmt.Errorf("%s: empty parts list", partsNotFound)
-	}
-
-	parts, err := s.inventoryClient.ListParts(
-		ctx,
-		model.PartsFilter{
-			Uuids: partUuids,
-		},
+	var (
+		priced *pricedOrder
+		err    error
 	)
+	if orderReq.QuoteToken != "" {
+		priced, err = s.quotedOrder(ctx, userUUID, orderReq)
+	} else {
+		priced, err = s.priceOrder(ctx, userUUID, orderReq.Items, orderReq.PromoCode)
+	}
 	if err != nil {
-		notFound := &inventoryV1.PartsNotFoundError{}
-		if errors.As(err, &notFound) {
-			logger.Error(ctx, "Failed to create order: parts not found",
-				zap.String("user_uuid", userUUID),
-				zap.Strings("part_uuids", partUuids),
-				zap.Error(err),
-			)
-			return "", 0, fmt.Errorf("%s: %w", partsNotFound, err)
-		}
-		logger.Error(ctx, "Failed to get parts from inventory",
+		logger.Warn(ctx, "Failed to create order: items could not be priced",
 			zap.String("user_uuid", userUUID),
-			zap.Strings("part_uuids", partUuids),
 			zap.Error(err),
 		)
-		return "", 0, err
+		return "", model.OrderPrice{}, err
 	}
 
-	if len(parts) != len(partUuids) {
-		logger.Error(ctx, "Failed to create order: parts count mismatch",
+	orderUUID := uuid.New().String()
+
+	reservationUUID, err := s.inventoryClient.ReserveParts(ctx, orderUUID, model.ReservationItemsFromOrderItems(priced.Items), s.reservationTTL)
+	if err != nil {
+		logger.Error(ctx, "Failed to reserve parts for order",
+			zap.String("order_uuid", orderUUID),
 			zap.String("user_uuid", userUUID),
-			zap.Int("requested_parts", len(partUuids)),
-			zap.Int("found_parts", len(parts)),
+			zap.Error(err),
 		)
-		return "", 0, fmt.Errorf("%s: %w", partsNotFound, err)
+		return "", model.OrderPrice{}, err
 	}
 
-	var totalPrice float64
-	for _, part := range parts {
-		totalPrice += part.Price
+	newOrder := &model.Order{
+		OrderUUID:       orderUUID,
+		UserUUID:        userUUID,
+		PartUuids:       priced.PartUuids,
+		Items:           priced.Items,
+		Subtotal:        priced.Subtotal,
+		Discount:        priced.Discount,
+		TotalPrice:      priced.Total,
+		PromoCode:       priced.PromoCode,
+		ReservationUUID: &reservationUUID,
+		QuoteToken:      priced.QuoteToken,
+		Status:          model.OrderStatusPENDINGPAYMENT,
+		Version:         1,
+		CreatedAt:       time.Now().UTC(),
 	}
 
-	orderUUID := uuid.New().String()
-	newOrder := &model.Order{
-		OrderUUID:  orderUUID,
-		UserUUID:   userUUID,
-		PartUuids:  partUuids,
-		TotalPrice: totalPrice,
-		Status:     model.OrderStatusPENDINGPAYMENT,
+	createdEvent, err := model.NewOrderCreatedOutboxEvent(model.NewOrderCreatedEvent(newOrder))
+	if err != nil {
+		logger.Error(ctx, "Failed to build order created event",
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+		s.releaseReservation(ctx, newOrder)
+		return "", model.OrderPrice{}, err
 	}
 
-	err = s.repository.PutOrder(ctx, orderUUID, newOrder)
+	err = s.repository.PutOrder(ctx, orderUUID, newOrder, createdEvent)
 	if err != nil {
 		logger.Error(ctx, "Failed to save order to repository",
 			zap.String("order_uuid", orderUUID),
 			zap.String("user_uuid", userUUID),
 			zap.Error(err),
 		)
-		return "", 0, fmt.Errorf("failed to put order in repository: %w", err)
+		// Заказ не сохранён — резерв никому не нужен, возвращаем остатки сразу, не дожидаясь TTL
+		s.releaseReservation(ctx, newOrder)
+		return "", model.OrderPrice{}, fmt.Errorf("failed to put order in repository: %w", err)
 	}
 
 	logger.Info(ctx, "Order created successfully",
 		zap.String("order_uuid", orderUUID),
 		zap.String("user_uuid", userUUID),
-		zap.Float64("total_price", totalPrice),
-		zap.Int("parts_count", len(partUuids)),
+		zap.Stringer("subtotal", priced.Subtotal),
+		zap.Stringer("discount", priced.Discount),
+		zap.Stringer("total_price", priced.Total),
+		zap.Int("items_count", len(priced.Items)),
 	)
 
-	return orderUUID, totalPrice, nil
+	return orderUUID, newOrder.Price(), nil
 }
@@ -1,6 +1,10 @@
 package order
 
 import (
+	"time"
+
+	"encoding/json"
+	"errors"
 	"slices"
 
 	"github.com/brianvoe/gofakeit/v7"
@@ -10,6 +14,7 @@ import (
 
 	inventoryV1 "github.com/ZanDattSu/star-factory/order/internal/client/grpc/inventory/v1"
 	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 )
 
 func (s *SuiteService) TestCreateOrderSuccess() {
@@ -17,14 +22,30 @@ func (s *SuiteService) TestCreateOrderSuccess() {
 	partUuids := []string{gofakeit.UUID(), gofakeit.UUID(), gofakeit.UUID()}
 
 	listParts := []*model.Part{
-		{Uuid: partUuids[0], Price: gofakeit.Price(50, 150)},
-		{Uuid: partUuids[1], Price: gofakeit.Price(50, 150)},
-		{Uuid: partUuids[2], Price: gofakeit.Price(50, 150)},
+		{Uuid: partUuids[0], Name: gofakeit.ProductName(), Price: money.FromMajor(gofakeit.Price(50, 150), money.DefaultCurrency)},
+		{Uuid: partUuids[1], Name: gofakeit.ProductName(), Price: money.FromMajor(gofakeit.Price(50, 150), money.DefaultCurrency)},
+		{Uuid: partUuids[2], Name: gofakeit.ProductName(), Price: money.FromMajor(gofakeit.Price(50, 150), money.DefaultCurrency)},
+	}
+
+	// Первая деталь встречается дважды и должна схлопнуться в одну позицию
+	items := []model.OrderItem{
+		{PartUUID: partUuids[0], Quantity: 3},
+		{PartUUID: partUuids[1], Quantity: 1},
+		{PartUUID: partUuids[2], Quantity: 2},
+		{PartUUID: partUuids[0], Quantity: 1},
 	}
 
-	var expectedTotalPrice float64
-	for _, part := range listParts {
-		expectedTotalPrice += part.Price
+	expectedItems := []model.OrderItem{
+		{PartUUID: partUuids[0], PartName: listParts[0].Name, Quantity: 4, UnitPrice: listParts[0].Price},
+		{PartUUID: partUuids[1], PartName: listParts[1].Name, Quantity: 1, UnitPrice: listParts[1].Price},
+		{PartUUID: partUuids[2], PartName: listParts[2].Name, Quantity: 2, UnitPrice: listParts[2].Price},
+	}
+
+	expectedTotalPrice := money.Zero(money.DefaultCurrency)
+	for _, item := range expectedItems {
+		var err error
+		expectedTotalPrice, err = expectedTotalPrice.Add(item.Total())
+		s.Require().NoError(err)
 	}
 
 	s.inventoryClient.
@@ -34,32 +55,69 @@ func (s *SuiteService) TestCreateOrderSuccess() {
 		Return(listParts, nil).
 		Once()
 
+	reservationUUID := gofakeit.UUID()
+	s.inventoryClient.
+		On("ReserveParts", s.ctx, mock.AnythingOfType("string"), []model.ReservationItem{
+			{PartUUID: partUuids[0], Quantity: 4},
+			{PartUUID: partUuids[1], Quantity: 1},
+			{PartUUID: partUuids[2], Quantity: 2},
+		}, 30*time.Minute).
+		Return(reservationUUID, nil).
+		Once()
+
 	s.orderRepository.
 		On("PutOrder", s.ctx, mock.AnythingOfType("string"), mock.MatchedBy(func(order *model.Order) bool {
 			return order.UserUUID == userUUID &&
 				slices.Equal(order.PartUuids, partUuids) &&
+				slices.Equal(order.Items, expectedItems) &&
+				order.Subtotal == expectedTotalPrice &&
+				order.Discount.IsZero() &&
 				order.TotalPrice == expectedTotalPrice &&
-				order.Status == model.OrderStatusPENDINGPAYMENT
+				order.PromoCode == nil &&
+				order.Status == model.OrderStatusPENDINGPAYMENT &&
+				order.ReservationUUID != nil && *order.ReservationUUID == reservationUUID
+		}), mock.MatchedBy(func(e model.OutboxEvent) bool {
+			var event model.OrderCreatedEvent
+			return e.EventType == model.OutboxEventOrderCreated &&
+				json.Unmarshal(e.Payload, &event) == nil &&
+				event.UserUuid == userUUID &&
+				e.Key == event.OrderUuid &&
+				event.TotalPrice == expectedTotalPrice &&
+				slices.Equal(event.Items, expectedItems)
 		})).
 		Return(nil).
 		Once()
 
-	orderUUID, totalPrice, err := s.service.CreateOrder(s.ctx, userUUID, partUuids)
+	orderUUID, price, err := s.service.CreateOrder(s.ctx, userUUID, model.OrderRequest{Items: items}, "")
 
 	s.Require().NoError(err)
 	s.Require().NotEmpty(orderUUID)
-	s.Require().Equal(expectedTotalPrice, totalPrice)
+	s.Require().Equal(model.OrderPrice{
+		Subtotal: expectedTotalPrice,
+		Discount: money.Zero(money.DefaultCurrency),
+		Total:    expectedTotalPrice,
+	}, price)
+}
+
+func (s *SuiteService) TestCreateOrderNonPositiveQuantity() {
+	items := []model.OrderItem{{PartUUID: gofakeit.UUID(), Quantity: 0}}
+
+	_, _, err := s.service.CreateOrder(s.ctx, gofakeit.UUID(), model.OrderRequest{Items: items}, "")
+
+	var badRequest *model.BadRequestError
+	s.Require().ErrorAs(err, &badRequest)
+	s.inventoryClient.AssertNotCalled(s.T(), "ListParts", mock.Anything, mock.Anything)
 }
 
 func (s *SuiteService) TestCreateOrderEmptyParts() {
 	userUUID := gofakeit.UUID()
 	var partUuids []string
 
-	orderUUID, totalPrice, err := s.service.CreateOrder(s.ctx, userUUID, partUuids)
+	orderUUID, price, err := s.service.CreateOrder(s.ctx, userUUID, model.OrderRequest{Items: model.OrderItemsFromParts(partUuids)}, "")
 
 	s.Require().Error(err)
 	s.Require().Empty(orderUUID)
-	s.Require().Zero(totalPrice)
+	s.Require().Zero(price)
 	s.Require().Contains(err.Error(), "one or more parts not found")
 	s.Require().Contains(err.Error(), "empty parts list")
 
@@ -72,8 +130,8 @@ func (s *SuiteService) TestCreateOrderPartsLengthMismatch() {
 	partUuids := []string{gofakeit.UUID(), gofakeit.UUID(), gofakeit.UUID()}
 
 	parts := []*model.Part{
-		{Uuid: partUuids[0], Price: 100.0},
-		{Uuid: partUuids[1], Price: 200.0},
+		{Uuid: partUuids[0], Price: money.New(10000, money.DefaultCurrency)},
+		{Uuid: partUuids[1], Price: money.New(20000, money.DefaultCurrency)},
 	}
 
 	s.inventoryClient.
@@ -81,11 +139,11 @@ func (s *SuiteService) TestCreateOrderPartsLengthMismatch() {
 		Return(parts, nil).
 		Once()
 
-	orderUUID, totalPrice, err := s.service.CreateOrder(s.ctx, userUUID, partUuids)
+	orderUUID, price, err := s.service.CreateOrder(s.ctx, userUUID, model.OrderRequest{Items: model.OrderItemsFromParts(partUuids)}, "")
 
 	s.Require().Error(err)
 	s.Require().Empty(orderUUID)
-	s.Require().Zero(totalPrice)
+	s.Require().Zero(price)
 	s.Require().Contains(err.Error(), "one or more parts not found")
 
 	s.orderRepository.AssertNotCalled(s.T(), "PutOrder", mock.Anything, mock.Anything, mock.Anything)
@@ -104,11 +162,11 @@ func (s *SuiteService) TestCreateOrderInventoryPartsNotFoundError() {
 		Return(nil, expectedErr).
 		Once()
 
-	orderUUID, totalPrice, err := s.service.CreateOrder(s.ctx, userUUID, partUuids)
+	orderUUID, price, err := s.service.CreateOrder(s.ctx, userUUID, model.OrderRequest{Items: model.OrderItemsFromParts(partUuids)}, "")
 
 	s.Require().Error(err)
 	s.Require().Empty(orderUUID)
-	s.Require().Zero(totalPrice)
+	s.Require().Zero(price)
 	s.Require().Contains(err.Error(), "one or more parts not found")
 	s.Require().Contains(err.Error(), partUuids[1])
 
@@ -128,11 +186,11 @@ func (s *SuiteService) TestCreateOrderInventoryGenericError() {
 		Return(nil, expectedErr).
 		Once()
 
-	orderUUID, totalPrice, err := s.service.CreateOrder(s.ctx, userUUID, partUuids)
+	orderUUID, price, err := s.service.CreateOrder(s.ctx, userUUID, model.OrderRequest{Items: model.OrderItemsFromParts(partUuids)}, "")
 
 	s.Require().Error(err)
 	s.Require().Empty(orderUUID)
-	s.Require().Zero(totalPrice)
+	s.Require().Zero(price)
 
 	s.Require().Contains(err.Error(), "inventory")
 	s.Require().Contains(err.Error(), "unavailable")
@@ -140,3 +198,62 @@ func (s *SuiteService) TestCreateOrderInventoryGenericError() {
 	s.orderRepository.AssertNotCalled(s.T(), "PutOrder", mock.Anything, mock.Anything, mock.Anything)
 	s.inventoryClient.AssertNumberOfCalls(s.T(), "ListParts", 1)
 }
+
+func (s *SuiteService) TestCreateOrderInsufficientStock() {
+	userUUID := gofakeit.UUID()
+	partUuids := []string{gofakeit.UUID(), gofakeit.UUID()}
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, mock.Anything).
+		Return([]*model.Part{
+			{Uuid: partUuids[0], Price: money.New(10000, money.DefaultCurrency)},
+			{Uuid: partUuids[1], Price: money.New(20000, money.DefaultCurrency)},
+		}, nil).
+		Once()
+
+	s.inventoryClient.
+		On("ReserveParts", s.ctx, mock.AnythingOfType("string"), mock.Anything, 30*time.Minute).
+		Return("", model.NewInsufficientStockError([]string{partUuids[1]})).
+		Once()
+
+	orderUUID, price, err := s.service.CreateOrder(s.ctx, userUUID, model.OrderRequest{Items: model.OrderItemsFromParts(partUuids)}, "")
+
+	s.Require().Empty(orderUUID)
+	s.Require().Zero(price)
+
+	var shortage *model.InsufficientStockError
+	s.Require().ErrorAs(err, &shortage)
+	s.Require().Equal([]string{partUuids[1]}, shortage.PartUUIDs)
+
+	s.orderRepository.AssertNotCalled(s.T(), "PutOrder", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestCreateOrderReleasesReservationWhenSaveFails() {
+	userUUID := gofakeit.UUID()
+	partUuids := []string{gofakeit.UUID()}
+	reservationUUID := gofakeit.UUID()
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, mock.Anything).
+		Return([]*model.Part{{Uuid: partUuids[0], Price: money.New(10000, money.DefaultCurrency)}}, nil).
+		Once()
+
+	s.inventoryClient.
+		On("ReserveParts", s.ctx, mock.AnythingOfType("string"), mock.Anything, 30*time.Minute).
+		Return(reservationUUID, nil).
+		Once()
+
+	s.orderRepository.
+		On("PutOrder", s.ctx, mock.AnythingOfType("string"), mock.Anything, mock.Anything).
+		Return(errors.New("db is down")).
+		Once()
+
+	s.inventoryClient.
+		On("ReleaseReservation", s.ctx, reservationUUID).
+		Return(nil).
+		Once()
+
+	_, _, err := s.service.CreateOrder(s.ctx, userUUID, model.OrderRequest{Items: model.OrderItemsFromParts(partUuids)}, "")
+
+	s.Require().Error(err)
+}
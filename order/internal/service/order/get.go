@@ -9,7 +9,7 @@ import (
 	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
 )
 
-func (s *service) GetOrder(ctx context.Context, orderUUID string) (*model.Order, error) {
+func (s *service) GetOrder(ctx context.Context, userUUID, orderUUID string) (*model.Order, error) {
 	logger.Debug(ctx, "Getting order",
 		zap.String("order_uuid", orderUUID),
 	)
@@ -23,6 +23,10 @@ func (s *service) GetOrder(ctx context.Context, orderUUID string) (*model.Order,
 		return nil, model.NewOrderNotFoundError(orderUUID)
 	}
 
+	if err = checkOwnership(ctx, order, userUUID); err != nil {
+		return nil, err
+	}
+
 	logger.Debug(ctx, "Order retrieved successfully",
 		zap.String("order_uuid", orderUUID),
 		zap.String("user_uuid", order.UserUUID),
@@ -3,6 +3,8 @@ package order
 import (
 	"fmt"
 
+	"github.com/brianvoe/gofakeit/v7"
+
 	"github.com/ZanDattSu/star-factory/order/internal/model"
 )
 
@@ -14,7 +16,7 @@ func (s *SuiteService) TestGetOrderSuccess() {
 		Return(expectedOrder, nil).
 		Once()
 
-	order, err := s.service.GetOrder(s.ctx, expectedOrder.OrderUUID)
+	order, err := s.service.GetOrder(s.ctx, expectedOrder.UserUUID, expectedOrder.OrderUUID)
 	s.Require().NoError(err)
 	s.Require().Equal(expectedOrder, order)
 }
@@ -27,7 +29,7 @@ func (s *SuiteService) TestGetOrderNotFound() {
 		Return(nil, &model.OrderNotFoundError{}).
 		Once()
 
-	order, err := s.service.GetOrder(s.ctx, expectedOrder.OrderUUID)
+	order, err := s.service.GetOrder(s.ctx, expectedOrder.UserUUID, expectedOrder.OrderUUID)
 
 	s.Require().Error(err)
 	s.Require().Nil(order)
@@ -38,3 +40,20 @@ func (s *SuiteService) TestGetOrderNotFound() {
 	s.Require().Equal(404, notFound.Code)
 	s.Require().Contains(err.Error(), fmt.Sprintf("order with UUID %q not found", expectedOrder.OrderUUID))
 }
+
+func (s *SuiteService) TestGetOrderForbidden() {
+	order := RandomOrder()
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).
+		Once()
+
+	got, err := s.service.GetOrder(s.ctx, gofakeit.UUID(), order.OrderUUID)
+
+	s.Require().Nil(got)
+
+	var forbidden *model.ForbiddenError
+	s.Require().ErrorAs(err, &forbidden)
+	s.Require().Equal(403, forbidden.Code)
+}
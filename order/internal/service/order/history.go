@@ -0,0 +1,41 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+func (s *service) GetOrderStatusHistory(ctx context.Context, userUUID, orderUUID string) ([]*model.OrderStatusChange, error) {
+	logger.Debug(ctx, "Getting order status history",
+		zap.String("order_uuid", orderUUID),
+	)
+
+	order, err := s.repository.GetOrder(ctx, orderUUID)
+	if err != nil {
+		logger.Warn(ctx, "Order not found",
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+		return nil, model.NewOrderNotFoundError(orderUUID)
+	}
+
+	if err = checkOwnership(ctx, order, userUUID); err != nil {
+		return nil, err
+	}
+
+	history, err := s.repository.GetOrderStatusHistory(ctx, orderUUID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get order status history",
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get order status history: %w", err)
+	}
+
+	return history, nil
+}
@@ -0,0 +1,44 @@
+package order
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (s *SuiteService) TestGetOrderStatusHistorySuccess() {
+	order := RandomOrder()
+	history := []*model.OrderStatusChange{
+		{
+			OrderUUID:  order.OrderUUID,
+			FromStatus: model.OrderStatusPENDINGPAYMENT,
+			ToStatus:   model.OrderStatusPAID,
+			Actor:      order.UserUUID,
+			Reason:     "order paid",
+			ChangedAt:  time.Now().UTC(),
+		},
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).Once()
+	s.orderRepository.On("GetOrderStatusHistory", s.ctx, order.OrderUUID).
+		Return(history, nil).Once()
+
+	got, err := s.service.GetOrderStatusHistory(s.ctx, order.UserUUID, order.OrderUUID)
+	s.Require().NoError(err)
+	s.Require().Equal(history, got)
+}
+
+func (s *SuiteService) TestGetOrderStatusHistoryForbidden() {
+	order := RandomOrder()
+
+	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).Once()
+
+	_, err := s.service.GetOrderStatusHistory(s.ctx, gofakeit.UUID(), order.OrderUUID)
+
+	var forbidden *model.ForbiddenError
+	s.Require().ErrorAs(err, &forbidden)
+}
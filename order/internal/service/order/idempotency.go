@@ -0,0 +1,123 @@
+package order
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+// idempotentRequest описывает запрос, выполняемый с Idempotency-Key.
+type idempotentRequest struct {
+	userUUID  string
+	operation string
+	key       string
+	// body параметры запроса, по которым считается fingerprint
+	body any
+}
+
+// withIdempotency выполняет action не более одного раза для каждого ключа.
+// Повтор с тем же ключом и телом возвращает сохранённый результат, с другим телом — ConflictError.
+// Пустой ключ отключает идемпотентность.
+func withIdempotency[T any](ctx context.Context, s *service, req idempotentRequest, action func() (T, error)) (T, error) {
+	var zero T
+
+	if req.key == "" {
+		return action()
+	}
+
+	fingerprint, err := requestFingerprint(req.body)
+	if err != nil {
+		return zero, err
+	}
+
+	record := model.IdempotencyRecord{
+		UserUUID:    req.userUUID,
+		Operation:   req.operation,
+		Key:         req.key,
+		Fingerprint: fingerprint,
+		// Точность PostgreSQL: по created_at Complete и Release находят свою попытку
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+
+	existing, reserved, err := s.idempotencyRepository.Reserve(ctx, record)
+	if err != nil {
+		return zero, err
+	}
+
+	if !reserved {
+		return replayIdempotent[T](ctx, req, existing, fingerprint)
+	}
+
+	result, err := action()
+	if err != nil {
+		if releaseErr := s.idempotencyRepository.Release(ctx, record); releaseErr != nil {
+			logger.Error(ctx, "Failed to release idempotency key",
+				zap.String("operation", req.operation),
+				zap.String("idempotency_key", req.key),
+				zap.Error(releaseErr),
+			)
+		}
+		return zero, err
+	}
+
+	response, err := json.Marshal(result)
+	if err != nil {
+		return zero, fmt.Errorf("failed to marshal idempotent response: %w", err)
+	}
+
+	// Операция уже выполнена, поэтому ошибку сохранения ответа только логируем
+	if err = s.idempotencyRepository.Complete(ctx, record, response); err != nil {
+		logger.Error(ctx, "Failed to save idempotent response",
+			zap.String("operation", req.operation),
+			zap.String("idempotency_key", req.key),
+			zap.Error(err),
+		)
+	}
+
+	return result, nil
+}
+
+func replayIdempotent[T any](ctx context.Context, req idempotentRequest, existing *model.IdempotencyRecord, fingerprint string) (T, error) {
+	var stored T
+
+	if existing.Fingerprint != fingerprint {
+		logger.Warn(ctx, "Idempotency key reused with a different request",
+			zap.String("operation", req.operation),
+			zap.String("idempotency_key", req.key),
+		)
+		return stored, model.NewConflictError("Idempotency-Key was already used with a different request")
+	}
+
+	if existing.Response == nil {
+		return stored, model.NewConflictError("request with this Idempotency-Key is still in progress")
+	}
+
+	if err := json.Unmarshal(existing.Response, &stored); err != nil {
+		return stored, fmt.Errorf("failed to unmarshal idempotent response: %w", err)
+	}
+
+	logger.Info(ctx, "Replaying idempotent response",
+		zap.String("operation", req.operation),
+		zap.String("idempotency_key", req.key),
+	)
+
+	return stored, nil
+}
+
+func requestFingerprint(body any) (string, error) {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request fingerprint: %w", err)
+	}
+
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
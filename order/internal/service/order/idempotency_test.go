@@ -0,0 +1,124 @@
+package order
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+func (s *SuiteService) TestCreateOrderIdempotentReplay() {
+	userUUID := gofakeit.UUID()
+	partUuids := []string{gofakeit.UUID()}
+	key := gofakeit.UUID()
+
+	fingerprint, err := requestFingerprint(model.OrderRequest{Items: model.OrderItemsFromParts(partUuids)})
+	s.Require().NoError(err)
+
+	stored, err := json.Marshal(createOrderResult{
+		OrderUUID:  gofakeit.UUID(),
+		Subtotal:   money.New(10000, money.DefaultCurrency),
+		Discount:   money.Zero(money.DefaultCurrency),
+		TotalPrice: money.New(10000, money.DefaultCurrency),
+	})
+	s.Require().NoError(err)
+
+	s.idempotencyRepository.
+		On("Reserve", s.ctx, mock.MatchedBy(func(r model.IdempotencyRecord) bool {
+			return r.UserUUID == userUUID && r.Key == key && r.Operation == model.IdempotentCreateOrder
+		})).
+		Return(&model.IdempotencyRecord{Fingerprint: fingerprint, Response: stored}, false, nil).
+		Once()
+
+	orderUUID, price, err := s.service.CreateOrder(s.ctx, userUUID, model.OrderRequest{Items: model.OrderItemsFromParts(partUuids)}, key)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(orderUUID)
+	s.Require().Equal(money.New(10000, money.DefaultCurrency), price.Total)
+
+	s.inventoryClient.AssertNotCalled(s.T(), "ListParts", mock.Anything, mock.Anything)
+	s.orderRepository.AssertNotCalled(s.T(), "PutOrder", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestPayOrderIdempotencyKeyReusedWithDifferentBody() {
+	s.idempotencyRepository.
+		On("Reserve", s.ctx, mock.Anything).
+		Return(&model.IdempotencyRecord{Fingerprint: "other", Response: []byte(`"txn"`)}, false, nil).
+		Once()
+
+	_, err := s.service.PayOrder(s.ctx, gofakeit.UUID(), model.PaymentMethodCard, gofakeit.UUID(), gofakeit.UUID())
+
+	var conflict *model.ConflictError
+	s.Require().ErrorAs(err, &conflict)
+	s.paymentClient.AssertNotCalled(s.T(), "PayOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestPayOrderIdempotencyKeyReleasedOnFailure() {
+	order := RandomOrder()
+	order.Status = model.OrderStatusPENDINGPAYMENT
+	key := gofakeit.UUID()
+
+	var reserved model.IdempotencyRecord
+	s.idempotencyRepository.
+		On("Reserve", s.ctx, mock.Anything).
+		Run(func(args mock.Arguments) {
+			reserved = args.Get(1).(model.IdempotencyRecord)
+		}).
+		Return(nil, true, nil).
+		Once()
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).
+		Once()
+	s.paymentClient.
+		On("PayOrder", s.ctx, order.OrderUUID, order.UserUUID, model.PaymentMethodCard, order.TotalPrice).
+		Return("", errors.New("payment declined")).
+		Once()
+	s.idempotencyRepository.
+		On("Release", s.ctx, mock.MatchedBy(func(r model.IdempotencyRecord) bool {
+			return r.CreatedAt.Equal(reserved.CreatedAt) && r.UserUUID == order.UserUUID && r.Operation == model.IdempotentPayOrder && r.Key == key
+		})).
+		Return(nil).
+		Once()
+
+	_, err := s.service.PayOrder(s.ctx, order.UserUUID, model.PaymentMethodCard, order.OrderUUID, key)
+	s.Require().Error(err)
+	s.idempotencyRepository.AssertNotCalled(s.T(), "Complete", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestIdempotencyCompletesReservedAttempt() {
+	key := gofakeit.UUID()
+	userUUID := gofakeit.UUID()
+
+	var reserved model.IdempotencyRecord
+	s.idempotencyRepository.
+		On("Reserve", s.ctx, mock.Anything).
+		Run(func(args mock.Arguments) {
+			reserved = args.Get(1).(model.IdempotencyRecord)
+		}).
+		Return(nil, true, nil).
+		Once()
+	s.idempotencyRepository.
+		On("Complete", s.ctx, mock.MatchedBy(func(r model.IdempotencyRecord) bool {
+			return r.Key == reserved.Key && r.CreatedAt.Equal(reserved.CreatedAt)
+		}), []byte(`"done"`)).
+		Return(nil).
+		Once()
+
+	result, err := withIdempotency(s.ctx, s.service, idempotentRequest{
+		userUUID:  userUUID,
+		operation: model.IdempotentPayOrder,
+		key:       key,
+		body:      key,
+	}, func() (string, error) {
+		return "done", nil
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("done", result)
+	// created_at попытки хранится в PostgreSQL с точностью до микросекунд
+	s.Require().Equal(reserved.CreatedAt, reserved.CreatedAt.Truncate(time.Microsecond))
+}
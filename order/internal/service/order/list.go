@@ -0,0 +1,78 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+func (s *service) ListOrders(ctx context.Context, filter model.OrdersFilter, cursor string) ([]*model.Order, string, error) {
+	logger.Debug(ctx, "Listing orders",
+		zap.String("user_uuid", filter.UserUUID),
+		zap.Int("page_size", filter.Limit),
+		zap.Bool("has_cursor", cursor != ""),
+	)
+
+	if filter.UserUUID == "" {
+		return nil, "", model.NewBadRequestError("user UUID should be not empty")
+	}
+
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return nil, "", model.NewBadRequestError("created_from should be before created_to")
+	}
+
+	pageSize := filter.Limit
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+
+	if cursor != "" {
+		decoded, err := model.DecodeOrderCursor(cursor)
+		if err != nil {
+			logger.Warn(ctx, "Invalid orders cursor",
+				zap.String("user_uuid", filter.UserUUID),
+				zap.Error(err),
+			)
+			return nil, "", err
+		}
+		filter.Cursor = decoded
+	}
+
+	// Запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница
+	filter.Limit = pageSize + 1
+
+	orders, err := s.repository.ListOrders(ctx, filter)
+	if err != nil {
+		logger.Error(ctx, "Failed to list orders",
+			zap.String("user_uuid", filter.UserUUID),
+			zap.Error(err),
+		)
+		return nil, "", fmt.Errorf("failed to list orders: %w", err)
+	}
+
+	var nextCursor string
+	if len(orders) > pageSize {
+		orders = orders[:pageSize]
+		nextCursor = model.NewOrderCursor(orders[pageSize-1]).Encode()
+	}
+
+	logger.Debug(ctx, "Orders listed successfully",
+		zap.String("user_uuid", filter.UserUUID),
+		zap.Int("orders_count", len(orders)),
+		zap.Bool("has_next_page", nextCursor != ""),
+	)
+
+	return orders, nextCursor, nil
+}
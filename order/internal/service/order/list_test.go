@@ -0,0 +1,105 @@
+package order
+
+import (
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (s *SuiteService) TestListOrdersSuccessWithNextPage() {
+	userUUID := gofakeit.UUID()
+	now := time.Now().UTC()
+
+	orders := make([]*model.Order, 3)
+	for i := range orders {
+		orders[i] = RandomOrder()
+		orders[i].UserUUID = userUUID
+		orders[i].CreatedAt = now.Add(-time.Duration(i) * time.Minute)
+	}
+
+	s.orderRepository.
+		On("ListOrders", s.ctx, mock.MatchedBy(func(f model.OrdersFilter) bool {
+			return f.UserUUID == userUUID && f.Limit == 3 && f.Cursor == nil
+		})).
+		Return(orders, nil).Once()
+
+	got, nextCursor, err := s.service.ListOrders(s.ctx, model.OrdersFilter{UserUUID: userUUID, Limit: 2}, "")
+	s.Require().NoError(err)
+	s.Require().Len(got, 2)
+	s.Require().NotEmpty(nextCursor)
+
+	cursor, err := model.DecodeOrderCursor(nextCursor)
+	s.Require().NoError(err)
+	s.Equal(orders[1].OrderUUID, cursor.OrderUUID)
+	s.True(orders[1].CreatedAt.Equal(cursor.CreatedAt))
+}
+
+func (s *SuiteService) TestListOrdersLastPage() {
+	userUUID := gofakeit.UUID()
+	order := RandomOrder()
+	order.UserUUID = userUUID
+	order.CreatedAt = time.Now().UTC()
+
+	cursor := model.NewOrderCursor(RandomOrder()).Encode()
+
+	s.orderRepository.
+		On("ListOrders", s.ctx, mock.MatchedBy(func(f model.OrdersFilter) bool {
+			return f.Limit == defaultPageSize+1 && f.Cursor != nil
+		})).
+		Return([]*model.Order{order}, nil).Once()
+
+	got, nextCursor, err := s.service.ListOrders(s.ctx, model.OrdersFilter{UserUUID: userUUID}, cursor)
+	s.Require().NoError(err)
+	s.Require().Len(got, 1)
+	s.Empty(nextCursor)
+}
+
+func (s *SuiteService) TestListOrdersInvalidCursor() {
+	_, _, err := s.service.ListOrders(s.ctx, model.OrdersFilter{UserUUID: gofakeit.UUID()}, "not a cursor")
+
+	var badRequest *model.BadRequestError
+	s.Require().ErrorAs(err, &badRequest)
+	s.Equal(400, badRequest.Code)
+}
+
+func (s *SuiteService) TestListOrdersCursorWithInvalidUUID() {
+	raw := time.Now().UTC().Format(time.RFC3339Nano) + "|abc"
+	cursor := base64.RawURLEncoding.EncodeToString([]byte(raw))
+
+	_, _, err := s.service.ListOrders(s.ctx, model.OrdersFilter{UserUUID: gofakeit.UUID()}, cursor)
+
+	var badRequest *model.BadRequestError
+	s.Require().ErrorAs(err, &badRequest)
+	s.Equal("invalid cursor", badRequest.Message)
+}
+
+func (s *SuiteService) TestListOrdersInvalidDateRange() {
+	now := time.Now()
+	filter := model.OrdersFilter{
+		UserUUID:    gofakeit.UUID(),
+		CreatedFrom: lo.ToPtr(now),
+		CreatedTo:   lo.ToPtr(now.Add(-time.Hour)),
+	}
+
+	_, _, err := s.service.ListOrders(s.ctx, filter, "")
+
+	var badRequest *model.BadRequestError
+	s.Require().ErrorAs(err, &badRequest)
+}
+
+func (s *SuiteService) TestListOrdersRepositoryError() {
+	repoErr := errors.New("db is down")
+
+	s.orderRepository.
+		On("ListOrders", s.ctx, mock.Anything).
+		Return(nil, repoErr).Once()
+
+	_, _, err := s.service.ListOrders(s.ctx, model.OrdersFilter{UserUUID: gofakeit.UUID()}, "")
+	s.Require().ErrorIs(err, repoErr)
+}
@@ -0,0 +1,24 @@
+package order
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+// checkOwnership проверяет, что заказ принадлежит пользователю из сессии.
+func checkOwnership(ctx context.Context, order *model.Order, userUUID string) error {
+	if order.UserUUID == userUUID {
+		return nil
+	}
+
+	logger.Warn(ctx, "Access to another user's order denied",
+		zap.String("order_uuid", order.OrderUUID),
+		zap.String("user_uuid", userUUID),
+	)
+
+	return model.NewForbiddenError("order belongs to another user")
+}
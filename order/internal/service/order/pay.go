@@ -2,6 +2,7 @@ package order
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -11,7 +12,23 @@ import (
 	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
 )
 
-func (s *service) PayOrder(ctx context.Context, paymentMethod model.PaymentMethod, orderUUID string) (string, error) {
+func (s *service) PayOrder(ctx context.Context, userUUID string, paymentMethod model.PaymentMethod, orderUUID, idempotencyKey string) (string, error) {
+	req := idempotentRequest{
+		userUUID:  userUUID,
+		operation: model.IdempotentPayOrder,
+		key:       idempotencyKey,
+		body: struct {
+			OrderUUID     string              `json:"order_uuid"`
+			PaymentMethod model.PaymentMethod `json:"payment_method"`
+		}{orderUUID, paymentMethod},
+	}
+
+	return withIdempotency(ctx, s, req, func() (string, error) {
+		return s.payOrder(ctx, userUUID, paymentMethod, orderUUID)
+	})
+}
+
+func (s *service) payOrder(ctx context.Context, userUUID string, paymentMethod model.PaymentMethod, orderUUID string) (string, error) {
 	logger.Info(ctx, "Processing order payment",
 		zap.String("order_uuid", orderUUID),
 		zap.String("payment_method", string(paymentMethod)),
@@ -26,6 +43,29 @@ func (s *service) PayOrder(ctx context.Context, paymentMethod model.PaymentMetho
 		return "", model.NewOrderNotFoundError(orderUUID)
 	}
 
+	if err = checkOwnership(ctx, order, userUUID); err != nil {
+		return "", err
+	}
+
+	// Проверяем переход до списания средств, чтобы не оплатить заказ повторно
+	if !order.Status.CanTransitionTo(model.OrderStatusPAID) {
+		logger.Warn(ctx, "Cannot pay order in current status",
+			zap.String("order_uuid", orderUUID),
+			zap.String("status", string(order.Status)),
+		)
+		return "", model.NewConflictError(fmt.Sprintf("cannot pay order in status %s", order.Status))
+	}
+
+	// Сверяем заказ с каталогом: списываем только актуальную стоимость
+	if err = s.revalidateOrder(ctx, order); err != nil {
+		return "", err
+	}
+
+	// Детали должны остаться за заказом: без подтверждённого резерва деньги не списываем
+	if err = s.commitReservation(ctx, order); err != nil {
+		return "", err
+	}
+
 	logger.Debug(ctx, "Order found, initiating payment",
 		zap.String("order_uuid", orderUUID),
 		zap.String("user_uuid", order.UserUUID),
@@ -37,6 +77,7 @@ func (s *service) PayOrder(ctx context.Context, paymentMethod model.PaymentMetho
 		order.OrderUUID,
 		order.UserUUID,
 		paymentMethod,
+		order.TotalPrice,
 	)
 	if err != nil {
 		logger.Error(ctx, "Payment failed",
@@ -53,38 +94,46 @@ func (s *service) PayOrder(ctx context.Context, paymentMethod model.PaymentMetho
 		zap.String("transaction_uuid", transactionUUID),
 	)
 
-	order.Status = model.OrderStatusPAID
+	change, err := order.TransitionTo(model.OrderStatusPAID, userUUID, "order paid")
+	if err != nil {
+		return "", err
+	}
 	order.TransactionUUID = &transactionUUID
 	order.PaymentMethod = paymentMethod
 
-	err = s.repository.UpdateOrder(ctx, orderUUID, order)
+	// Событие попадает в outbox в одной транзакции со сменой статуса и публикуется relay
+	outboxEvent, err := model.NewOrderPaidOutboxEvent(model.OrderPaidEvent{
+		EventUuid:       uuid.NewString(),
+		OrderUuid:       order.OrderUUID,
+		UserUuid:        order.UserUUID,
+		PaymentMethod:   order.PaymentMethod,
+		TransactionUuid: transactionUUID,
+	})
 	if err != nil {
-		logger.Error(ctx, "Failed to update order status after payment",
+		logger.Error(ctx, "Failed to build order paid event",
 			zap.String("order_uuid", orderUUID),
-			zap.String("transaction_uuid", transactionUUID),
 			zap.Error(err),
 		)
-		return "", fmt.Errorf("failed to put order in repository: %w", err)
+		return "", err
 	}
 
-	err = s.orderProducerService.ProduceOrderPaid(ctx,
-		model.OrderPaidEvent{
-			EventUuid:       uuid.NewString(),
-			OrderUuid:       order.OrderUUID,
-			UserUuid:        order.UserUUID,
-			PaymentMethod:   order.PaymentMethod,
-			TransactionUuid: transactionUUID,
-		},
-	)
+	err = s.repository.UpdateOrderStatus(ctx, order, change, outboxEvent)
 	if err != nil {
-		logger.Error(ctx, "Failed to produce order paid event",
+		versionConflict := &model.OrderVersionConflictError{}
+		if errors.As(err, &versionConflict) {
+			return "", s.refundStalePayment(ctx, order, transactionUUID, versionConflict)
+		}
+
+		logger.Error(ctx, "Failed to update order status after payment",
 			zap.String("order_uuid", orderUUID),
 			zap.String("transaction_uuid", transactionUUID),
 			zap.Error(err),
 		)
-		return "", fmt.Errorf("failed to produce order: %w", err)
+		return "", fmt.Errorf("failed to put order in repository: %w", err)
 	}
 
+	s.statusNotifier.Notify(order.OrderUUID)
+
 	logger.Info(ctx, "Order payment completed successfully",
 		zap.String("order_uuid", orderUUID),
 		zap.String("transaction_uuid", transactionUUID),
@@ -93,3 +142,31 @@ func (s *service) PayOrder(ctx context.Context, paymentMethod model.PaymentMetho
 
 	return transactionUUID, nil
 }
+
+// refundStalePayment возвращает оплату, если заказ изменили, пока шло списание:
+// клиент мог оплатить уже другой состав или заказ оплатили параллельным запросом.
+func (s *service) refundStalePayment(
+	ctx context.Context,
+	order *model.Order,
+	transactionUUID string,
+	versionConflict *model.OrderVersionConflictError,
+) error {
+	logger.Warn(ctx, "Order was modified during payment, refunding",
+		zap.String("order_uuid", order.OrderUUID),
+		zap.String("transaction_uuid", transactionUUID),
+		zap.Error(versionConflict),
+	)
+
+	_, err := s.paymentClient.RefundPayment(ctx, order.OrderUUID, order.UserUUID, transactionUUID, order.TotalPrice)
+	if err != nil {
+		// Деньги списаны, а заказ не оплачен: расхождение нужно разбирать по transaction_uuid
+		logger.Error(ctx, "Failed to refund payment for modified order",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.String("transaction_uuid", transactionUUID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("order was modified during payment and refund failed: %w", errors.Join(versionConflict, err))
+	}
+
+	return model.NewConflictError("order was modified during payment, payment refunded; review the order and pay again")
+}
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 )
 
 func (s *SuiteService) TestPayOrderSuccess() {
@@ -17,18 +18,22 @@ func (s *SuiteService) TestPayOrderSuccess() {
 		OrderUUID:  gofakeit.UUID(),
 		UserUUID:   gofakeit.UUID(),
 		PartUuids:  RandomPartUuids(),
-		TotalPrice: gofakeit.Price(100, 1000),
+		TotalPrice: money.FromMajor(gofakeit.Price(100, 1000), money.DefaultCurrency),
 		Status:     model.OrderStatusPENDINGPAYMENT,
 	}
 	paymentMethod := RandomPaymentMethod()
 	expectedTransactionUUID := gofakeit.UUID()
+	reservationUUID := gofakeit.UUID()
+	order.ReservationUUID = &reservationUUID
 
 	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
 		Return(order, nil).Once()
 
-	s.orderRepository.On("UpdateOrder",
+	s.inventoryClient.On("CommitReservation", s.ctx, reservationUUID).
+		Return(nil).Once()
+
+	s.orderRepository.On("UpdateOrderStatus",
 		s.ctx,
-		order.OrderUUID,
 		mock.MatchedBy(func(o *model.Order) bool {
 			return o.Status == model.OrderStatusPAID &&
 				o.PaymentMethod == paymentMethod &&
@@ -38,19 +43,52 @@ func (s *SuiteService) TestPayOrderSuccess() {
 				o.UserUUID == order.UserUUID &&
 				slices.Equal(o.PartUuids, order.PartUuids)
 		}),
+		mock.MatchedBy(func(c model.OrderStatusChange) bool {
+			return c.FromStatus == model.OrderStatusPENDINGPAYMENT &&
+				c.ToStatus == model.OrderStatusPAID &&
+				c.Actor == order.UserUUID
+		}),
+		mock.MatchedBy(func(e model.OutboxEvent) bool {
+			return e.EventType == model.OutboxEventOrderPaid &&
+				e.Key == order.OrderUUID
+		}),
 	).Return(nil).Once()
 
-	s.paymentClient.On("PayOrder", s.ctx, order.OrderUUID, order.UserUUID, paymentMethod).
+	s.paymentClient.On("PayOrder", s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, order.TotalPrice).
 		Return(expectedTransactionUUID, nil).Once()
 
-	s.orderProducerService.On("ProduceOrderPaid", s.ctx, mock.Anything).Return(nil)
-
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod, order.OrderUUID)
+	transactionUUID, err := s.service.PayOrder(s.ctx, order.UserUUID, paymentMethod, order.OrderUUID, "")
 
 	s.Require().NoError(err)
 	s.Require().Equal(expectedTransactionUUID, transactionUUID)
 }
 
+func (s *SuiteService) TestPayOrderReservationNotCommitted() {
+	reservationUUID := gofakeit.UUID()
+	order := &model.Order{
+		OrderUUID:       gofakeit.UUID(),
+		UserUUID:        gofakeit.UUID(),
+		TotalPrice:      money.FromMajor(gofakeit.Price(100, 1000), money.DefaultCurrency),
+		Status:          model.OrderStatusPENDINGPAYMENT,
+		ReservationUUID: &reservationUUID,
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).Once()
+
+	s.inventoryClient.On("CommitReservation", s.ctx, reservationUUID).
+		Return(model.NewConflictError("parts reservation has expired or was released")).Once()
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, order.UserUUID, RandomPaymentMethod(), order.OrderUUID, "")
+
+	s.Require().Empty(transactionUUID)
+	var conflict *model.ConflictError
+	s.Require().ErrorAs(err, &conflict)
+
+	s.paymentClient.AssertNotCalled(s.T(), "PayOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	s.orderRepository.AssertNotCalled(s.T(), "UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (s *SuiteService) TestPayOrderOrderNotFound() {
 	orderUUID := gofakeit.UUID()
 	paymentMethod := RandomPaymentMethod()
@@ -60,7 +98,7 @@ func (s *SuiteService) TestPayOrderOrderNotFound() {
 		Return((*model.Order)(nil), &model.OrderNotFoundError{}).
 		Once()
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod, orderUUID)
+	transactionUUID, err := s.service.PayOrder(s.ctx, gofakeit.UUID(), paymentMethod, orderUUID, "")
 
 	s.Require().Error(err)
 	s.Require().Empty(transactionUUID)
@@ -78,7 +116,7 @@ func (s *SuiteService) TestPayOrderPaymentFailed() {
 		OrderUUID:  gofakeit.UUID(),
 		UserUUID:   gofakeit.UUID(),
 		PartUuids:  RandomPartUuids(),
-		TotalPrice: gofakeit.Price(100, 1000),
+		TotalPrice: money.FromMajor(gofakeit.Price(100, 1000), money.DefaultCurrency),
 		Status:     model.OrderStatusPENDINGPAYMENT,
 	}
 	paymentMethod := RandomPaymentMethod()
@@ -90,10 +128,10 @@ func (s *SuiteService) TestPayOrderPaymentFailed() {
 		Return(order, nil).Once()
 
 	s.paymentClient.
-		On("PayOrder", s.ctx, order.OrderUUID, order.UserUUID, paymentMethod).
+		On("PayOrder", s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, order.TotalPrice).
 		Return("", internalErr).Once()
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod, order.OrderUUID)
+	transactionUUID, err := s.service.PayOrder(s.ctx, order.UserUUID, paymentMethod, order.OrderUUID, "")
 
 	s.Require().Error(err)
 	s.Require().Empty(transactionUUID)
@@ -118,10 +156,55 @@ func (s *SuiteService) TestPayOrderPaymentFailedNoMutation() {
 	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
 		Return(order, nil).Once()
 
-	s.paymentClient.On("PayOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+	s.paymentClient.On("PayOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return("", errors.New("failed")).Once()
 
-	_, _ = s.service.PayOrder(s.ctx, paymentMethod, order.OrderUUID)
+	_, _ = s.service.PayOrder(s.ctx, order.UserUUID, paymentMethod, order.OrderUUID, "")
 
 	s.Require().Equal(initialCopy, *order, "order should remain unchanged after failed payment")
 }
+
+func (s *SuiteService) TestPayOrderConflictNotPendingPayment() {
+	for _, st := range []model.OrderStatus{model.OrderStatusPAID, model.OrderStatusCANCELLED, model.OrderStatusASSEMBLED} {
+		order := RandomOrder()
+		order.Status = st
+
+		s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+			Return(order, nil).Once()
+
+		_, err := s.service.PayOrder(s.ctx, order.UserUUID, RandomPaymentMethod(), order.OrderUUID, "")
+
+		var conflict *model.ConflictError
+		s.Require().ErrorAs(err, &conflict, "status %s", st)
+	}
+
+	s.paymentClient.AssertNotCalled(s.T(), "PayOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestPayOrderVersionConflictRefunds() {
+	order := RandomOrder()
+	order.Status = model.OrderStatusPENDINGPAYMENT
+	order.TransactionUUID = nil
+	order.Version = 1
+	paymentMethod := RandomPaymentMethod()
+	transactionUUID := gofakeit.UUID()
+
+	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).Once()
+
+	s.paymentClient.On("PayOrder", s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, order.TotalPrice).
+		Return(transactionUUID, nil).Once()
+
+	// Пока шло списание, состав заказа изменили
+	s.orderRepository.On("UpdateOrderStatus", s.ctx, mock.Anything, mock.Anything, mock.Anything).
+		Return(model.NewOrderVersionConflictError(order.OrderUUID, 1, 2)).Once()
+
+	s.paymentClient.On("RefundPayment", s.ctx, order.OrderUUID, order.UserUUID, transactionUUID, order.TotalPrice).
+		Return(gofakeit.UUID(), nil).Once()
+
+	_, err := s.service.PayOrder(s.ctx, order.UserUUID, paymentMethod, order.OrderUUID, "")
+
+	var conflict *model.ConflictError
+	s.Require().ErrorAs(err, &conflict)
+	s.Require().Contains(conflict.Message, "payment refunded")
+}
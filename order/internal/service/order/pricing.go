@@ -0,0 +1,161 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	inventoryV1 "github.com/ZanDattSu/star-factory/order/internal/client/grpc/inventory/v1"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+var partsNotFound = "one or more parts not found"
+
+// pricedOrder позиции с зафиксированными ценами и итоговая стоимость заказа.
+type pricedOrder struct {
+	Items     []model.OrderItem
+	PartUuids []string
+	Subtotal  money.Money
+	Discount  money.Money
+	Total     money.Money
+	PromoCode *string
+	// QuoteToken расчёт, из которого взяты позиции и цены; пусто при оценке по каталогу
+	QuoteToken *string
+	// parts детали из каталога по UUID; для расчёта по токену не заполняется
+	parts map[string]*model.Part
+}
+
+// priceOrder оценивает позиции по текущему каталогу и применяет промокод, если он передан.
+func (s *service) priceOrder(ctx context.Context, userUUID string, items []model.OrderItem, promoCode string) (*pricedOrder, error) {
+	if len(items) == 0 {
+		logger.Warn(ctx, "Failed to price order: empty parts list",
+			zap.String("user_uuid", userUUID),
+		)
+		return nil, fmt.Errorf("%s: empty parts list", partsNotFound)
+	}
+
+	partUuids, partsByUUID, subtotal, err := s.priceItems(ctx, userUUID, items)
+	if err != nil {
+		return nil, err
+	}
+
+	discount := money.Zero(subtotal.Currency)
+	var appliedPromoCode *string
+	if promoCode != "" {
+		code, promoDiscount, err := s.applyPromoCode(ctx, promoCode, items, partsByUUID)
+		if err != nil {
+			logger.Warn(ctx, "Failed to price order: promo code rejected",
+				zap.String("user_uuid", userUUID),
+				zap.String("promo_code", promoCode),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+		discount = promoDiscount
+		appliedPromoCode = &code
+	}
+
+	total, err := subtotal.Sub(discount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate order total: %w", err)
+	}
+
+	return &pricedOrder{
+		Items:     items,
+		PartUuids: partUuids,
+		Subtotal:  subtotal,
+		Discount:  discount,
+		Total:     total,
+		PromoCode: appliedPromoCode,
+		parts:     partsByUUID,
+	}, nil
+}
+
+// priceItems проверяет позиции по каталогу inventory и правилам комплектации корабля
+// и фиксирует в них текущие название и цену детали.
+// Возвращает UUID деталей, найденные детали и сумму позиций.
+func (s *service) priceItems(
+	ctx context.Context,
+	userUUID string,
+	items []model.OrderItem,
+) ([]string, map[string]*model.Part, money.Money, error) {
+	partUuids := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, nil, money.Money{}, model.NewBadRequestError(fmt.Sprintf("quantity of part %s must be positive", item.PartUUID))
+		}
+		partUuids = append(partUuids, item.PartUUID)
+	}
+
+	parts, err := s.inventoryClient.ListParts(
+		ctx,
+		model.PartsFilter{
+			Uuids: partUuids,
+		},
+	)
+	if err != nil {
+		notFound := &inventoryV1.PartsNotFoundError{}
+		if errors.As(err, &notFound) {
+			logger.Error(ctx, "Failed to price order items: parts not found",
+				zap.String("user_uuid", userUUID),
+				zap.Strings("part_uuids", partUuids),
+				zap.Error(err),
+			)
+			return nil, nil, money.Money{}, fmt.Errorf("%s: %w", partsNotFound, err)
+		}
+		logger.Error(ctx, "Failed to get parts from inventory",
+			zap.String("user_uuid", userUUID),
+			zap.Strings("part_uuids", partUuids),
+			zap.Error(err),
+		)
+		return nil, nil, money.Money{}, err
+	}
+
+	if len(parts) != len(partUuids) {
+		logger.Error(ctx, "Failed to price order items: parts count mismatch",
+			zap.String("user_uuid", userUUID),
+			zap.Int("requested_parts", len(partUuids)),
+			zap.Int("found_parts", len(parts)),
+		)
+		return nil, nil, money.Money{}, fmt.Errorf("%s: %w", partsNotFound, err)
+	}
+
+	// Фиксируем название и цену детали на момент заказа
+	partsByUUID := make(map[string]*model.Part, len(parts))
+	for _, part := range parts {
+		partsByUUID[part.Uuid] = part
+	}
+
+	var subtotal money.Money
+	for i := range items {
+		part, ok := partsByUUID[items[i].PartUUID]
+		if !ok {
+			return nil, nil, money.Money{}, fmt.Errorf("%s: %s", partsNotFound, items[i].PartUUID)
+		}
+		items[i].PartName = part.Name
+		items[i].UnitPrice = part.Price
+
+		subtotal, err = subtotal.Add(items[i].Total())
+		if err != nil {
+			logger.Error(ctx, "Failed to price order items: parts priced in different currencies",
+				zap.String("user_uuid", userUUID),
+				zap.Error(err),
+			)
+			return nil, nil, money.Money{}, fmt.Errorf("failed to calculate order total: %w", err)
+		}
+	}
+
+	if violations := s.blueprint.Check(items, partsByUUID); len(violations) > 0 {
+		logger.Warn(ctx, "Order items do not match the ship blueprint",
+			zap.String("user_uuid", userUUID),
+			zap.Strings("violations", violations),
+		)
+		return nil, nil, money.Money{}, model.NewBlueprintViolationError(violations)
+	}
+
+	return partUuids, partsByUUID, subtotal, nil
+}
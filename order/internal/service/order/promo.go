@@ -0,0 +1,76 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+// applyPromoCode проверяет промокод и считает скидку для уже оценённых позиций.
+// Возвращает нормализованный код, под которым он сохраняется в заказе.
+func (s *service) applyPromoCode(
+	ctx context.Context,
+	code string,
+	items []model.OrderItem,
+	partsByUUID map[string]*model.Part,
+) (string, money.Money, error) {
+	code = model.NormalizePromoCode(code)
+
+	promo, err := s.promoCodeRepository.GetPromoCode(ctx, code)
+	if err != nil {
+		notFound := &model.PromoCodeNotFoundError{}
+		if errors.As(err, &notFound) {
+			return "", money.Money{}, model.NewBadRequestError(fmt.Sprintf("promo code %q not found", code))
+		}
+		return "", money.Money{}, fmt.Errorf("failed to get promo code: %w", err)
+	}
+
+	if err = promo.CheckRedeemable(time.Now().UTC()); err != nil {
+		return "", money.Money{}, err
+	}
+
+	discount, err := promoDiscount(promo, items, partsByUUID)
+	if err != nil {
+		return "", money.Money{}, err
+	}
+
+	logger.Debug(ctx, "Promo code applied",
+		zap.String("promo_code", code),
+		zap.Stringer("discount", discount),
+	)
+
+	return code, discount, nil
+}
+
+// recalculatePromoDiscount пересчитывает скидку по промокоду, уже применённому к заказу.
+// Использование кода засчитано при оформлении, поэтому срок действия и лимит повторно не проверяются.
+func (s *service) recalculatePromoDiscount(
+	ctx context.Context,
+	code string,
+	items []model.OrderItem,
+	partsByUUID map[string]*model.Part,
+) (money.Money, error) {
+	promo, err := s.promoCodeRepository.GetPromoCode(ctx, code)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("failed to get promo code: %w", err)
+	}
+
+	return promoDiscount(promo, items, partsByUUID)
+}
+
+// promoDiscount считает скидку по категориям найденных деталей.
+func promoDiscount(promo *model.PromoCode, items []model.OrderItem, partsByUUID map[string]*model.Part) (money.Money, error) {
+	categories := make(map[string]model.Category, len(partsByUUID))
+	for partUUID, part := range partsByUUID {
+		categories[partUUID] = part.Category
+	}
+
+	return promo.Discount(items, categories)
+}
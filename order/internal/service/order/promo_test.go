@@ -0,0 +1,164 @@
+package order
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+func (s *SuiteService) TestCreateOrderWithCategoryPromoCode() {
+	userUUID := gofakeit.UUID()
+	engineUUID, wingUUID := gofakeit.UUID(), gofakeit.UUID()
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, mock.Anything).
+		Return([]*model.Part{
+			{Uuid: engineUUID, Category: model.CategoryEngine, Price: money.New(10050, money.DefaultCurrency)},
+			{Uuid: wingUUID, Category: model.CategoryWing, Price: money.New(20000, money.DefaultCurrency)},
+		}, nil).
+		Once()
+
+	s.promoCodeRepository.
+		On("GetPromoCode", s.ctx, "ENGINE10").
+		Return(&model.PromoCode{
+			Code:         "ENGINE10",
+			DiscountType: model.DiscountTypePERCENT,
+			PercentOff:   10,
+			Categories:   []model.Category{model.CategoryEngine},
+			MaxUses:      lo.ToPtr(int64(5)),
+			UsedCount:    4,
+			Active:       true,
+		}, nil).
+		Once()
+
+	s.inventoryClient.
+		On("ReserveParts", s.ctx, mock.AnythingOfType("string"), mock.Anything, 30*time.Minute).
+		Return(gofakeit.UUID(), nil).
+		Once()
+
+	// Скидка только на двигатели: 10% от 2 * 100.50 = 20.10
+	expected := model.OrderPrice{
+		Subtotal: money.New(40100, money.DefaultCurrency),
+		Discount: money.New(2010, money.DefaultCurrency),
+		Total:    money.New(38090, money.DefaultCurrency),
+	}
+
+	s.orderRepository.
+		On("PutOrder", s.ctx, mock.AnythingOfType("string"), mock.MatchedBy(func(order *model.Order) bool {
+			return order.Price() == expected &&
+				order.PromoCode != nil && *order.PromoCode == "ENGINE10"
+		}), mock.Anything).
+		Return(nil).
+		Once()
+
+	items := []model.OrderItem{
+		{PartUUID: engineUUID, Quantity: 2},
+		{PartUUID: wingUUID, Quantity: 1},
+	}
+
+	_, price, err := s.service.CreateOrder(s.ctx, userUUID, model.OrderRequest{Items: items, PromoCode: " engine10 "}, "")
+
+	s.Require().NoError(err)
+	s.Require().Equal(expected, price)
+}
+
+func (s *SuiteService) TestCreateOrderFixedPromoCodeCappedBySubtotal() {
+	partUUID := gofakeit.UUID()
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, mock.Anything).
+		Return([]*model.Part{{Uuid: partUUID, Price: money.New(5000, money.DefaultCurrency)}}, nil).
+		Once()
+
+	s.promoCodeRepository.
+		On("GetPromoCode", s.ctx, "MINUS100").
+		Return(&model.PromoCode{
+			Code:         "MINUS100",
+			DiscountType: model.DiscountTypeFIXED,
+			AmountOff:    money.New(10000, money.DefaultCurrency),
+			Active:       true,
+		}, nil).
+		Once()
+
+	s.inventoryClient.
+		On("ReserveParts", s.ctx, mock.AnythingOfType("string"), mock.Anything, 30*time.Minute).
+		Return(gofakeit.UUID(), nil).
+		Once()
+
+	s.orderRepository.
+		On("PutOrder", s.ctx, mock.AnythingOfType("string"), mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	_, price, err := s.service.CreateOrder(s.ctx, gofakeit.UUID(), model.OrderRequest{Items: model.OrderItemsFromParts([]string{partUUID}), PromoCode: "MINUS100"}, "")
+
+	s.Require().NoError(err)
+	s.Require().Equal(money.New(5000, money.DefaultCurrency), price.Discount)
+	s.Require().True(price.Total.IsZero())
+}
+
+func (s *SuiteService) TestCreateOrderPromoCodeRejected() {
+	expired := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name  string
+		promo *model.PromoCode
+		err   error
+	}{
+		{
+			name: "not found",
+			err:  model.NewPromoCodeNotFoundError("SALE"),
+		},
+		{
+			name:  "expired",
+			promo: &model.PromoCode{Code: "SALE", DiscountType: model.DiscountTypePERCENT, PercentOff: 10, Active: true, ExpiresAt: &expired},
+		},
+		{
+			name:  "inactive",
+			promo: &model.PromoCode{Code: "SALE", DiscountType: model.DiscountTypePERCENT, PercentOff: 10},
+		},
+		{
+			name: "usage limit reached",
+			promo: &model.PromoCode{
+				Code: "SALE", DiscountType: model.DiscountTypePERCENT, PercentOff: 10, Active: true,
+				MaxUses: lo.ToPtr(int64(1)), UsedCount: 1,
+			},
+		},
+		{
+			name: "no eligible items",
+			promo: &model.PromoCode{
+				Code: "SALE", DiscountType: model.DiscountTypePERCENT, PercentOff: 10, Active: true,
+				Categories: []model.Category{model.CategoryFuel},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			partUUID := gofakeit.UUID()
+
+			s.inventoryClient.
+				On("ListParts", s.ctx, mock.Anything).
+				Return([]*model.Part{{Uuid: partUUID, Category: model.CategoryEngine, Price: money.New(5000, money.DefaultCurrency)}}, nil).
+				Once()
+
+			s.promoCodeRepository.
+				On("GetPromoCode", s.ctx, "SALE").
+				Return(tt.promo, tt.err).
+				Once()
+
+			_, _, err := s.service.CreateOrder(s.ctx, gofakeit.UUID(), model.OrderRequest{Items: model.OrderItemsFromParts([]string{partUUID}), PromoCode: "sale"}, "")
+
+			var badRequest *model.BadRequestError
+			s.Require().ErrorAs(err, &badRequest)
+		})
+	}
+
+	s.inventoryClient.AssertNotCalled(s.T(), "ReserveParts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	s.orderRepository.AssertNotCalled(s.T(), "PutOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,135 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+func (s *service) QuoteOrder(ctx context.Context, userUUID string, items []model.OrderItem, promoCode string) (*model.Quote, error) {
+	items = model.MergeOrderItems(items)
+
+	logger.Info(ctx, "Quoting order",
+		zap.String("user_uuid", userUUID),
+		zap.Int("items_count", len(items)),
+	)
+
+	priced, err := s.priceOrder(ctx, userUUID, items, promoCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkStock(priced); err != nil {
+		logger.Warn(ctx, "Failed to quote order: not enough stock",
+			zap.String("user_uuid", userUUID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	quote := &model.Quote{
+		Token:     uuid.NewString(),
+		UserUUID:  userUUID,
+		Items:     priced.Items,
+		Subtotal:  priced.Subtotal,
+		Discount:  priced.Discount,
+		Total:     priced.Total,
+		PromoCode: priced.PromoCode,
+		ExpiresAt: now.Add(s.quoteTTL),
+		CreatedAt: now,
+	}
+
+	if err = s.quoteRepository.CreateQuote(ctx, quote); err != nil {
+		logger.Error(ctx, "Failed to save quote",
+			zap.String("user_uuid", userUUID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to save quote: %w", err)
+	}
+
+	logger.Info(ctx, "Order quoted successfully",
+		zap.String("user_uuid", userUUID),
+		zap.String("quote_token", quote.Token),
+		zap.Stringer("total_price", quote.Total),
+		zap.Time("expires_at", quote.ExpiresAt),
+	)
+
+	return quote, nil
+}
+
+// checkStock сверяет количество с остатками на складе, ничего не резервируя.
+// Окончательно наличие проверяется резервированием при оформлении заказа.
+func checkStock(priced *pricedOrder) error {
+	var short []string
+	for _, item := range priced.Items {
+		if part, ok := priced.parts[item.PartUUID]; ok && part.StockQuantity < item.Quantity {
+			short = append(short, item.PartUUID)
+		}
+	}
+
+	if len(short) > 0 {
+		return model.NewInsufficientStockError(short)
+	}
+
+	return nil
+}
+
+// quotedOrder берёт позиции, цены и скидку из расчёта по токену.
+// Позиции и промокод из запроса необязательны, но если переданы, должны совпадать с расчётом.
+func (s *service) quotedOrder(ctx context.Context, userUUID string, orderReq model.OrderRequest) (*pricedOrder, error) {
+	token := orderReq.QuoteToken
+	if _, err := uuid.Parse(token); err != nil {
+		return nil, model.NewBadRequestError(fmt.Sprintf("invalid quote token %q", token))
+	}
+
+	quote, err := s.quoteRepository.GetQuote(ctx, token)
+	if err != nil {
+		notFound := &model.QuoteNotFoundError{}
+		if errors.As(err, &notFound) {
+			return nil, model.NewBadRequestError(fmt.Sprintf("quote %s not found", token))
+		}
+		return nil, fmt.Errorf("failed to get quote: %w", err)
+	}
+
+	// Чужой расчёт неотличим от несуществующего
+	if quote.UserUUID != userUUID {
+		return nil, model.NewBadRequestError(fmt.Sprintf("quote %s not found", token))
+	}
+
+	// Окончательно повторное использование отсекает PutOrder, здесь — до резервирования деталей
+	if quote.Used() {
+		return nil, model.NewConflictError(fmt.Sprintf("quote %s has already been used, request a new one", token))
+	}
+
+	if quote.Expired(time.Now()) {
+		return nil, model.NewConflictError(fmt.Sprintf("quote %s has expired, request a new one", token))
+	}
+
+	if len(orderReq.Items) > 0 && !quote.HasItems(orderReq.Items) {
+		return nil, model.NewBadRequestError("order items do not match the quote")
+	}
+
+	if orderReq.PromoCode != "" &&
+		(quote.PromoCode == nil || *quote.PromoCode != model.NormalizePromoCode(orderReq.PromoCode)) {
+		return nil, model.NewBadRequestError("promo code does not match the quote")
+	}
+
+	return &pricedOrder{
+		Items:      quote.Items,
+		PartUuids:  lo.Map(quote.Items, func(item model.OrderItem, _ int) string { return item.PartUUID }),
+		Subtotal:   quote.Subtotal,
+		Discount:   quote.Discount,
+		Total:      quote.Total,
+		PromoCode:  quote.PromoCode,
+		QuoteToken: &quote.Token,
+	}, nil
+}
@@ -0,0 +1,188 @@
+package order
+
+import (
+	"errors"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+func (s *SuiteService) TestQuoteOrderSuccess() {
+	userUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+	part := &model.Part{
+		Uuid:          partUUID,
+		Name:          gofakeit.ProductName(),
+		Price:         money.New(2500, money.DefaultCurrency),
+		StockQuantity: 10,
+	}
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, model.PartsFilter{Uuids: []string{partUUID}}).
+		Return([]*model.Part{part}, nil).
+		Once()
+
+	s.quoteRepository.
+		On("CreateQuote", s.ctx, mock.MatchedBy(func(q *model.Quote) bool {
+			return q.UserUUID == userUUID && q.Token != "" && q.ExpiresAt.After(time.Now())
+		})).
+		Return(nil).
+		Once()
+
+	quote, err := s.service.QuoteOrder(s.ctx, userUUID, []model.OrderItem{
+		{PartUUID: partUUID, Quantity: 2},
+		{PartUUID: partUUID, Quantity: 2},
+	}, "")
+
+	s.Require().NoError(err)
+	s.Require().Equal([]model.OrderItem{
+		{PartUUID: partUUID, PartName: part.Name, Quantity: 4, UnitPrice: part.Price},
+	}, quote.Items)
+	s.Require().Equal(money.New(10000, money.DefaultCurrency), quote.Total)
+	s.Require().True(quote.Discount.IsZero())
+
+	s.inventoryClient.AssertNotCalled(s.T(), "ReserveParts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestQuoteOrderInsufficientStock() {
+	partUUID := gofakeit.UUID()
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, model.PartsFilter{Uuids: []string{partUUID}}).
+		Return([]*model.Part{{
+			Uuid:          partUUID,
+			Price:         money.New(2500, money.DefaultCurrency),
+			StockQuantity: 1,
+		}}, nil).
+		Once()
+
+	_, err := s.service.QuoteOrder(s.ctx, gofakeit.UUID(), []model.OrderItem{{PartUUID: partUUID, Quantity: 2}}, "")
+
+	shortage := &model.InsufficientStockError{}
+	s.Require().ErrorAs(err, &shortage)
+	s.Require().Equal([]string{partUUID}, shortage.PartUUIDs)
+
+	s.quoteRepository.AssertNotCalled(s.T(), "CreateQuote", mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestCreateOrderFromQuoteKeepsQuotedPrices() {
+	userUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+	promoCode := "ENGINE10"
+	quote := &model.Quote{
+		Token:     gofakeit.UUID(),
+		UserUUID:  userUUID,
+		Items:     []model.OrderItem{{PartUUID: partUUID, PartName: "Engine", Quantity: 2, UnitPrice: money.New(5000, money.DefaultCurrency)}},
+		Subtotal:  money.New(10000, money.DefaultCurrency),
+		Discount:  money.New(1000, money.DefaultCurrency),
+		Total:     money.New(9000, money.DefaultCurrency),
+		PromoCode: &promoCode,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+
+	s.quoteRepository.On("GetQuote", s.ctx, quote.Token).Return(quote, nil).Once()
+
+	s.inventoryClient.
+		On("ReserveParts", s.ctx, mock.AnythingOfType("string"), []model.ReservationItem{{PartUUID: partUUID, Quantity: 2}}, 30*time.Minute).
+		Return(gofakeit.UUID(), nil).
+		Once()
+
+	s.orderRepository.
+		On("PutOrder", s.ctx, mock.AnythingOfType("string"), mock.MatchedBy(func(order *model.Order) bool {
+			return order.UserUUID == userUUID &&
+				order.TotalPrice == quote.Total &&
+				order.Discount == quote.Discount &&
+				order.PromoCode != nil && *order.PromoCode == promoCode &&
+				order.QuoteToken != nil && *order.QuoteToken == quote.Token &&
+				order.Items[0].UnitPrice == quote.Items[0].UnitPrice
+		}), mock.Anything).
+		Return(nil).
+		Once()
+
+	_, price, err := s.service.CreateOrder(s.ctx, userUUID, model.OrderRequest{QuoteToken: quote.Token}, "")
+
+	s.Require().NoError(err)
+	s.Require().Equal(quote.Price(), price)
+
+	// Цены берутся из расчёта, каталог повторно не запрашивается
+	s.inventoryClient.AssertNotCalled(s.T(), "ListParts", mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestCreateOrderFromQuoteRejected() {
+	userUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+
+	validQuote := func() *model.Quote {
+		return &model.Quote{
+			Token:     gofakeit.UUID(),
+			UserUUID:  userUUID,
+			Items:     []model.OrderItem{{PartUUID: partUUID, Quantity: 2, UnitPrice: money.New(5000, money.DefaultCurrency)}},
+			Total:     money.New(10000, money.DefaultCurrency),
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+	}
+
+	cases := []struct {
+		name     string
+		quote    func() *model.Quote
+		items    []model.OrderItem
+		conflict bool
+	}{
+		{
+			name: "expired",
+			quote: func() *model.Quote {
+				q := validQuote()
+				q.ExpiresAt = time.Now().Add(-time.Minute)
+				return q
+			},
+			conflict: true,
+		},
+		{
+			name: "already used",
+			quote: func() *model.Quote {
+				q := validQuote()
+				orderUUID := gofakeit.UUID()
+				q.OrderUUID = &orderUUID
+				return q
+			},
+			conflict: true,
+		},
+		{
+			name: "other user",
+			quote: func() *model.Quote {
+				q := validQuote()
+				q.UserUUID = gofakeit.UUID()
+				return q
+			},
+		},
+		{
+			name:  "items mismatch",
+			quote: validQuote,
+			items: []model.OrderItem{{PartUUID: partUUID, Quantity: 3}},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			quote := tc.quote()
+			s.quoteRepository.On("GetQuote", s.ctx, quote.Token).Return(quote, nil).Once()
+
+			_, _, err := s.service.CreateOrder(s.ctx, userUUID, model.OrderRequest{Items: tc.items, QuoteToken: quote.Token}, "")
+
+			conflict := &model.ConflictError{}
+			badRequest := &model.BadRequestError{}
+			if tc.conflict {
+				s.Require().True(errors.As(err, &conflict), err)
+			} else {
+				s.Require().True(errors.As(err, &badRequest), err)
+			}
+		})
+	}
+
+	s.inventoryClient.AssertNotCalled(s.T(), "ReserveParts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	s.orderRepository.AssertNotCalled(s.T(), "PutOrder", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 )
 
 func RandomOrder() *model.Order {
@@ -14,7 +15,7 @@ func RandomOrder() *model.Order {
 		OrderUUID:       gofakeit.UUID(),
 		UserUUID:        gofakeit.UUID(),
 		PartUuids:       RandomPartUuids(),
-		TotalPrice:      gofakeit.Price(100, 1000),
+		TotalPrice:      money.FromMajor(gofakeit.Price(100, 1000), money.DefaultCurrency),
 		TransactionUUID: lo.ToPtr(gofakeit.UUID()),
 		PaymentMethod:   RandomPaymentMethod(),
 		Status:          RandomOrderStatus(),
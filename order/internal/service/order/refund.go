@@ -0,0 +1,150 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+// markRefunding переводит оплаченный заказ в REFUNDING. Статус сохраняется под проверкой версии
+// до возврата денег: параллельная отмена или сборка заказа получат конфликт, а не второй возврат.
+// Событие OrderCancelled сохраняется в outbox вместе со сменой статуса.
+func (s *service) markRefunding(ctx context.Context, order *model.Order, userUUID string) (*model.Order, error) {
+	if order.TransactionUUID == nil {
+		return nil, fmt.Errorf("paid order %s has no transaction", order.OrderUUID)
+	}
+
+	change, err := order.TransitionTo(model.OrderStatusREFUNDING, userUUID, "cancelled by user, refunding payment")
+	if err != nil {
+		return nil, err
+	}
+
+	cancelledEvent, err := model.NewOrderCancelledOutboxEvent(model.NewOrderCancelledEvent(order, change))
+	if err != nil {
+		logger.Error(ctx, "Failed to build order cancelled event",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	err = s.repository.UpdateOrderStatus(ctx, order, change, cancelledEvent)
+	if err != nil {
+		logger.Error(ctx, "Failed to update order status to refunding",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to update order status to refunding: %w", err)
+	}
+
+	s.statusNotifier.Notify(order.OrderUUID)
+	return order, nil
+}
+
+// refundOrder возвращает оплату заказа в статусе REFUNDING и переводит его в REFUNDED.
+// Возврат идемпотентен по транзакции, поэтому прерванный возврат проводится повторно без второго списания.
+// После возврата детали подтверждённого резерва возвращаются на склад.
+func (s *service) refundOrder(ctx context.Context, order *model.Order, userUUID string) error {
+	if order.TransactionUUID == nil {
+		return fmt.Errorf("paid order %s has no transaction", order.OrderUUID)
+	}
+
+	logger.Info(ctx, "Refunding paid order",
+		zap.String("order_uuid", order.OrderUUID),
+		zap.String("transaction_uuid", *order.TransactionUUID),
+		zap.Stringer("amount", order.TotalPrice),
+	)
+
+	refundUUID, err := s.paymentClient.RefundPayment(
+		ctx,
+		order.OrderUUID,
+		order.UserUUID,
+		*order.TransactionUUID,
+		order.TotalPrice,
+	)
+	if err != nil {
+		// Заказ остаётся в REFUNDING: повторная отмена проведёт возврат снова
+		logger.Error(ctx, "Refund failed",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.String("transaction_uuid", *order.TransactionUUID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to refund order %s: %w", order.OrderUUID, err)
+	}
+
+	current := order
+	err = model.RetryOnVersionConflict(model.MaxVersionConflictRetries, func() error {
+		if current == nil {
+			fresh, getErr := s.repository.GetOrder(ctx, order.OrderUUID)
+			if getErr != nil {
+				return fmt.Errorf("failed to reload refunded order: %w", getErr)
+			}
+			current = fresh
+		}
+
+		// Параллельная отмена уже завершила тот же возврат
+		if current.Status == model.OrderStatusREFUNDED {
+			return nil
+		}
+
+		markErr := s.markRefunded(ctx, current, userUUID, refundUUID)
+		current = nil
+		return markErr
+	})
+	if err != nil {
+		// Деньги уже возвращены: расхождение нужно разбирать по refund_uuid
+		logger.Error(ctx, "Payment refunded but order status was not updated",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.String("refund_uuid", refundUUID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update order status to refunded: %w", err)
+	}
+
+	s.releaseReservation(ctx, order)
+
+	logger.Info(ctx, "Order refunded successfully",
+		zap.String("order_uuid", order.OrderUUID),
+		zap.String("refund_uuid", refundUUID),
+	)
+
+	return nil
+}
+
+// markRefunded переводит заказ из REFUNDING в REFUNDED.
+// Событие OrderRefunded сохраняется в outbox вместе со сменой статуса.
+func (s *service) markRefunded(ctx context.Context, order *model.Order, userUUID, refundUUID string) error {
+	change, err := order.TransitionTo(model.OrderStatusREFUNDED, userUUID, "cancelled by user, payment refunded")
+	if err != nil {
+		return err
+	}
+
+	outboxEvent, err := model.NewOrderRefundedOutboxEvent(model.OrderRefundedEvent{
+		EventUuid:       uuid.NewString(),
+		OrderUuid:       order.OrderUUID,
+		UserUuid:        order.UserUUID,
+		TransactionUuid: *order.TransactionUUID,
+		RefundUuid:      refundUUID,
+		Amount:          order.TotalPrice,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to build order refunded event",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	err = s.repository.UpdateOrderStatus(ctx, order, change, outboxEvent)
+	if err != nil {
+		return err
+	}
+
+	s.statusNotifier.Notify(order.OrderUUID)
+	return nil
+}
@@ -0,0 +1,130 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+// releaseReservation возвращает зарезервированные под заказ детали на склад.
+// Ошибка не прерывает операцию: непогашенный резерв всё равно снимется по TTL в inventory.
+func (s *service) releaseReservation(ctx context.Context, order *model.Order) {
+	if order.ReservationUUID == nil {
+		return
+	}
+
+	err := s.inventoryClient.ReleaseReservation(ctx, *order.ReservationUUID)
+	if err != nil {
+		logger.Error(ctx, "Failed to release parts reservation",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.String("reservation_uuid", *order.ReservationUUID),
+			zap.Error(err),
+		)
+	}
+}
+
+// commitReservation окончательно списывает зарезервированные детали перед списанием денег,
+// чтобы не оплатить заказ, детали которого уже вернулись на склад.
+// Подтверждение идемпотентно: если оплата не прошла, повторная попытка подтвердит тот же резерв,
+// а при отмене или истечении заказа подтверждённый резерв снимается как обычный.
+func (s *service) commitReservation(ctx context.Context, order *model.Order) error {
+	if order.ReservationUUID == nil {
+		return nil
+	}
+
+	err := s.inventoryClient.CommitReservation(ctx, *order.ReservationUUID)
+	if err != nil {
+		logger.Warn(ctx, "Failed to commit parts reservation before payment",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.String("reservation_uuid", *order.ReservationUUID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// swapReservation снимает резерв заказа и резервирует детали нового состава.
+// Резерв у заказа может быть только один, поэтому старый снимается до создания нового.
+func (s *service) swapReservation(ctx context.Context, order *model.Order, items []model.OrderItem) (string, error) {
+	// Пока считались цены, заказ могла изменить параллельная правка: её резерв снимать нельзя
+	current, err := s.repository.GetOrder(ctx, order.OrderUUID)
+	if err != nil {
+		return "", fmt.Errorf("failed to reload order before swapping reservation: %w", err)
+	}
+	if current.Version != order.Version {
+		return "", model.NewOrderVersionConflictError(order.OrderUUID, order.Version, current.Version)
+	}
+
+	if order.ReservationUUID != nil {
+		err = s.inventoryClient.ReleaseReservation(ctx, *order.ReservationUUID)
+		if err != nil {
+			logger.Error(ctx, "Failed to release previous reservation of edited order",
+				zap.String("order_uuid", order.OrderUUID),
+				zap.String("reservation_uuid", *order.ReservationUUID),
+				zap.Error(err),
+			)
+			// Если резерв уже сняла параллельная операция, клиент вернул ConflictError: он остаётся в цепочке и даёт 409
+			return "", fmt.Errorf("failed to release previous reservation: %w", err)
+		}
+	}
+
+	reservationUUID, err := s.inventoryClient.ReserveParts(ctx, order.OrderUUID, model.ReservationItemsFromOrderItems(items), s.reservationTTL)
+	if err != nil {
+		logger.Warn(ctx, "Failed to reserve parts for edited order",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.Error(err),
+		)
+
+		// Конфликт означает, что резерв уже держит параллельная правка — восстанавливать нечего
+		conflict := &model.ConflictError{}
+		if !errors.As(err, &conflict) {
+			s.restoreReservation(ctx, order)
+		}
+		return "", err
+	}
+
+	return reservationUUID, nil
+}
+
+// restoreReservation заново резервирует прежний состав заказа, если новый зарезервировать не удалось.
+// Ошибка только логируется: без резерва заказ всё равно можно отредактировать ещё раз или отменить.
+func (s *service) restoreReservation(ctx context.Context, order *model.Order) {
+	reservationUUID, err := s.inventoryClient.ReserveParts(ctx, order.OrderUUID, model.ReservationItemsFromOrderItems(order.Items), s.reservationTTL)
+	if err != nil {
+		logger.Error(ctx, "Failed to restore reservation of edited order",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	restored := *order
+	restored.ReservationUUID = &reservationUUID
+
+	err = s.repository.UpdateOrder(ctx, order.OrderUUID, &restored)
+	if err != nil {
+		logger.Error(ctx, "Failed to save restored reservation of edited order",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.String("reservation_uuid", reservationUUID),
+			zap.Error(err),
+		)
+	}
+}
+
+// discardReservation снимает резерв, который не удалось сохранить в заказе.
+// Если параллельная правка с тем же составом уже сохранила этот же резерв, он остаётся за заказом.
+func (s *service) discardReservation(ctx context.Context, orderUUID, reservationUUID string) {
+	current, err := s.repository.GetOrder(ctx, orderUUID)
+	if err == nil && current.ReservationUUID != nil && *current.ReservationUUID == reservationUUID {
+		return
+	}
+
+	s.releaseReservation(ctx, &model.Order{OrderUUID: orderUUID, ReservationUUID: &reservationUUID})
+}
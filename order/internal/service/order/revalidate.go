@@ -0,0 +1,143 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	inventoryV1 "github.com/ZanDattSu/star-factory/order/internal/client/grpc/inventory/v1"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+// revalidateOrder сверяет позиции заказа с текущим каталогом перед списанием.
+// Если деталь сняли с продажи или её цена ушла от цены в заказе дальше допустимого,
+// возвращает PriceChangedError со стоимостью заказа по текущему каталогу.
+func (s *service) revalidateOrder(ctx context.Context, order *model.Order) error {
+	if len(order.Items) == 0 {
+		return nil
+	}
+
+	partUuids := lo.Map(order.Items, func(item model.OrderItem, _ int) string { return item.PartUUID })
+
+	parts, err := s.inventoryClient.ListParts(ctx, model.PartsFilter{Uuids: partUuids})
+	if err != nil {
+		notFound := &inventoryV1.PartsNotFoundError{}
+		if !errors.As(err, &notFound) {
+			return fmt.Errorf("failed to revalidate order prices: %w", err)
+		}
+		// Ни одной детали заказа в каталоге не осталось
+		parts = nil
+	}
+
+	partsByUUID := lo.KeyBy(parts, func(part *model.Part) string { return part.Uuid })
+
+	var (
+		current     []model.OrderItem
+		changed     []string
+		unavailable []string
+	)
+	for _, item := range order.Items {
+		part, ok := partsByUUID[item.PartUUID]
+		if !ok {
+			unavailable = append(unavailable, item.PartUUID)
+			continue
+		}
+
+		if priceDrifted(item.UnitPrice, part.Price, s.priceTolerancePercent) {
+			changed = append(changed, item.PartUUID)
+		}
+
+		item.PartName = part.Name
+		item.UnitPrice = part.Price
+		current = append(current, item)
+	}
+
+	if len(changed) == 0 && len(unavailable) == 0 {
+		return nil
+	}
+
+	quote, err := s.repriceOrder(ctx, order, current, partsByUUID)
+	if err != nil {
+		return err
+	}
+
+	logger.Warn(ctx, "Order prices changed since creation, payment rejected",
+		zap.String("order_uuid", order.OrderUUID),
+		zap.Strings("changed_part_uuids", changed),
+		zap.Strings("unavailable_part_uuids", unavailable),
+		zap.Stringer("order_total", order.TotalPrice),
+		zap.Stringer("current_total", quote.Total),
+	)
+
+	return model.NewPriceChangedError(changed, unavailable, quote)
+}
+
+// repriceOrder считает стоимость доступных позиций заказа по текущим ценам с промокодом заказа.
+// Если промокод больше не подходит ни к одной позиции, стоимость считается без скидки,
+// чтобы клиент всё равно получил новую оценку заказа.
+func (s *service) repriceOrder(
+	ctx context.Context,
+	order *model.Order,
+	items []model.OrderItem,
+	partsByUUID map[string]*model.Part,
+) (*model.Quote, error) {
+	subtotal := money.Zero(order.Subtotal.Currency)
+	for _, item := range items {
+		var err error
+		subtotal, err = subtotal.Add(item.Total())
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate current order total: %w", err)
+		}
+	}
+
+	discount := money.Zero(subtotal.Currency)
+	promoCode := order.PromoCode
+	if promoCode != nil {
+		var err error
+		discount, err = s.recalculatePromoDiscount(ctx, *promoCode, items, partsByUUID)
+		if err != nil {
+			badRequest := &model.BadRequestError{}
+			if !errors.As(err, &badRequest) {
+				return nil, err
+			}
+
+			logger.Warn(ctx, "Promo code no longer applies to the order",
+				zap.String("order_uuid", order.OrderUUID),
+				zap.String("promo_code", *promoCode),
+				zap.Error(err),
+			)
+			discount = money.Zero(subtotal.Currency)
+			promoCode = nil
+		}
+	}
+
+	total, err := subtotal.Sub(discount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate current order total: %w", err)
+	}
+
+	return &model.Quote{
+		UserUUID:  order.UserUUID,
+		Items:     items,
+		Subtotal:  subtotal,
+		Discount:  discount,
+		Total:     total,
+		PromoCode: promoCode,
+	}, nil
+}
+
+// priceDrifted сообщает, что текущая цена отличается от цены в заказе больше чем на tolerancePercent процентов.
+func priceDrifted(ordered, current money.Money, tolerancePercent float64) bool {
+	if ordered.Currency != current.Currency {
+		return true
+	}
+
+	diff := math.Abs(float64(current.Amount - ordered.Amount))
+	return diff*100 > tolerancePercent*float64(ordered.Amount)
+}
@@ -0,0 +1,167 @@
+package order
+
+import (
+	"errors"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+
+	inventoryV1 "github.com/ZanDattSu/star-factory/order/internal/client/grpc/inventory/v1"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+// orderAwaitingPayment возвращает заказ с двумя позициями, готовый к оплате.
+func orderAwaitingPayment() (*model.Order, string, string) {
+	engineUUID, wingUUID := gofakeit.UUID(), gofakeit.UUID()
+
+	order := pendingOrder()
+	order.Items = []model.OrderItem{
+		{PartUUID: engineUUID, PartName: "Engine", Quantity: 1, UnitPrice: money.New(10000, money.DefaultCurrency)},
+		{PartUUID: wingUUID, PartName: "Wing", Quantity: 2, UnitPrice: money.New(5000, money.DefaultCurrency)},
+	}
+	order.PartUuids = []string{engineUUID, wingUUID}
+	order.TotalPrice = money.New(20000, money.DefaultCurrency)
+
+	return order, engineUUID, wingUUID
+}
+
+func (s *SuiteService) TestPayOrderRejectedWhenPriceChanged() {
+	order, engineUUID, wingUUID := orderAwaitingPayment()
+
+	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).Return(order, nil).Once()
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, model.PartsFilter{Uuids: []string{engineUUID, wingUUID}}).
+		Return([]*model.Part{
+			{Uuid: engineUUID, Name: "Engine", Category: model.CategoryEngine, Price: money.New(12000, money.DefaultCurrency)},
+			{Uuid: wingUUID, Name: "Wing", Category: model.CategoryWing, Price: money.New(5000, money.DefaultCurrency)},
+		}, nil).
+		Once()
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, order.UserUUID, RandomPaymentMethod(), order.OrderUUID, "")
+
+	s.Require().Error(err)
+	s.Require().Empty(transactionUUID)
+
+	var priceChanged *model.PriceChangedError
+	s.Require().True(errors.As(err, &priceChanged))
+	s.Require().Equal([]string{engineUUID}, priceChanged.ChangedPartUUIDs)
+	s.Require().Empty(priceChanged.UnavailablePartUUIDs)
+	s.Require().Equal(money.New(22000, money.DefaultCurrency), priceChanged.Quote.Total)
+
+	s.paymentClient.AssertNotCalled(s.T(), "PayOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestPayOrderRejectedWhenPartDiscontinued() {
+	order, engineUUID, wingUUID := orderAwaitingPayment()
+
+	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).Return(order, nil).Once()
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, mock.Anything).
+		Return([]*model.Part{
+			{Uuid: engineUUID, Name: "Engine", Category: model.CategoryEngine, Price: money.New(10000, money.DefaultCurrency)},
+		}, nil).
+		Once()
+
+	_, err := s.service.PayOrder(s.ctx, order.UserUUID, RandomPaymentMethod(), order.OrderUUID, "")
+
+	var priceChanged *model.PriceChangedError
+	s.Require().True(errors.As(err, &priceChanged))
+	s.Require().Empty(priceChanged.ChangedPartUUIDs)
+	s.Require().Equal([]string{wingUUID}, priceChanged.UnavailablePartUUIDs)
+	s.Require().Len(priceChanged.Quote.Items, 1)
+	s.Require().Equal(money.New(10000, money.DefaultCurrency), priceChanged.Quote.Total)
+}
+
+func (s *SuiteService) TestPayOrderRejectedWhenAllPartsDiscontinued() {
+	order, engineUUID, wingUUID := orderAwaitingPayment()
+
+	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).Return(order, nil).Once()
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, mock.Anything).
+		Return(nil, inventoryV1.NewPartsNotFoundError([]string{engineUUID, wingUUID})).
+		Once()
+
+	_, err := s.service.PayOrder(s.ctx, order.UserUUID, RandomPaymentMethod(), order.OrderUUID, "")
+
+	var priceChanged *model.PriceChangedError
+	s.Require().True(errors.As(err, &priceChanged))
+	s.Require().Equal([]string{engineUUID, wingUUID}, priceChanged.UnavailablePartUUIDs)
+	s.Require().Empty(priceChanged.Quote.Items)
+	s.Require().True(priceChanged.Quote.Total.IsZero())
+}
+
+func (s *SuiteService) TestPayOrderQuotesWithoutPromoWhenPromoNoLongerApplies() {
+	order, engineUUID, wingUUID := orderAwaitingPayment()
+	order.PromoCode = lo.ToPtr("WINGS10")
+
+	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).Return(order, nil).Once()
+
+	// Крыло, на которое действовал промокод, сняли с продажи
+	s.inventoryClient.
+		On("ListParts", s.ctx, mock.Anything).
+		Return([]*model.Part{
+			{Uuid: engineUUID, Name: "Engine", Category: model.CategoryEngine, Price: money.New(10000, money.DefaultCurrency)},
+		}, nil).
+		Once()
+
+	s.promoCodeRepository.
+		On("GetPromoCode", s.ctx, "WINGS10").
+		Return(&model.PromoCode{
+			Code:         "WINGS10",
+			DiscountType: model.DiscountTypePERCENT,
+			PercentOff:   10,
+			Categories:   []model.Category{model.CategoryWing},
+			Active:       true,
+		}, nil).
+		Once()
+
+	_, err := s.service.PayOrder(s.ctx, order.UserUUID, RandomPaymentMethod(), order.OrderUUID, "")
+
+	var priceChanged *model.PriceChangedError
+	s.Require().ErrorAs(err, &priceChanged)
+	s.Require().Equal([]string{wingUUID}, priceChanged.UnavailablePartUUIDs)
+	s.Require().True(priceChanged.Quote.Discount.IsZero())
+	s.Require().Nil(priceChanged.Quote.PromoCode)
+	s.Require().Equal(money.New(10000, money.DefaultCurrency), priceChanged.Quote.Total)
+}
+
+func (s *SuiteService) TestPayOrderAcceptsPriceChangeWithinTolerance() {
+	s.service.priceTolerancePercent = 5
+
+	order, engineUUID, wingUUID := orderAwaitingPayment()
+	transactionUUID := gofakeit.UUID()
+
+	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).Return(order, nil).Once()
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, mock.Anything).
+		Return([]*model.Part{
+			{Uuid: engineUUID, Name: "Engine", Category: model.CategoryEngine, Price: money.New(10400, money.DefaultCurrency)},
+			{Uuid: wingUUID, Name: "Wing", Category: model.CategoryWing, Price: money.New(4800, money.DefaultCurrency)},
+		}, nil).
+		Once()
+
+	s.inventoryClient.On("CommitReservation", s.ctx, *order.ReservationUUID).Return(nil).Once()
+
+	// Списывается сумма, зафиксированная при оформлении
+	s.paymentClient.
+		On("PayOrder", s.ctx, order.OrderUUID, order.UserUUID, model.PaymentMethodSbp, order.TotalPrice).
+		Return(transactionUUID, nil).
+		Once()
+
+	s.orderRepository.
+		On("UpdateOrderStatus", s.ctx, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	got, err := s.service.PayOrder(s.ctx, order.UserUUID, model.PaymentMethodSbp, order.OrderUUID, "")
+
+	s.Require().NoError(err)
+	s.Require().Equal(transactionUUID, got)
+	s.Require().Equal(lo.ToPtr(transactionUUID), order.TransactionUUID)
+}
@@ -1,7 +1,10 @@
 package order
 
 import (
+	"time"
+
 	gRPCClient "github.com/ZanDattSu/star-factory/order/internal/client/grpc"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
 	"github.com/ZanDattSu/star-factory/order/internal/repository"
 	srvc "github.com/ZanDattSu/star-factory/order/internal/service"
 )
@@ -10,22 +13,46 @@ import (
 var _ srvc.OrderService = (*service)(nil)
 
 type service struct {
-	repository           repository.OrderRepository
-	paymentClient        gRPCClient.PaymentClient
-	inventoryClient      gRPCClient.InventoryClient
-	orderProducerService srvc.OrderProducerService
+	repository            repository.OrderRepository
+	idempotencyRepository repository.IdempotencyRepository
+	promoCodeRepository   repository.PromoCodeRepository
+	quoteRepository       repository.QuoteRepository
+	cartRepository        repository.CartRepository
+	paymentClient         gRPCClient.PaymentClient
+	inventoryClient       gRPCClient.InventoryClient
+	statusNotifier        srvc.OrderStatusNotifier
+	quoteTTL              time.Duration
+	reservationTTL        time.Duration
+	blueprint             model.ShipBlueprint
+	priceTolerancePercent float64
 }
 
 func NewService(
 	repository repository.OrderRepository,
+	idempotencyRepository repository.IdempotencyRepository,
+	promoCodeRepository repository.PromoCodeRepository,
+	quoteRepository repository.QuoteRepository,
+	cartRepository repository.CartRepository,
 	payClient gRPCClient.PaymentClient,
 	invClient gRPCClient.InventoryClient,
-	orderProducerService srvc.OrderProducerService,
+	statusNotifier srvc.OrderStatusNotifier,
+	quoteTTL time.Duration,
+	reservationTTL time.Duration,
+	blueprint model.ShipBlueprint,
+	priceTolerancePercent float64,
 ) *service {
 	return &service{
-		repository:           repository,
-		paymentClient:        payClient,
-		inventoryClient:      invClient,
-		orderProducerService: orderProducerService,
+		repository:            repository,
+		idempotencyRepository: idempotencyRepository,
+		promoCodeRepository:   promoCodeRepository,
+		quoteRepository:       quoteRepository,
+		cartRepository:        cartRepository,
+		paymentClient:         payClient,
+		inventoryClient:       invClient,
+		statusNotifier:        statusNotifier,
+		quoteTTL:              quoteTTL,
+		reservationTTL:        reservationTTL,
+		blueprint:             blueprint,
+		priceTolerancePercent: priceTolerancePercent,
 	}
 }
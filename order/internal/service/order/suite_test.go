@@ -3,12 +3,14 @@ package order
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	clientMocks "github.com/ZanDattSu/star-factory/order/internal/client/grpc/mocks"
+	"github.com/ZanDattSu/star-factory/order/internal/model"
 	"github.com/ZanDattSu/star-factory/order/internal/repository/mocks"
-	serviceMocks "github.com/ZanDattSu/star-factory/order/internal/service/mocks"
+	"github.com/ZanDattSu/star-factory/order/internal/service/notifier/status_notifier"
 	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
 )
 
@@ -17,10 +19,13 @@ type SuiteService struct {
 
 	ctx context.Context //nolint:containedctx
 
-	orderRepository      *mocks.OrderRepository
-	paymentClient        *clientMocks.PaymentClient
-	inventoryClient      *clientMocks.InventoryClient
-	orderProducerService *serviceMocks.OrderProducerService
+	orderRepository       *mocks.OrderRepository
+	idempotencyRepository *mocks.IdempotencyRepository
+	promoCodeRepository   *mocks.PromoCodeRepository
+	quoteRepository       *mocks.QuoteRepository
+	cartRepository        *mocks.CartRepository
+	paymentClient         *clientMocks.PaymentClient
+	inventoryClient       *clientMocks.InventoryClient
 
 	service *service
 }
@@ -29,15 +34,26 @@ func (s *SuiteService) SetupTest() {
 	s.ctx = context.Background()
 
 	s.orderRepository = mocks.NewOrderRepository(s.T())
+	s.idempotencyRepository = mocks.NewIdempotencyRepository(s.T())
+	s.promoCodeRepository = mocks.NewPromoCodeRepository(s.T())
+	s.quoteRepository = mocks.NewQuoteRepository(s.T())
+	s.cartRepository = mocks.NewCartRepository(s.T())
 	s.paymentClient = clientMocks.NewPaymentClient(s.T())
 	s.inventoryClient = clientMocks.NewInventoryClient(s.T())
-	s.orderProducerService = serviceMocks.NewOrderProducerService(s.T())
 
 	s.service = NewService(
 		s.orderRepository,
+		s.idempotencyRepository,
+		s.promoCodeRepository,
+		s.quoteRepository,
+		s.cartRepository,
 		s.paymentClient,
 		s.inventoryClient,
-		s.orderProducerService,
+		status_notifier.NewNotifier(),
+		15*time.Minute,
+		30*time.Minute,
+		model.ShipBlueprint{},
+		0,
 	)
 	logger.SetNopLogger()
 }
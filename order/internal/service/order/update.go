@@ -0,0 +1,109 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+func (s *service) UpdateOrderItems(
+	ctx context.Context,
+	userUUID, orderUUID string,
+	items []model.OrderItem,
+	version int64,
+) (*model.Order, error) {
+	items = model.MergeOrderItems(items)
+
+	logger.Info(ctx, "Updating order items",
+		zap.String("order_uuid", orderUUID),
+		zap.Int("items_count", len(items)),
+		zap.Int64("version", version),
+	)
+
+	if len(items) == 0 {
+		return nil, model.NewBadRequestError("order should contain at least 1 item")
+	}
+
+	order, err := s.repository.GetOrder(ctx, orderUUID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get order for update",
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+		return nil, model.NewOrderNotFoundError(orderUUID)
+	}
+
+	if err = checkOwnership(ctx, order, userUUID); err != nil {
+		return nil, err
+	}
+
+	if order.Status != model.OrderStatusPENDINGPAYMENT {
+		return nil, model.NewConflictError(fmt.Sprintf("cannot edit order in status %s", order.Status))
+	}
+
+	// Ранняя проверка версии избавляет от лишних обращений в inventory; окончательно её проверяет UpdateOrder
+	if order.Version != version {
+		return nil, model.NewOrderVersionConflictError(orderUUID, version, order.Version)
+	}
+
+	partUuids, partsByUUID, subtotal, err := s.priceItems(ctx, userUUID, items)
+	if err != nil {
+		return nil, err
+	}
+
+	discount := money.Zero(subtotal.Currency)
+	if order.PromoCode != nil {
+		discount, err = s.recalculatePromoDiscount(ctx, *order.PromoCode, items, partsByUUID)
+		if err != nil {
+			logger.Warn(ctx, "Failed to update order: promo code does not apply to new items",
+				zap.String("order_uuid", orderUUID),
+				zap.String("promo_code", *order.PromoCode),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+	}
+
+	totalPrice, err := subtotal.Sub(discount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate order total: %w", err)
+	}
+
+	reservationUUID, err := s.swapReservation(ctx, order, items)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := *order
+	updated.PartUuids = partUuids
+	updated.Items = items
+	updated.Subtotal = subtotal
+	updated.Discount = discount
+	updated.TotalPrice = totalPrice
+	updated.ReservationUUID = &reservationUUID
+
+	err = s.repository.UpdateOrder(ctx, orderUUID, &updated)
+	if err != nil {
+		logger.Error(ctx, "Failed to save updated order",
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+		s.discardReservation(ctx, orderUUID, reservationUUID)
+		return nil, fmt.Errorf("failed to update order: %w", err)
+	}
+
+	logger.Info(ctx, "Order items updated successfully",
+		zap.String("order_uuid", orderUUID),
+		zap.Stringer("subtotal", subtotal),
+		zap.Stringer("discount", discount),
+		zap.Stringer("total_price", totalPrice),
+		zap.Int64("version", updated.Version),
+	)
+
+	return &updated, nil
+}
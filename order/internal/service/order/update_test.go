@@ -0,0 +1,263 @@
+package order
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+// pendingOrder возвращает заказ, который ещё можно редактировать.
+func pendingOrder() *model.Order {
+	order := RandomOrder()
+	order.Status = model.OrderStatusPENDINGPAYMENT
+	order.TransactionUUID = nil
+	order.ReservationUUID = lo.ToPtr(gofakeit.UUID())
+	order.Items = []model.OrderItem{{PartUUID: gofakeit.UUID(), Quantity: 1}}
+	order.Version = 3
+	return order
+}
+
+func (s *SuiteService) TestUpdateOrderItemsSuccess() {
+	order := pendingOrder()
+	order.PromoCode = lo.ToPtr("ENGINE10")
+	oldReservationUUID := *order.ReservationUUID
+	newReservationUUID := gofakeit.UUID()
+	engineUUID, wingUUID := gofakeit.UUID(), gofakeit.UUID()
+
+	items := []model.OrderItem{
+		{PartUUID: engineUUID, Quantity: 1},
+		{PartUUID: wingUUID, Quantity: 2},
+		{PartUUID: engineUUID, Quantity: 1},
+	}
+
+	// Второй раз заказ перечитывается перед сменой резерва
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).
+		Twice()
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, mock.Anything).
+		Return([]*model.Part{
+			{Uuid: engineUUID, Name: "Engine", Category: model.CategoryEngine, Price: money.New(10000, money.DefaultCurrency)},
+			{Uuid: wingUUID, Name: "Wing", Category: model.CategoryWing, Price: money.New(5000, money.DefaultCurrency)},
+		}, nil).
+		Once()
+
+	// Промокод мог исчерпать лимит после оформления, но для уже применённого кода это не важно
+	s.promoCodeRepository.
+		On("GetPromoCode", s.ctx, "ENGINE10").
+		Return(&model.PromoCode{
+			Code:         "ENGINE10",
+			DiscountType: model.DiscountTypePERCENT,
+			PercentOff:   10,
+			Categories:   []model.Category{model.CategoryEngine},
+			MaxUses:      lo.ToPtr(int64(1)),
+			UsedCount:    1,
+			Active:       true,
+		}, nil).
+		Once()
+
+	s.inventoryClient.
+		On("ReleaseReservation", s.ctx, oldReservationUUID).
+		Return(nil).
+		Once()
+
+	s.inventoryClient.
+		On("ReserveParts", s.ctx, order.OrderUUID, []model.ReservationItem{
+			{PartUUID: engineUUID, Quantity: 2},
+			{PartUUID: wingUUID, Quantity: 2},
+		}, 30*time.Minute).
+		Return(newReservationUUID, nil).
+		Once()
+
+	// Двигатели: 2 * 100.00, крылья: 2 * 50.00, скидка 10% только на двигатели
+	expected := model.OrderPrice{
+		Subtotal: money.New(30000, money.DefaultCurrency),
+		Discount: money.New(2000, money.DefaultCurrency),
+		Total:    money.New(28000, money.DefaultCurrency),
+	}
+
+	s.orderRepository.
+		On("UpdateOrder", s.ctx, order.OrderUUID, mock.MatchedBy(func(o *model.Order) bool {
+			return o.Price() == expected &&
+				o.Version == 3 &&
+				len(o.Items) == 2 &&
+				o.Items[0].PartName == "Engine" &&
+				*o.ReservationUUID == newReservationUUID
+		})).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*model.Order).Version++
+		}).
+		Return(nil).
+		Once()
+
+	updated, err := s.service.UpdateOrderItems(s.ctx, order.UserUUID, order.OrderUUID, items, 3)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, updated.Price())
+	s.Require().Equal(int64(4), updated.Version)
+	s.Require().Equal([]string{engineUUID, wingUUID}, updated.PartUuids)
+}
+
+func (s *SuiteService) TestUpdateOrderItemsStaleVersion() {
+	order := pendingOrder()
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).
+		Once()
+
+	items := []model.OrderItem{{PartUUID: gofakeit.UUID(), Quantity: 1}}
+	_, err := s.service.UpdateOrderItems(s.ctx, order.UserUUID, order.OrderUUID, items, 2)
+
+	var conflict *model.OrderVersionConflictError
+	s.Require().ErrorAs(err, &conflict)
+	s.Require().Equal(int64(2), conflict.ExpectedVersion)
+	s.Require().Equal(int64(3), conflict.ActualVersion)
+}
+
+func (s *SuiteService) TestUpdateOrderItemsNotPending() {
+	order := pendingOrder()
+	order.Status = model.OrderStatusPAID
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).
+		Once()
+
+	items := []model.OrderItem{{PartUUID: gofakeit.UUID(), Quantity: 1}}
+	_, err := s.service.UpdateOrderItems(s.ctx, order.UserUUID, order.OrderUUID, items, order.Version)
+
+	var conflict *model.ConflictError
+	s.Require().ErrorAs(err, &conflict)
+}
+
+func (s *SuiteService) TestUpdateOrderItemsForbidden() {
+	order := pendingOrder()
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).
+		Once()
+
+	items := []model.OrderItem{{PartUUID: gofakeit.UUID(), Quantity: 1}}
+	_, err := s.service.UpdateOrderItems(s.ctx, gofakeit.UUID(), order.OrderUUID, items, order.Version)
+
+	var forbidden *model.ForbiddenError
+	s.Require().ErrorAs(err, &forbidden)
+}
+
+func (s *SuiteService) TestUpdateOrderItemsInsufficientStockRestoresReservation() {
+	order := pendingOrder()
+	oldReservationUUID := *order.ReservationUUID
+	restoredReservationUUID := gofakeit.UUID()
+	partUUID := gofakeit.UUID()
+
+	// Второй раз заказ перечитывается перед сменой резерва
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).
+		Twice()
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, mock.Anything).
+		Return([]*model.Part{
+			{Uuid: partUUID, Price: money.New(10000, money.DefaultCurrency)},
+		}, nil).
+		Once()
+
+	s.inventoryClient.
+		On("ReleaseReservation", s.ctx, oldReservationUUID).
+		Return(nil).
+		Once()
+
+	s.inventoryClient.
+		On("ReserveParts", s.ctx, order.OrderUUID, []model.ReservationItem{{PartUUID: partUUID, Quantity: 5}}, 30*time.Minute).
+		Return("", model.NewInsufficientStockError([]string{partUUID})).
+		Once()
+
+	s.inventoryClient.
+		On("ReserveParts", s.ctx, order.OrderUUID, model.ReservationItemsFromOrderItems(order.Items), 30*time.Minute).
+		Return(restoredReservationUUID, nil).
+		Once()
+
+	// Прежний состав сохраняется вместе с новым резервом
+	s.orderRepository.
+		On("UpdateOrder", s.ctx, order.OrderUUID, mock.MatchedBy(func(o *model.Order) bool {
+			return *o.ReservationUUID == restoredReservationUUID &&
+				len(o.Items) == 1 && o.Items[0].PartUUID == order.Items[0].PartUUID
+		})).
+		Return(nil).
+		Once()
+
+	items := []model.OrderItem{{PartUUID: partUUID, Quantity: 5}}
+	_, err := s.service.UpdateOrderItems(s.ctx, order.UserUUID, order.OrderUUID, items, order.Version)
+
+	var shortage *model.InsufficientStockError
+	s.Require().ErrorAs(err, &shortage)
+}
+
+func (s *SuiteService) TestUpdateOrderItemsConcurrentEditBeforeSwap() {
+	order := pendingOrder()
+	partUUID := gofakeit.UUID()
+
+	// Параллельная правка сохранила заказ, пока считались цены
+	edited := *order
+	edited.Version = order.Version + 1
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).
+		Once()
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(&edited, nil).
+		Once()
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, mock.Anything).
+		Return([]*model.Part{{Uuid: partUUID, Price: money.New(10000, money.DefaultCurrency)}}, nil).
+		Once()
+
+	items := []model.OrderItem{{PartUUID: partUUID, Quantity: 1}}
+	_, err := s.service.UpdateOrderItems(s.ctx, order.UserUUID, order.OrderUUID, items, order.Version)
+
+	var conflict *model.OrderVersionConflictError
+	s.Require().ErrorAs(err, &conflict)
+	s.inventoryClient.AssertNotCalled(s.T(), "ReleaseReservation", mock.Anything, mock.Anything)
+	s.inventoryClient.AssertNotCalled(s.T(), "ReserveParts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestUpdateOrderItemsReservationReleasedConcurrently() {
+	order := pendingOrder()
+	partUUID := gofakeit.UUID()
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).
+		Twice()
+
+	s.inventoryClient.
+		On("ListParts", s.ctx, mock.Anything).
+		Return([]*model.Part{{Uuid: partUUID, Price: money.New(10000, money.DefaultCurrency)}}, nil).
+		Once()
+
+	// Параллельная правка сняла резерв между перечитыванием заказа и обращением в inventory
+	s.inventoryClient.
+		On("ReleaseReservation", s.ctx, *order.ReservationUUID).
+		Return(model.NewConflictError("parts reservation was already released or committed, reload the order and try again")).
+		Once()
+
+	items := []model.OrderItem{{PartUUID: partUUID, Quantity: 1}}
+	_, err := s.service.UpdateOrderItems(s.ctx, order.UserUUID, order.OrderUUID, items, order.Version)
+
+	var conflict *model.ConflictError
+	s.Require().ErrorAs(err, &conflict)
+	s.inventoryClient.AssertNotCalled(s.T(), "ReserveParts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	s.orderRepository.AssertNotCalled(s.T(), "UpdateOrder", mock.Anything, mock.Anything, mock.Anything)
+}
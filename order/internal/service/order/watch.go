@@ -0,0 +1,28 @@
+package order
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+func (s *service) WatchOrderStatus(ctx context.Context, userUUID, orderUUID string) (<-chan struct{}, func(), error) {
+	order, err := s.repository.GetOrder(ctx, orderUUID)
+	if err != nil {
+		logger.Warn(ctx, "Order not found",
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+		return nil, nil, model.NewOrderNotFoundError(orderUUID)
+	}
+
+	if err = checkOwnership(ctx, order, userUUID); err != nil {
+		return nil, nil, err
+	}
+
+	updates, unsubscribe := s.statusNotifier.Subscribe(orderUUID)
+	return updates, unsubscribe, nil
+}
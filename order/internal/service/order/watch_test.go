@@ -0,0 +1,38 @@
+package order
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (s *SuiteService) TestWatchOrderStatusReceivesTransitions() {
+	order := RandomOrder()
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).
+		Once()
+
+	updates, unsubscribe, err := s.service.WatchOrderStatus(s.ctx, order.UserUUID, order.OrderUUID)
+	s.Require().NoError(err)
+	defer unsubscribe()
+
+	s.service.statusNotifier.Notify(order.OrderUUID)
+
+	s.Require().Len(updates, 1)
+}
+
+func (s *SuiteService) TestWatchOrderStatusForbidden() {
+	order := RandomOrder()
+
+	s.orderRepository.
+		On("GetOrder", s.ctx, order.OrderUUID).
+		Return(order, nil).
+		Once()
+
+	_, _, err := s.service.WatchOrderStatus(s.ctx, gofakeit.UUID(), order.OrderUUID)
+
+	var forbidden *model.ForbiddenError
+	s.Require().ErrorAs(err, &forbidden)
+}
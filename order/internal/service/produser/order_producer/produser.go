@@ -6,19 +6,40 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ZanDattSu/star-factory/order/internal/model"
 	"github.com/ZanDattSu/star-factory/platform/pkg/kafka"
 	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 	eventsV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/events/v1"
 )
 
 type service struct {
-	orderPaidProducer kafka.Producer
+	orderPaidProducer          kafka.Producer
+	orderRefundedProducer      kafka.Producer
+	orderExpiredProducer       kafka.Producer
+	orderCreatedProducer       kafka.Producer
+	orderCancelledProducer     kafka.Producer
+	orderStatusChangedProducer kafka.Producer
 }
 
-func NewService(orderPaidProducer kafka.Producer) *service {
-	return &service{orderPaidProducer: orderPaidProducer}
+func NewService(
+	orderPaidProducer kafka.Producer,
+	orderRefundedProducer kafka.Producer,
+	orderExpiredProducer kafka.Producer,
+	orderCreatedProducer kafka.Producer,
+	orderCancelledProducer kafka.Producer,
+	orderStatusChangedProducer kafka.Producer,
+) *service {
+	return &service{
+		orderPaidProducer:          orderPaidProducer,
+		orderRefundedProducer:      orderRefundedProducer,
+		orderExpiredProducer:       orderExpiredProducer,
+		orderCreatedProducer:       orderCreatedProducer,
+		orderCancelledProducer:     orderCancelledProducer,
+		orderStatusChangedProducer: orderStatusChangedProducer,
+	}
 }
 
 func (s *service) ProduceOrderPaid(ctx context.Context, event model.OrderPaidEvent) error {
@@ -42,6 +63,7 @@ func (s *service) ProduceOrderPaid(ctx context.Context, event model.OrderPaidEve
 	}
 
 	msg := &eventsV1.OrderPaid{
+		EventUuid:       event.EventUuid,
 		OrderUuid:       event.OrderUuid,
 		UserUuid:        event.UserUuid,
 		PaymentMethod:   eventsV1.PaymentMethod(int32(id)), //nolint:gosec // unavoidable proto enum cast
@@ -77,3 +99,246 @@ func (s *service) ProduceOrderPaid(ctx context.Context, event model.OrderPaidEve
 
 	return nil
 }
+
+func (s *service) ProduceOrderRefunded(ctx context.Context, event model.OrderRefundedEvent) error {
+	logger.Info(ctx, "Producing OrderRefunded event",
+		zap.String("event_uuid", event.EventUuid),
+		zap.String("order_uuid", event.OrderUuid),
+		zap.String("user_uuid", event.UserUuid),
+		zap.String("transaction_uuid", event.TransactionUuid),
+		zap.String("refund_uuid", event.RefundUuid),
+	)
+
+	msg := &eventsV1.OrderRefunded{
+		EventUuid:       event.EventUuid,
+		OrderUuid:       event.OrderUuid,
+		UserUuid:        event.UserUuid,
+		TransactionUuid: event.TransactionUuid,
+		RefundUuid:      event.RefundUuid,
+		Amount:          money.ToProto(event.Amount),
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal OrderRefunded event",
+			zap.String("event_uuid", event.EventUuid),
+			zap.String("order_uuid", event.OrderUuid),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	err = s.orderRefundedProducer.Send(ctx, []byte(event.OrderUuid), payload)
+	if err != nil {
+		logger.Error(ctx, "Failed to publish OrderRefunded event",
+			zap.String("event_uuid", event.EventUuid),
+			zap.String("order_uuid", event.OrderUuid),
+			zap.String("refund_uuid", event.RefundUuid),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	logger.Info(ctx, "OrderRefunded event published successfully",
+		zap.String("event_uuid", event.EventUuid),
+		zap.String("order_uuid", event.OrderUuid),
+		zap.String("refund_uuid", event.RefundUuid),
+	)
+
+	return nil
+}
+
+func (s *service) ProduceOrderExpired(ctx context.Context, event model.OrderExpiredEvent) error {
+	logger.Info(ctx, "Producing OrderExpired event",
+		zap.String("event_uuid", event.EventUuid),
+		zap.String("order_uuid", event.OrderUuid),
+		zap.String("user_uuid", event.UserUuid),
+	)
+
+	msg := &eventsV1.OrderExpired{
+		EventUuid: event.EventUuid,
+		OrderUuid: event.OrderUuid,
+		UserUuid:  event.UserUuid,
+		ExpiredAt: timestamppb.New(event.ExpiredAt),
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal OrderExpired event",
+			zap.String("event_uuid", event.EventUuid),
+			zap.String("order_uuid", event.OrderUuid),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	err = s.orderExpiredProducer.Send(ctx, []byte(event.OrderUuid), payload)
+	if err != nil {
+		logger.Error(ctx, "Failed to publish OrderExpired event",
+			zap.String("event_uuid", event.EventUuid),
+			zap.String("order_uuid", event.OrderUuid),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	logger.Info(ctx, "OrderExpired event published successfully",
+		zap.String("event_uuid", event.EventUuid),
+		zap.String("order_uuid", event.OrderUuid),
+	)
+
+	return nil
+}
+
+func (s *service) ProduceOrderCreated(ctx context.Context, event model.OrderCreatedEvent) error {
+	logger.Info(ctx, "Producing OrderCreated event",
+		zap.String("event_uuid", event.EventUuid),
+		zap.String("order_uuid", event.OrderUuid),
+		zap.String("user_uuid", event.UserUuid),
+		zap.Int("items_count", len(event.Items)),
+	)
+
+	items := make([]*eventsV1.OrderItem, 0, len(event.Items))
+	for _, item := range event.Items {
+		items = append(items, &eventsV1.OrderItem{
+			PartUuid:  item.PartUUID,
+			PartName:  item.PartName,
+			Quantity:  item.Quantity,
+			UnitPrice: money.ToProto(item.UnitPrice),
+		})
+	}
+
+	msg := &eventsV1.OrderCreated{
+		EventUuid:  event.EventUuid,
+		OrderUuid:  event.OrderUuid,
+		UserUuid:   event.UserUuid,
+		Items:      items,
+		TotalPrice: money.ToProto(event.TotalPrice),
+		CreatedAt:  timestamppb.New(event.CreatedAt),
+	}
+
+	return s.send(ctx, s.orderCreatedProducer, "OrderCreated", event.EventUuid, event.OrderUuid, msg)
+}
+
+func (s *service) ProduceOrderCancelled(ctx context.Context, event model.OrderCancelledEvent) error {
+	logger.Info(ctx, "Producing OrderCancelled event",
+		zap.String("event_uuid", event.EventUuid),
+		zap.String("order_uuid", event.OrderUuid),
+		zap.String("user_uuid", event.UserUuid),
+		zap.String("previous_status", string(event.PreviousStatus)),
+		zap.String("actor", event.Actor),
+	)
+
+	previousStatus, err := orderStatusToProto(event.PreviousStatus)
+	if err != nil {
+		logger.Error(ctx, "Invalid order status",
+			zap.String("event_uuid", event.EventUuid),
+			zap.String("order_uuid", event.OrderUuid),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	msg := &eventsV1.OrderCancelled{
+		EventUuid:      event.EventUuid,
+		OrderUuid:      event.OrderUuid,
+		UserUuid:       event.UserUuid,
+		PreviousStatus: previousStatus,
+		Actor:          event.Actor,
+		Reason:         event.Reason,
+		CancelledAt:    timestamppb.New(event.CancelledAt),
+	}
+
+	return s.send(ctx, s.orderCancelledProducer, "OrderCancelled", event.EventUuid, event.OrderUuid, msg)
+}
+
+func (s *service) ProduceOrderStatusChanged(ctx context.Context, event model.OrderStatusChangedEvent) error {
+	logger.Info(ctx, "Producing OrderStatusChanged event",
+		zap.String("event_uuid", event.EventUuid),
+		zap.String("order_uuid", event.OrderUuid),
+		zap.String("from_status", string(event.FromStatus)),
+		zap.String("to_status", string(event.ToStatus)),
+	)
+
+	fromStatus, err := orderStatusToProto(event.FromStatus)
+	if err != nil {
+		logger.Error(ctx, "Invalid order status",
+			zap.String("event_uuid", event.EventUuid),
+			zap.String("order_uuid", event.OrderUuid),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	toStatus, err := orderStatusToProto(event.ToStatus)
+	if err != nil {
+		logger.Error(ctx, "Invalid order status",
+			zap.String("event_uuid", event.EventUuid),
+			zap.String("order_uuid", event.OrderUuid),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	msg := &eventsV1.OrderStatusChanged{
+		EventUuid:  event.EventUuid,
+		OrderUuid:  event.OrderUuid,
+		UserUuid:   event.UserUuid,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		Actor:      event.Actor,
+		Reason:     event.Reason,
+		ChangedAt:  timestamppb.New(event.ChangedAt),
+	}
+
+	return s.send(ctx, s.orderStatusChangedProducer, "OrderStatusChanged", event.EventUuid, event.OrderUuid, msg)
+}
+
+// send сериализует событие и публикует его с UUID заказа в качестве ключа,
+// чтобы все события одного заказа попадали в одну партицию и читались по порядку.
+func (s *service) send(ctx context.Context, producer kafka.Producer, name, eventUUID, orderUUID string, msg proto.Message) error {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal "+name+" event",
+			zap.String("event_uuid", eventUUID),
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	err = producer.Send(ctx, []byte(orderUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "Failed to publish "+name+" event",
+			zap.String("event_uuid", eventUUID),
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	logger.Info(ctx, name+" event published successfully",
+		zap.String("event_uuid", eventUUID),
+		zap.String("order_uuid", orderUUID),
+	)
+
+	return nil
+}
+
+var orderStatusToProtoMap = map[model.OrderStatus]eventsV1.OrderStatus{
+	model.OrderStatusUNSPECIFIED:    eventsV1.OrderStatus_ORDER_STATUS_UNSPECIFIED,
+	model.OrderStatusPENDINGPAYMENT: eventsV1.OrderStatus_ORDER_STATUS_PENDING_PAYMENT,
+	model.OrderStatusPAID:           eventsV1.OrderStatus_ORDER_STATUS_PAID,
+	model.OrderStatusCANCELLED:      eventsV1.OrderStatus_ORDER_STATUS_CANCELLED,
+	model.OrderStatusASSEMBLED:      eventsV1.OrderStatus_ORDER_STATUS_ASSEMBLED,
+	model.OrderStatusREFUNDED:       eventsV1.OrderStatus_ORDER_STATUS_REFUNDED,
+	model.OrderStatusREFUNDING:      eventsV1.OrderStatus_ORDER_STATUS_REFUNDING,
+}
+
+func orderStatusToProto(status model.OrderStatus) (eventsV1.OrderStatus, error) {
+	s, ok := orderStatusToProtoMap[status]
+	if !ok {
+		return eventsV1.OrderStatus_ORDER_STATUS_UNSPECIFIED, fmt.Errorf("unknown order status: %s", status)
+	}
+	return s, nil
+}
@@ -0,0 +1,47 @@
+package promo
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+func (s *service) CreatePromoCode(ctx context.Context, userUUID string, promo model.PromoCode) (*model.PromoCode, error) {
+	if err := s.checkAdmin(ctx, userUUID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	promo.Code = model.NormalizePromoCode(promo.Code)
+	promo.UsedCount = 0
+	promo.Active = true
+	promo.CreatedAt = now
+
+	if err := promo.Validate(); err != nil {
+		return nil, err
+	}
+	if promo.ExpiresAt != nil && !promo.ExpiresAt.After(now) {
+		return nil, model.NewBadRequestError("expires_at must be in the future")
+	}
+
+	if err := s.repository.CreatePromoCode(ctx, &promo); err != nil {
+		logger.Error(ctx, "Failed to create promo code",
+			zap.String("promo_code", promo.Code),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	logger.Info(ctx, "Promo code created",
+		zap.String("promo_code", promo.Code),
+		zap.String("discount_type", string(promo.DiscountType)),
+		zap.String("user_uuid", userUUID),
+	)
+
+	return &promo, nil
+}
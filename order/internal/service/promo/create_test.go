@@ -0,0 +1,72 @@
+package promo
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+func (s *SuiteService) TestCreatePromoCodeSuccess() {
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	s.promoCodeRepository.
+		On("CreatePromoCode", s.ctx, mock.MatchedBy(func(p *model.PromoCode) bool {
+			return p.Code == "ENGINE10" &&
+				p.Active &&
+				p.UsedCount == 0 &&
+				!p.CreatedAt.IsZero()
+		})).
+		Return(nil).
+		Once()
+
+	promo, err := s.service.CreatePromoCode(s.ctx, s.adminUUID, model.PromoCode{
+		Code:         " engine10",
+		DiscountType: model.DiscountTypePERCENT,
+		PercentOff:   10,
+		Categories:   []model.Category{model.CategoryEngine},
+		ExpiresAt:    &expiresAt,
+		UsedCount:    7,
+	})
+
+	s.Require().NoError(err)
+	s.Require().Equal("ENGINE10", promo.Code)
+}
+
+func (s *SuiteService) TestCreatePromoCodeInvalid() {
+	past := time.Now().Add(-time.Hour)
+
+	tests := map[string]model.PromoCode{
+		"bad code":         {Code: "a", DiscountType: model.DiscountTypePERCENT, PercentOff: 10},
+		"percent too high": {Code: "SALE", DiscountType: model.DiscountTypePERCENT, PercentOff: 150},
+		"fixed without":    {Code: "SALE", DiscountType: model.DiscountTypeFIXED},
+		"unknown type":     {Code: "SALE", DiscountType: "BOGO", AmountOff: money.New(100, money.DefaultCurrency)},
+		"already expired":  {Code: "SALE", DiscountType: model.DiscountTypePERCENT, PercentOff: 10, ExpiresAt: &past},
+	}
+
+	for name, promo := range tests {
+		s.Run(name, func() {
+			_, err := s.service.CreatePromoCode(s.ctx, s.adminUUID, promo)
+
+			var badRequest *model.BadRequestError
+			s.Require().ErrorAs(err, &badRequest)
+		})
+	}
+
+	s.promoCodeRepository.AssertNotCalled(s.T(), "CreatePromoCode", mock.Anything, mock.Anything)
+}
+
+func (s *SuiteService) TestCreatePromoCodeForbidden() {
+	_, err := s.service.CreatePromoCode(s.ctx, gofakeit.UUID(), model.PromoCode{
+		Code:         "SALE",
+		DiscountType: model.DiscountTypePERCENT,
+		PercentOff:   10,
+	})
+
+	var forbidden *model.ForbiddenError
+	s.Require().ErrorAs(err, &forbidden)
+	s.promoCodeRepository.AssertNotCalled(s.T(), "CreatePromoCode", mock.Anything, mock.Anything)
+}
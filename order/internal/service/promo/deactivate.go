@@ -0,0 +1,28 @@
+package promo
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+func (s *service) DeactivatePromoCode(ctx context.Context, userUUID, code string) (*model.PromoCode, error) {
+	if err := s.checkAdmin(ctx, userUUID); err != nil {
+		return nil, err
+	}
+
+	promo, err := s.repository.DeactivatePromoCode(ctx, model.NormalizePromoCode(code))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(ctx, "Promo code deactivated",
+		zap.String("promo_code", promo.Code),
+		zap.String("user_uuid", userUUID),
+	)
+
+	return promo, nil
+}
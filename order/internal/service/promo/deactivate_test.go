@@ -0,0 +1,38 @@
+package promo
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (s *SuiteService) TestDeactivatePromoCodeSuccess() {
+	s.promoCodeRepository.
+		On("DeactivatePromoCode", s.ctx, "SALE").
+		Return(&model.PromoCode{Code: "SALE"}, nil).
+		Once()
+
+	promo, err := s.service.DeactivatePromoCode(s.ctx, s.adminUUID, "sale")
+
+	s.Require().NoError(err)
+	s.Require().False(promo.Active)
+}
+
+func (s *SuiteService) TestDeactivatePromoCodeNotFound() {
+	s.promoCodeRepository.
+		On("DeactivatePromoCode", s.ctx, "SALE").
+		Return(nil, model.NewPromoCodeNotFoundError("SALE")).
+		Once()
+
+	_, err := s.service.DeactivatePromoCode(s.ctx, s.adminUUID, "SALE")
+
+	var notFound *model.PromoCodeNotFoundError
+	s.Require().ErrorAs(err, &notFound)
+}
+
+func (s *SuiteService) TestListPromoCodesForbidden() {
+	_, err := s.service.ListPromoCodes(s.ctx, gofakeit.UUID())
+
+	var forbidden *model.ForbiddenError
+	s.Require().ErrorAs(err, &forbidden)
+}
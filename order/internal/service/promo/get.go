@@ -0,0 +1,15 @@
+package promo
+
+import (
+	"context"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+func (s *service) GetPromoCode(ctx context.Context, userUUID, code string) (*model.PromoCode, error) {
+	if err := s.checkAdmin(ctx, userUUID); err != nil {
+		return nil, err
+	}
+
+	return s.repository.GetPromoCode(ctx, model.NormalizePromoCode(code))
+}
@@ -0,0 +1,35 @@
+package promo
+
+import (
+	"context"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/order/internal/repository"
+	srvc "github.com/ZanDattSu/star-factory/order/internal/service"
+	"github.com/ZanDattSu/star-factory/platform/pkg/admin"
+)
+
+// Компиляторная проверка: убеждаемся, что *service реализует интерфейс PromoCodeService.
+var _ srvc.PromoCodeService = (*service)(nil)
+
+type service struct {
+	repository repository.PromoCodeRepository
+	// admins проверяет, что промокодами управляет администратор
+	admins *admin.Checker
+}
+
+func NewService(repository repository.PromoCodeRepository, admins *admin.Checker) *service {
+	return &service{
+		repository: repository,
+		admins:     admins,
+	}
+}
+
+// checkAdmin проверяет, что пользователь из сессии может управлять промокодами.
+func (s *service) checkAdmin(ctx context.Context, userUUID string) error {
+	if s.admins.Allow(ctx, userUUID, "promo code management") {
+		return nil
+	}
+
+	return model.NewForbiddenError("promo codes can be managed by administrators only")
+}
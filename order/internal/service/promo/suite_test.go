@@ -0,0 +1,38 @@
+package promo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ZanDattSu/star-factory/order/internal/repository/mocks"
+	"github.com/ZanDattSu/star-factory/platform/pkg/admin"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+type SuiteService struct {
+	suite.Suite
+
+	ctx context.Context //nolint:containedctx
+
+	promoCodeRepository *mocks.PromoCodeRepository
+	adminUUID           string
+
+	service *service
+}
+
+func (s *SuiteService) SetupTest() {
+	s.ctx = context.Background()
+
+	s.promoCodeRepository = mocks.NewPromoCodeRepository(s.T())
+	s.adminUUID = gofakeit.UUID()
+
+	s.service = NewService(s.promoCodeRepository, admin.NewChecker([]string{s.adminUUID}))
+	logger.SetNopLogger()
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(SuiteService))
+}
@@ -0,0 +1,53 @@
+package outbox_relay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+)
+
+// publish восстанавливает доменное событие из outbox и отправляет его соответствующим producer'ом.
+func (s *service) publish(ctx context.Context, event *model.OutboxEvent) error {
+	switch event.EventType {
+	case model.OutboxEventOrderPaid:
+		var orderPaid model.OrderPaidEvent
+		if err := json.Unmarshal(event.Payload, &orderPaid); err != nil {
+			return fmt.Errorf("failed to unmarshal order paid event: %w", err)
+		}
+		return s.orderProducerService.ProduceOrderPaid(ctx, orderPaid)
+	case model.OutboxEventOrderRefunded:
+		var orderRefunded model.OrderRefundedEvent
+		if err := json.Unmarshal(event.Payload, &orderRefunded); err != nil {
+			return fmt.Errorf("failed to unmarshal order refunded event: %w", err)
+		}
+		return s.orderProducerService.ProduceOrderRefunded(ctx, orderRefunded)
+	case model.OutboxEventOrderExpired:
+		var orderExpired model.OrderExpiredEvent
+		if err := json.Unmarshal(event.Payload, &orderExpired); err != nil {
+			return fmt.Errorf("failed to unmarshal order expired event: %w", err)
+		}
+		return s.orderProducerService.ProduceOrderExpired(ctx, orderExpired)
+	case model.OutboxEventOrderCreated:
+		var orderCreated model.OrderCreatedEvent
+		if err := json.Unmarshal(event.Payload, &orderCreated); err != nil {
+			return fmt.Errorf("failed to unmarshal order created event: %w", err)
+		}
+		return s.orderProducerService.ProduceOrderCreated(ctx, orderCreated)
+	case model.OutboxEventOrderCancelled:
+		var orderCancelled model.OrderCancelledEvent
+		if err := json.Unmarshal(event.Payload, &orderCancelled); err != nil {
+			return fmt.Errorf("failed to unmarshal order cancelled event: %w", err)
+		}
+		return s.orderProducerService.ProduceOrderCancelled(ctx, orderCancelled)
+	case model.OutboxEventOrderStatusChanged:
+		var statusChanged model.OrderStatusChangedEvent
+		if err := json.Unmarshal(event.Payload, &statusChanged); err != nil {
+			return fmt.Errorf("failed to unmarshal order status changed event: %w", err)
+		}
+		return s.orderProducerService.ProduceOrderStatusChanged(ctx, statusChanged)
+	default:
+		return fmt.Errorf("unknown outbox event type %q", event.EventType)
+	}
+}
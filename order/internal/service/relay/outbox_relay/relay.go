@@ -0,0 +1,130 @@
+package outbox_relay
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/order/internal/repository"
+	serv "github.com/ZanDattSu/star-factory/order/internal/service"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+// claimLease время, на которое захваченные события скрываются от других экземпляров relay.
+// Если relay упадёт во время публикации, события станут доступны снова по истечении lease.
+const claimLease = 30 * time.Second
+
+type service struct {
+	outboxRepository     repository.OutboxRepository
+	orderProducerService serv.OrderProducerService
+
+	pollInterval   time.Duration
+	batchSize      int
+	retryBaseDelay time.Duration
+	retryMaxDelay  time.Duration
+}
+
+func NewService(
+	outboxRepository repository.OutboxRepository,
+	orderProducerService serv.OrderProducerService,
+	pollInterval time.Duration,
+	batchSize int,
+	retryBaseDelay time.Duration,
+	retryMaxDelay time.Duration,
+) *service {
+	return &service{
+		outboxRepository:     outboxRepository,
+		orderProducerService: orderProducerService,
+		pollInterval:         pollInterval,
+		batchSize:            batchSize,
+		retryBaseDelay:       retryBaseDelay,
+		retryMaxDelay:        retryMaxDelay,
+	}
+}
+
+func (s *service) RunRelay(ctx context.Context) error {
+	logger.Info(ctx, "Starting outbox relay",
+		zap.Duration("poll_interval", s.pollInterval),
+		zap.Int("batch_size", s.batchSize),
+	)
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.relayBatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "Outbox relay stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayBatch публикует одну порцию ожидающих событий.
+func (s *service) relayBatch(ctx context.Context) {
+	events, err := s.outboxRepository.ClaimPending(ctx, s.batchSize, claimLease)
+	if err != nil {
+		logger.Error(ctx, "Failed to claim outbox events", zap.Error(err))
+		return
+	}
+
+	// Ключи заказов, событие которых в этой порции не опубликовалось: следующие события заказа
+	// оставляем до истечения lease, чтобы не нарушить порядок для потребителей
+	blocked := make(map[string]struct{})
+
+	for _, event := range events {
+		if _, ok := blocked[event.Key]; ok {
+			logger.Debug(ctx, "Outbox event postponed after failed predecessor",
+				zap.Int64("outbox_id", event.ID),
+				zap.String("event_key", event.Key),
+			)
+			continue
+		}
+
+		err = s.publish(ctx, event)
+		if err != nil {
+			blocked[event.Key] = struct{}{}
+
+			retryAfter := s.backoff(event.Attempts)
+			logger.Warn(ctx, "Failed to publish outbox event, will retry",
+				zap.Int64("outbox_id", event.ID),
+				zap.String("event_uuid", event.EventUUID),
+				zap.String("event_type", event.EventType),
+				zap.Int("attempts", event.Attempts),
+				zap.Duration("retry_after", retryAfter),
+				zap.Error(err),
+			)
+
+			if markErr := s.outboxRepository.MarkFailed(ctx, event.ID, err.Error(), retryAfter); markErr != nil {
+				logger.Error(ctx, "Failed to mark outbox event as failed",
+					zap.Int64("outbox_id", event.ID),
+					zap.Error(markErr),
+				)
+			}
+			continue
+		}
+
+		if err = s.outboxRepository.MarkSent(ctx, event.ID); err != nil {
+			// Событие уже опубликовано и будет отправлено повторно после lease:
+			// потребители должны быть идемпотентны по event_uuid
+			logger.Error(ctx, "Failed to mark outbox event as sent",
+				zap.Int64("outbox_id", event.ID),
+				zap.String("event_uuid", event.EventUUID),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// backoff экспоненциальная задержка перед следующей попыткой, ограниченная retryMaxDelay.
+func (s *service) backoff(attempts int) time.Duration {
+	delay := s.retryBaseDelay
+	for i := 1; i < attempts && delay < s.retryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, s.retryMaxDelay)
+}
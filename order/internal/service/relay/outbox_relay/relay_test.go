@@ -0,0 +1,145 @@
+package outbox_relay
+
+import (
+	"errors"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZanDattSu/star-factory/order/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+func (s *SuiteRelay) orderPaidOutboxEvent(attempts int) (*model.OutboxEvent, model.OrderPaidEvent) {
+	paid := model.OrderPaidEvent{
+		EventUuid:       gofakeit.UUID(),
+		OrderUuid:       gofakeit.UUID(),
+		UserUuid:        gofakeit.UUID(),
+		PaymentMethod:   model.PaymentMethodCard,
+		TransactionUuid: gofakeit.UUID(),
+	}
+
+	event, err := model.NewOrderPaidOutboxEvent(paid)
+	s.Require().NoError(err)
+	event.ID = 1
+	event.Attempts = attempts
+
+	return &event, paid
+}
+
+func (s *SuiteRelay) TestRelayBatchPublishesAndMarksSent() {
+	event, paid := s.orderPaidOutboxEvent(1)
+
+	s.outboxRepository.On("ClaimPending", s.ctx, 10, claimLease).
+		Return([]*model.OutboxEvent{event}, nil).Once()
+	s.orderProducerService.On("ProduceOrderPaid", s.ctx, paid).
+		Return(nil).Once()
+	s.outboxRepository.On("MarkSent", s.ctx, event.ID).
+		Return(nil).Once()
+
+	s.service.relayBatch(s.ctx)
+}
+
+func (s *SuiteRelay) TestRelayBatchPublishesOrderRefunded() {
+	refunded := model.OrderRefundedEvent{
+		EventUuid:       gofakeit.UUID(),
+		OrderUuid:       gofakeit.UUID(),
+		UserUuid:        gofakeit.UUID(),
+		TransactionUuid: gofakeit.UUID(),
+		RefundUuid:      gofakeit.UUID(),
+		Amount:          money.New(150000, money.DefaultCurrency),
+	}
+	event, err := model.NewOrderRefundedOutboxEvent(refunded)
+	s.Require().NoError(err)
+	event.ID = 2
+
+	s.outboxRepository.On("ClaimPending", s.ctx, 10, claimLease).
+		Return([]*model.OutboxEvent{&event}, nil).Once()
+	s.orderProducerService.On("ProduceOrderRefunded", s.ctx, refunded).
+		Return(nil).Once()
+	s.outboxRepository.On("MarkSent", s.ctx, event.ID).
+		Return(nil).Once()
+
+	s.service.relayBatch(s.ctx)
+}
+
+func (s *SuiteRelay) TestRelayBatchPublishesOrderStatusChanged() {
+	statusChanged := model.OrderStatusChangedEvent{
+		EventUuid:  gofakeit.UUID(),
+		OrderUuid:  gofakeit.UUID(),
+		UserUuid:   gofakeit.UUID(),
+		FromStatus: model.OrderStatusPAID,
+		ToStatus:   model.OrderStatusASSEMBLED,
+		Actor:      model.ActorAssembly,
+		Reason:     "ship assembled",
+		ChangedAt:  time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC),
+	}
+	event, err := model.NewOrderStatusChangedOutboxEvent(statusChanged)
+	s.Require().NoError(err)
+	event.ID = 3
+
+	s.outboxRepository.On("ClaimPending", s.ctx, 10, claimLease).
+		Return([]*model.OutboxEvent{&event}, nil).Once()
+	s.orderProducerService.On("ProduceOrderStatusChanged", s.ctx, statusChanged).
+		Return(nil).Once()
+	s.outboxRepository.On("MarkSent", s.ctx, event.ID).
+		Return(nil).Once()
+
+	s.service.relayBatch(s.ctx)
+}
+
+func (s *SuiteRelay) TestRelayBatchPublishFailedSchedulesRetry() {
+	event, paid := s.orderPaidOutboxEvent(3)
+
+	s.outboxRepository.On("ClaimPending", s.ctx, 10, claimLease).
+		Return([]*model.OutboxEvent{event}, nil).Once()
+	s.orderProducerService.On("ProduceOrderPaid", s.ctx, paid).
+		Return(errors.New("kafka is down")).Once()
+	s.outboxRepository.On("MarkFailed", s.ctx, event.ID, "kafka is down", 4*time.Second).
+		Return(nil).Once()
+
+	s.service.relayBatch(s.ctx)
+
+	s.outboxRepository.AssertNotCalled(s.T(), "MarkSent", mock.Anything, mock.Anything)
+}
+
+func (s *SuiteRelay) TestRelayBatchKeepsOrderAfterFailure() {
+	failed, paid := s.orderPaidOutboxEvent(1)
+
+	later, err := model.NewOrderCancelledOutboxEvent(model.OrderCancelledEvent{
+		EventUuid: gofakeit.UUID(),
+		OrderUuid: paid.OrderUuid,
+	})
+	s.Require().NoError(err)
+	later.ID = 2
+
+	s.outboxRepository.On("ClaimPending", s.ctx, 10, claimLease).
+		Return([]*model.OutboxEvent{failed, &later}, nil).Once()
+	s.orderProducerService.On("ProduceOrderPaid", s.ctx, paid).
+		Return(errors.New("kafka is down")).Once()
+	s.outboxRepository.On("MarkFailed", s.ctx, failed.ID, "kafka is down", time.Second).
+		Return(nil).Once()
+
+	s.service.relayBatch(s.ctx)
+
+	s.orderProducerService.AssertNotCalled(s.T(), "ProduceOrderCancelled", mock.Anything, mock.Anything)
+	s.outboxRepository.AssertNotCalled(s.T(), "MarkSent", mock.Anything, mock.Anything)
+}
+
+func (s *SuiteRelay) TestRelayBatchUnknownEventType() {
+	event := &model.OutboxEvent{ID: 7, EventType: "order.unknown", Attempts: 1}
+
+	s.outboxRepository.On("ClaimPending", s.ctx, 10, claimLease).
+		Return([]*model.OutboxEvent{event}, nil).Once()
+	s.outboxRepository.On("MarkFailed", s.ctx, event.ID, mock.Anything, time.Second).
+		Return(nil).Once()
+
+	s.service.relayBatch(s.ctx)
+}
+
+func (s *SuiteRelay) TestBackoffIsCapped() {
+	s.Equal(time.Second, s.service.backoff(1))
+	s.Equal(2*time.Second, s.service.backoff(2))
+	s.Equal(time.Minute, s.service.backoff(50))
+}
@@ -0,0 +1,45 @@
+package outbox_relay
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ZanDattSu/star-factory/order/internal/repository/mocks"
+	serviceMocks "github.com/ZanDattSu/star-factory/order/internal/service/mocks"
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+type SuiteRelay struct {
+	suite.Suite
+
+	ctx context.Context //nolint:containedctx
+
+	outboxRepository     *mocks.OutboxRepository
+	orderProducerService *serviceMocks.OrderProducerService
+
+	service *service
+}
+
+func (s *SuiteRelay) SetupTest() {
+	s.ctx = context.Background()
+
+	s.outboxRepository = mocks.NewOutboxRepository(s.T())
+	s.orderProducerService = serviceMocks.NewOrderProducerService(s.T())
+
+	s.service = NewService(
+		s.outboxRepository,
+		s.orderProducerService,
+		time.Second,
+		10,
+		time.Second,
+		time.Minute,
+	)
+	logger.SetNopLogger()
+}
+
+func TestRelaySuite(t *testing.T) {
+	suite.Run(t, new(SuiteRelay))
+}
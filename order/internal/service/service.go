@@ -7,16 +7,74 @@ import (
 )
 
 type OrderService interface {
-	CreateOrder(ctx context.Context, userUUID string, partUuids []string) (string, float64, error)
-	PayOrder(ctx context.Context, paymentMethod model.PaymentMethod, orderUUID string) (string, error)
-	GetOrder(ctx context.Context, orderUUID string) (*model.Order, error)
-	CancelOrder(ctx context.Context, orderUUID string) error
+	// CreateOrder оформляет заказ. По токену расчёта заказ оформляется по ценам из расчёта.
+	CreateOrder(ctx context.Context, userUUID string, req model.OrderRequest, idempotencyKey string) (string, model.OrderPrice, error)
+	// QuoteOrder считает стоимость позиций с учётом промокода и наличия на складе, не оформляя заказ.
+	// Возвращённый расчёт действует ограниченное время, его токен принимает CreateOrder.
+	QuoteOrder(ctx context.Context, userUUID string, items []model.OrderItem, promoCode string) (*model.Quote, error)
+	// CheckoutCart оформляет заказ из корзины пользователя тем же путём, что и CreateOrder,
+	// и очищает корзину. Позиции заказа берутся из корзины, promoCode и quoteToken необязательны.
+	CheckoutCart(ctx context.Context, userUUID, promoCode, quoteToken, idempotencyKey string) (string, model.OrderPrice, error)
+	PayOrder(ctx context.Context, userUUID string, paymentMethod model.PaymentMethod, orderUUID, idempotencyKey string) (string, error)
+	GetOrder(ctx context.Context, userUUID, orderUUID string) (*model.Order, error)
+	CancelOrder(ctx context.Context, userUUID, orderUUID string) error
+	// UpdateOrderItems заменяет позиции заказа, ожидающего оплаты, и пересчитывает его стоимость.
+	// version — версия заказа, которую видел клиент; если заказ успели изменить, возвращает ConflictError.
+	UpdateOrderItems(ctx context.Context, userUUID, orderUUID string, items []model.OrderItem, version int64) (*model.Order, error)
+	ListOrders(ctx context.Context, filter model.OrdersFilter, cursor string) ([]*model.Order, string, error)
+	GetOrderStatusHistory(ctx context.Context, userUUID, orderUUID string) ([]*model.OrderStatusChange, error)
+	// WatchOrderStatus проверяет владельца заказа и подписывает на сигналы о смене его статуса.
+	// Подписку нужно закрыть возвращённой функцией.
+	WatchOrderStatus(ctx context.Context, userUUID, orderUUID string) (<-chan struct{}, func(), error)
+}
+
+// PromoCodeService управляет промокодами. Все методы доступны только администраторам.
+type PromoCodeService interface {
+	CreatePromoCode(ctx context.Context, userUUID string, promo model.PromoCode) (*model.PromoCode, error)
+	GetPromoCode(ctx context.Context, userUUID, code string) (*model.PromoCode, error)
+	ListPromoCodes(ctx context.Context, userUUID string) ([]*model.PromoCode, error)
+	DeactivatePromoCode(ctx context.Context, userUUID, code string) (*model.PromoCode, error)
+}
+
+// CartService управляет корзиной пользователя. Оформление корзины — OrderService.CheckoutCart.
+type CartService interface {
+	AddCartItem(ctx context.Context, userUUID, partUUID string, quantity int64) (*model.Cart, error)
+	RemoveCartItem(ctx context.Context, userUUID, partUUID string) (*model.Cart, error)
+	GetCart(ctx context.Context, userUUID string) (*model.Cart, error)
+}
+
+// OrderStatusNotifier оповещает подписчиков внутри процесса о смене статуса заказа.
+type OrderStatusNotifier interface {
+	Notify(orderUUID string)
+	// Subscribe возвращает канал сигналов о переходах заказа и функцию отписки.
+	Subscribe(orderUUID string) (<-chan struct{}, func())
 }
 
 type ConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
 
+type OutboxRelayService interface {
+	RunRelay(ctx context.Context) error
+}
+
+// OrderExpiryService отменяет заказы, не оплаченные в течение TTL.
+type OrderExpiryService interface {
+	ExpireOrders(ctx context.Context) (int, error)
+	RunExpirySweeper(ctx context.Context) error
+}
+
+// IdempotencyExpiryService удаляет истёкшие ключи идемпотентности.
+type IdempotencyExpiryService interface {
+	PurgeExpiredKeys(ctx context.Context) (int, error)
+	RunPurgeSweeper(ctx context.Context) error
+}
+
 type OrderProducerService interface {
 	ProduceOrderPaid(ctx context.Context, event model.OrderPaidEvent) error
+	ProduceOrderRefunded(ctx context.Context, event model.OrderRefundedEvent) error
+	ProduceOrderExpired(ctx context.Context, event model.OrderExpiredEvent) error
+	ProduceOrderCreated(ctx context.Context, event model.OrderCreatedEvent) error
+	ProduceOrderCancelled(ctx context.Context, event model.OrderCancelledEvent) error
+	ProduceOrderStatusChanged(ctx context.Context, event model.OrderStatusChangedEvent) error
 }
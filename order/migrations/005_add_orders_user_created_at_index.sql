@@ -0,0 +1,7 @@
+-- +goose Up
+-- Индекс под keyset-пагинацию списка заказов пользователя
+CREATE INDEX IF NOT EXISTS idx_orders_user_created_at
+    ON orders (user_uuid, created_at DESC, order_uuid DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_orders_user_created_at;
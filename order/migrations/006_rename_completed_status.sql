@@ -0,0 +1,10 @@
+-- +goose Up
+-- Миграция 004 добавила статус COMPLETED, тогда как сервис оперирует ASSEMBLED
+UPDATE order_statuses
+SET code = 'ASSEMBLED'
+WHERE code = 'COMPLETED';
+
+-- +goose Down
+UPDATE order_statuses
+SET code = 'COMPLETED'
+WHERE code = 'ASSEMBLED';
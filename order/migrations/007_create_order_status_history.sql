@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS order_status_history
+(
+    id             BIGSERIAL PRIMARY KEY,
+    order_uuid     UUID        NOT NULL
+        REFERENCES orders (order_uuid)
+            ON DELETE CASCADE,
+
+    from_status_id INT         NOT NULL
+        REFERENCES order_statuses (id)
+            ON UPDATE CASCADE
+            ON DELETE RESTRICT,
+    to_status_id   INT         NOT NULL
+        REFERENCES order_statuses (id)
+            ON UPDATE CASCADE
+            ON DELETE RESTRICT,
+
+    -- UUID пользователя либо имя сервиса-инициатора
+    actor          TEXT        NOT NULL,
+    reason         TEXT        NOT NULL DEFAULT '',
+
+    changed_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_status_history_order_uuid
+    ON order_status_history (order_uuid, changed_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS order_status_history;
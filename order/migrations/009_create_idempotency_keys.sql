@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS idempotency_keys
+(
+    user_uuid       UUID        NOT NULL,
+    operation       TEXT        NOT NULL,
+    idempotency_key TEXT        NOT NULL,
+
+    -- Хэш тела запроса: повтор ключа с другим телом отклоняется
+    fingerprint     TEXT        NOT NULL,
+    -- NULL, пока исходный запрос ещё выполняется
+    response        JSONB,
+
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_uuid, operation, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_created_at ON idempotency_keys (created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS idempotency_keys;
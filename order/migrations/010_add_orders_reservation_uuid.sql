@@ -0,0 +1,8 @@
+-- +goose Up
+-- Резерв деталей в inventory, созданный под заказ
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS reservation_uuid UUID;
+
+-- +goose Down
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS reservation_uuid;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- Позиции заказа со снимком названия и цены детали на момент оформления.
+-- Для заказов, созданных до миграции, позиций нет: цены на тот момент не сохранялись.
+CREATE TABLE IF NOT EXISTS order_items
+(
+    id         BIGSERIAL PRIMARY KEY,
+    order_uuid UUID           NOT NULL
+        REFERENCES orders (order_uuid)
+            ON DELETE CASCADE,
+
+    part_uuid  UUID           NOT NULL,
+    part_name  TEXT           NOT NULL DEFAULT '',
+    quantity   INT            NOT NULL CHECK (quantity > 0),
+    unit_price NUMERIC(10, 2) NOT NULL CHECK (unit_price >= 0),
+
+    CONSTRAINT uq_order_items_order_part UNIQUE (order_uuid, part_uuid)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS order_items;
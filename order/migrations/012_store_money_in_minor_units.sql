@@ -0,0 +1,31 @@
+-- +goose Up
+-- Суммы хранятся целым числом минимальных единиц валюты вместе с кодом валюты ISO 4217.
+-- Существующие данные были в рублях с двумя знаками после запятой.
+ALTER TABLE orders
+    ALTER COLUMN total_price TYPE BIGINT USING ROUND(total_price * 100)::BIGINT;
+ALTER TABLE orders
+    RENAME COLUMN total_price TO total_price_minor;
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'RUB';
+
+ALTER TABLE order_items
+    ALTER COLUMN unit_price TYPE BIGINT USING ROUND(unit_price * 100)::BIGINT;
+ALTER TABLE order_items
+    RENAME COLUMN unit_price TO unit_price_minor;
+ALTER TABLE order_items
+    ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'RUB';
+
+-- +goose Down
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS currency;
+ALTER TABLE order_items
+    RENAME COLUMN unit_price_minor TO unit_price;
+ALTER TABLE order_items
+    ALTER COLUMN unit_price TYPE NUMERIC(10, 2) USING unit_price / 100.0;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS currency;
+ALTER TABLE orders
+    RENAME COLUMN total_price_minor TO total_price;
+ALTER TABLE orders
+    ALTER COLUMN total_price TYPE NUMERIC(10, 2) USING total_price / 100.0;
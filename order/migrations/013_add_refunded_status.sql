@@ -0,0 +1,6 @@
+-- +goose Up
+INSERT INTO order_statuses (code, name)
+VALUES ('REFUNDED', 'Возвращён');
+
+-- +goose Down
+DELETE FROM order_statuses WHERE code = 'REFUNDED';
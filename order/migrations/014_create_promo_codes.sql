@@ -0,0 +1,44 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS promo_codes
+(
+    code             TEXT PRIMARY KEY,
+    discount_type    TEXT        NOT NULL CHECK (discount_type IN ('PERCENT', 'FIXED')),
+    percent_off      INT         NOT NULL DEFAULT 0 CHECK (percent_off BETWEEN 0 AND 100),
+    amount_off_minor BIGINT      NOT NULL DEFAULT 0 CHECK (amount_off_minor >= 0),
+    currency         CHAR(3)     NOT NULL DEFAULT 'RUB',
+    -- Категории деталей, на которые действует скидка; пустой массив — все детали
+    categories       TEXT[]      NOT NULL DEFAULT '{}',
+
+    -- NULL — без ограничения числа использований
+    max_uses         BIGINT CHECK (max_uses > 0),
+    used_count       BIGINT      NOT NULL DEFAULT 0,
+    expires_at       TIMESTAMPTZ,
+    active           BOOLEAN     NOT NULL DEFAULT TRUE,
+
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_promo_codes_usage CHECK (max_uses IS NULL OR used_count <= max_uses)
+);
+
+-- Сумма позиций и скидка хранятся рядом с итогом, чтобы цена заказа не зависела от последующих правок промокода.
+-- Для существующих заказов скидки не было, сумма позиций равна итогу.
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS subtotal_minor BIGINT;
+UPDATE orders
+SET subtotal_minor = total_price_minor;
+ALTER TABLE orders
+    ALTER COLUMN subtotal_minor SET NOT NULL;
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS discount_minor BIGINT NOT NULL DEFAULT 0 CHECK (discount_minor >= 0);
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS promo_code TEXT REFERENCES promo_codes (code);
+
+-- +goose Down
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS promo_code;
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS discount_minor;
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS subtotal_minor;
+
+DROP TABLE IF EXISTS promo_codes;
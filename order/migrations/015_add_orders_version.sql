@@ -0,0 +1,8 @@
+-- +goose Up
+-- Версия заказа для оптимистичной блокировки: любое UPDATE заказа (состав, статус, оплата, истечение) увеличивает её на единицу
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1 CHECK (version > 0);
+
+-- +goose Down
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS version;
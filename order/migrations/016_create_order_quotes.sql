@@ -0,0 +1,23 @@
+-- +goose Up
+-- Расчёты стоимости без оформления заказа. Позиции хранятся со снимком цены и названия детали,
+-- чтобы заказ по токену оформлялся по тем же ценам, что увидел клиент.
+CREATE TABLE IF NOT EXISTS order_quotes
+(
+    token          UUID PRIMARY KEY,
+    user_uuid      UUID        NOT NULL,
+    items          JSONB       NOT NULL,
+
+    subtotal_minor BIGINT      NOT NULL CHECK (subtotal_minor >= 0),
+    discount_minor BIGINT      NOT NULL DEFAULT 0 CHECK (discount_minor >= 0),
+    total_minor    BIGINT      NOT NULL CHECK (total_minor >= 0),
+    currency       CHAR(3)     NOT NULL,
+    promo_code     TEXT,
+
+    expires_at     TIMESTAMPTZ NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_quotes_expires_at ON order_quotes (expires_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS order_quotes;
@@ -0,0 +1,31 @@
+-- +goose Up
+-- Первая запись истории — создание заказа: переход из UNSPECIFIED в начальный статус от имени владельца.
+-- Для уже созданных заказов начальный статус берётся из первого перехода, а если переходов не было — из текущего статуса
+INSERT INTO order_status_history(order_uuid, from_status_id, to_status_id, actor, reason, changed_at)
+SELECT o.order_uuid,
+       unspecified.id,
+       COALESCE(
+               (SELECT h.from_status_id
+                FROM order_status_history h
+                WHERE h.order_uuid = o.order_uuid
+                ORDER BY h.changed_at, h.id
+                LIMIT 1),
+               o.status_id
+       ),
+       o.user_uuid::TEXT,
+       'order created',
+       o.created_at
+FROM orders o
+         CROSS JOIN (SELECT id FROM order_statuses WHERE code = 'UNSPECIFIED') unspecified
+WHERE NOT EXISTS (SELECT 1
+                  FROM order_status_history h
+                  WHERE h.order_uuid = o.order_uuid
+                    AND h.from_status_id = unspecified.id);
+
+-- +goose Down
+DELETE
+FROM order_status_history h
+    USING order_statuses s
+WHERE h.from_status_id = s.id
+  AND s.code = 'UNSPECIFIED'
+  AND h.reason = 'order created';
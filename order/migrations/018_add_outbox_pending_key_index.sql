@@ -0,0 +1,8 @@
+-- +goose Up
+-- Relay публикует события заказа строго по порядку и проверяет, нет ли у ключа более раннего неотправленного события
+CREATE INDEX IF NOT EXISTS idx_outbox_pending_key
+    ON outbox (event_key, id)
+    WHERE sent_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_outbox_pending_key;
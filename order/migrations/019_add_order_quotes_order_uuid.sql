@@ -0,0 +1,8 @@
+-- +goose Up
+-- Расчёт одноразовый: заказ, оформленный по токену, записывается в расчёт, и повторно токен не принимается
+ALTER TABLE order_quotes
+    ADD COLUMN IF NOT EXISTS order_uuid UUID;
+
+-- +goose Down
+ALTER TABLE order_quotes
+    DROP COLUMN IF EXISTS order_uuid;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- Время, после которого ключ можно удалить, а незавершённый запрос — выполнить заново
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+
+UPDATE idempotency_keys
+SET expires_at = created_at + INTERVAL '24 hours'
+WHERE expires_at IS NULL;
+
+ALTER TABLE idempotency_keys ALTER COLUMN expires_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_idempotency_keys_expires_at;
+
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS expires_at;
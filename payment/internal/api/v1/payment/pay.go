@@ -5,11 +5,12 @@ import (
 	"log"
 
 	"github.com/ZanDattSu/star-factory/payment/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 	paymentV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/payment/v1"
 )
 
 func (a *api) PayOrder(ctx context.Context, req *paymentV1.PayOrderRequest) (*paymentV1.PayOrderResponse, error) {
-	transactionUuid := a.service.PayOrder(ctx, req.OrderUuid, req.UserUuid, model.PaymentMethod(req.PaymentMethod))
+	transactionUuid := a.service.PayOrder(ctx, req.OrderUuid, req.UserUuid, model.PaymentMethod(req.PaymentMethod), money.FromProto(req.Amount))
 	log.Printf("Оплата прошла успешно, transaction_uuid:%s", transactionUuid)
 
 	return &paymentV1.PayOrderResponse{
@@ -0,0 +1,18 @@
+package payment
+
+import (
+	"context"
+	"log"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+	paymentV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) RefundPayment(ctx context.Context, req *paymentV1.RefundPaymentRequest) (*paymentV1.RefundPaymentResponse, error) {
+	refundUuid := a.service.RefundPayment(ctx, req.OrderUuid, req.UserUuid, req.TransactionUuid, money.FromProto(req.Amount))
+	log.Printf("Возврат прошёл успешно, refund_uuid:%s", refundUuid)
+
+	return &paymentV1.RefundPaymentResponse{
+		RefundUuid: refundUuid,
+	}, nil
+}
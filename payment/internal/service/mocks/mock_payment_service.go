@@ -7,6 +7,8 @@ import (
 
 	model "github.com/ZanDattSu/star-factory/payment/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	money "github.com/ZanDattSu/star-factory/platform/pkg/money"
 )
 
 // PaymentService is an autogenerated mock type for the PaymentService type
@@ -22,17 +24,17 @@ func (_m *PaymentService) EXPECT() *PaymentService_Expecter {
 	return &PaymentService_Expecter{mock: &_m.Mock}
 }
 
-// PayOrder provides a mock function with given fields: ctx, orderUuid, userUuid, paymentMethod
-func (_m *PaymentService) PayOrder(ctx context.Context, orderUuid string, userUuid string, paymentMethod model.PaymentMethod) string {
-	ret := _m.Called(ctx, orderUuid, userUuid, paymentMethod)
+// PayOrder provides a mock function with given fields: ctx, orderUuid, userUuid, paymentMethod, amount
+func (_m *PaymentService) PayOrder(ctx context.Context, orderUuid string, userUuid string, paymentMethod model.PaymentMethod, amount money.Money) string {
+	ret := _m.Called(ctx, orderUuid, userUuid, paymentMethod, amount)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
 	}
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PaymentMethod) string); ok {
-		r0 = rf(ctx, orderUuid, userUuid, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PaymentMethod, money.Money) string); ok {
+		r0 = rf(ctx, orderUuid, userUuid, paymentMethod, amount)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
@@ -50,13 +52,14 @@ type PaymentService_PayOrder_Call struct {
 //   - orderUuid string
 //   - userUuid string
 //   - paymentMethod model.PaymentMethod
-func (_e *PaymentService_Expecter) PayOrder(ctx interface{}, orderUuid interface{}, userUuid interface{}, paymentMethod interface{}) *PaymentService_PayOrder_Call {
-	return &PaymentService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUuid, userUuid, paymentMethod)}
+//   - amount money.Money
+func (_e *PaymentService_Expecter) PayOrder(ctx interface{}, orderUuid interface{}, userUuid interface{}, paymentMethod interface{}, amount interface{}) *PaymentService_PayOrder_Call {
+	return &PaymentService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUuid, userUuid, paymentMethod, amount)}
 }
 
-func (_c *PaymentService_PayOrder_Call) Run(run func(ctx context.Context, orderUuid string, userUuid string, paymentMethod model.PaymentMethod)) *PaymentService_PayOrder_Call {
+func (_c *PaymentService_PayOrder_Call) Run(run func(ctx context.Context, orderUuid string, userUuid string, paymentMethod model.PaymentMethod, amount money.Money)) *PaymentService_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.PaymentMethod))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.PaymentMethod), args[4].(money.Money))
 	})
 	return _c
 }
@@ -66,7 +69,57 @@ func (_c *PaymentService_PayOrder_Call) Return(_a0 string) *PaymentService_PayOr
 	return _c
 }
 
-func (_c *PaymentService_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, model.PaymentMethod) string) *PaymentService_PayOrder_Call {
+func (_c *PaymentService_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, model.PaymentMethod, money.Money) string) *PaymentService_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundPayment provides a mock function with given fields: ctx, orderUuid, userUuid, transactionUuid, amount
+func (_m *PaymentService) RefundPayment(ctx context.Context, orderUuid string, userUuid string, transactionUuid string, amount money.Money) string {
+	ret := _m.Called(ctx, orderUuid, userUuid, transactionUuid, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, money.Money) string); ok {
+		r0 = rf(ctx, orderUuid, userUuid, transactionUuid, amount)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// PaymentService_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type PaymentService_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUuid string
+//   - userUuid string
+//   - transactionUuid string
+//   - amount money.Money
+func (_e *PaymentService_Expecter) RefundPayment(ctx interface{}, orderUuid interface{}, userUuid interface{}, transactionUuid interface{}, amount interface{}) *PaymentService_RefundPayment_Call {
+	return &PaymentService_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, orderUuid, userUuid, transactionUuid, amount)}
+}
+
+func (_c *PaymentService_RefundPayment_Call) Run(run func(ctx context.Context, orderUuid string, userUuid string, transactionUuid string, amount money.Money)) *PaymentService_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(money.Money))
+	})
+	return _c
+}
+
+func (_c *PaymentService_RefundPayment_Call) Return(_a0 string) *PaymentService_RefundPayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentService_RefundPayment_Call) RunAndReturn(run func(context.Context, string, string, string, money.Money) string) *PaymentService_RefundPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
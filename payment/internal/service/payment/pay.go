@@ -8,13 +8,15 @@ import (
 
 	"github.com/ZanDattSu/star-factory/payment/internal/model"
 	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 )
 
-func (s *service) PayOrder(ctx context.Context, orderUUID, userUUID string, paymentMethod model.PaymentMethod) string {
+func (s *service) PayOrder(ctx context.Context, orderUUID, userUUID string, paymentMethod model.PaymentMethod, amount money.Money) string {
 	logger.Info(ctx, "Processing payment",
 		zap.String("order_uuid", orderUUID),
 		zap.String("user_uuid", userUUID),
 		zap.String("payment_method", string(paymentMethod)),
+		zap.Stringer("amount", amount),
 	)
 
 	transactionUUID := uuid.New().String()
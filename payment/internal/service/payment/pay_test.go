@@ -4,6 +4,7 @@ import (
 	"github.com/brianvoe/gofakeit/v7"
 
 	"github.com/ZanDattSu/star-factory/payment/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 )
 
 func (s *ServiceSuite) TestPaySuccess() {
@@ -11,9 +12,10 @@ func (s *ServiceSuite) TestPaySuccess() {
 		orderUuid     = gofakeit.UUID()
 		userUuid      = gofakeit.UUID()
 		paymentMethod = randomPaymentMethod()
+		amount        = money.New(int64(gofakeit.Number(1, 1_000_000)), money.DefaultCurrency)
 	)
 
-	transactionUuid := s.service.PayOrder(s.ctx, orderUuid, userUuid, paymentMethod)
+	transactionUuid := s.service.PayOrder(s.ctx, orderUuid, userUuid, paymentMethod, amount)
 
 	s.Require().NotNil(transactionUuid)
 	s.IsType("", transactionUuid, "uuid должен быть строкой")
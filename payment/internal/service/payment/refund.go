@@ -0,0 +1,36 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+// refundNamespace пространство имён UUID возвратов: UUID возврата выводится из UUID транзакции.
+var refundNamespace = uuid.MustParse("3b0f6c1e-6a43-4f5e-9d2c-8f1f7c2b9a10")
+
+// RefundPayment возвращает оплату транзакции. Возврат идемпотентен по transaction_uuid:
+// повторный запрос по той же транзакции возвращает тот же возврат, а не проводит новый.
+func (s *service) RefundPayment(ctx context.Context, orderUUID, userUUID, transactionUUID string, amount money.Money) string {
+	logger.Info(ctx, "Processing refund",
+		zap.String("order_uuid", orderUUID),
+		zap.String("user_uuid", userUUID),
+		zap.String("transaction_uuid", transactionUUID),
+		zap.Stringer("amount", amount),
+	)
+
+	refundUUID := uuid.NewSHA1(refundNamespace, []byte(transactionUUID)).String()
+
+	logger.Info(ctx, "Refund transaction created",
+		zap.String("order_uuid", orderUUID),
+		zap.String("user_uuid", userUUID),
+		zap.String("transaction_uuid", transactionUUID),
+		zap.String("refund_uuid", refundUUID),
+	)
+
+	return refundUUID
+}
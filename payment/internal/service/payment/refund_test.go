@@ -0,0 +1,37 @@
+package payment
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
+)
+
+func (s *ServiceSuite) TestRefundSuccess() {
+	var (
+		orderUuid       = gofakeit.UUID()
+		userUuid        = gofakeit.UUID()
+		transactionUuid = gofakeit.UUID()
+		amount          = money.New(int64(gofakeit.Number(1, 1_000_000)), money.DefaultCurrency)
+	)
+
+	refundUuid := s.service.RefundPayment(s.ctx, orderUuid, userUuid, transactionUuid, amount)
+
+	_, err := uuid.Parse(refundUuid)
+	s.Require().NoError(err)
+	s.NotEqual(transactionUuid, refundUuid)
+}
+
+func (s *ServiceSuite) TestRefundIsIdempotentByTransaction() {
+	var (
+		transactionUuid = gofakeit.UUID()
+		amount          = money.New(int64(gofakeit.Number(1, 1_000_000)), money.DefaultCurrency)
+	)
+
+	first := s.service.RefundPayment(s.ctx, gofakeit.UUID(), gofakeit.UUID(), transactionUuid, amount)
+	second := s.service.RefundPayment(s.ctx, gofakeit.UUID(), gofakeit.UUID(), transactionUuid, amount)
+	other := s.service.RefundPayment(s.ctx, gofakeit.UUID(), gofakeit.UUID(), gofakeit.UUID(), amount)
+
+	s.Equal(first, second)
+	s.NotEqual(first, other)
+}
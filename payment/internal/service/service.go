@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"github.com/ZanDattSu/star-factory/payment/internal/model"
+	"github.com/ZanDattSu/star-factory/platform/pkg/money"
 )
 
 type PaymentService interface {
-	PayOrder(ctx context.Context, orderUuid, userUuid string, paymentMethod model.PaymentMethod) string
+	PayOrder(ctx context.Context, orderUuid, userUuid string, paymentMethod model.PaymentMethod, amount money.Money) string
+	RefundPayment(ctx context.Context, orderUuid, userUuid, transactionUuid string, amount money.Money) string
 }
@@ -0,0 +1,59 @@
+package admin
+
+import (
+	"context"
+	"slices"
+
+	"github.com/caarlos0/env/v11"
+	"go.uber.org/zap"
+
+	"github.com/ZanDattSu/star-factory/platform/pkg/logger"
+)
+
+type envConfig struct {
+	// UserUUIDs пользователи с правами администратора сервиса (UUID через запятую)
+	UserUUIDs []string `env:"ADMIN_USER_UUIDS"`
+}
+
+// Config список администраторов сервиса из ADMIN_USER_UUIDS.
+type Config struct {
+	raw envConfig
+}
+
+// NewConfig читает ADMIN_USER_UUIDS. Пустой список означает, что администраторов нет.
+func NewConfig() (*Config, error) {
+	var raw envConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &Config{raw: raw}, nil
+}
+
+func (cfg *Config) UserUUIDs() []string {
+	return cfg.raw.UserUUIDs
+}
+
+// Checker проверяет, что пользователь из сессии — администратор сервиса.
+type Checker struct {
+	userUUIDs []string
+}
+
+func NewChecker(userUUIDs []string) *Checker {
+	return &Checker{userUUIDs: userUUIDs}
+}
+
+// Allow сообщает, может ли пользователь выполнить действие action, доступное только администраторам.
+// Отказ пишется в лог, ошибку доступа сервис возвращает сам.
+func (c *Checker) Allow(ctx context.Context, userUUID, action string) bool {
+	if slices.Contains(c.userUUIDs, userUUID) {
+		return true
+	}
+
+	logger.Warn(ctx, "Admin action denied",
+		zap.String("user_uuid", userUUID),
+		zap.String("action", action),
+	)
+
+	return false
+}
@@ -11,6 +11,10 @@ type RedisClient interface {
 	Get(ctx context.Context, key string) ([]byte, error)
 	HashSet(ctx context.Context, key string, values any) error
 	HGetAll(ctx context.Context, key string) ([]any, error)
+	HIncrBy(ctx context.Context, key, field string, increment int64) (int64, error)
+	HDel(ctx context.Context, key string, fields ...string) error
+	// HDecrBy атомарно уменьшает поля hash на decrements и удаляет поля, значение которых стало не больше нуля.
+	HDecrBy(ctx context.Context, key string, decrements map[string]int64) error
 	Del(ctx context.Context, key string) error
 	Exists(ctx context.Context, key string) (bool, error)
 	Expire(ctx context.Context, key string, expiration time.Duration) error
@@ -21,6 +21,17 @@ type Logger interface {
 
 type redisFn func(ctx context.Context, conn redigo.Conn) error
 
+// hDecrByScript уменьшает поля hash и удаляет обнулившиеся одной атомарной операцией.
+// ARGV — пары «поле, на сколько уменьшить».
+var hDecrByScript = redigo.NewScript(1, `
+for i = 1, #ARGV, 2 do
+	if redis.call('HINCRBY', KEYS[1], ARGV[i], -tonumber(ARGV[i + 1])) <= 0 then
+		redis.call('HDEL', KEYS[1], ARGV[i])
+	end
+end
+return 0
+`)
+
 func NewClient(pool *redigo.Pool, logger Logger, connectionTimeout time.Duration) *client {
 	return &client{
 		pool:              pool,
@@ -104,6 +115,47 @@ func (c *client) HGetAll(ctx context.Context, key string) ([]any, error) {
 	return values, err
 }
 
+func (c *client) HIncrBy(ctx context.Context, key, field string, increment int64) (int64, error) {
+	var value int64
+	err := c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
+		result, err := redigo.Int64(conn.Do("HINCRBY", key, field, increment))
+		if err != nil {
+			return err
+		}
+		value = result
+		return nil
+	})
+
+	return value, err
+}
+
+func (c *client) HDel(ctx context.Context, key string, fields ...string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
+		_, err := conn.Do("HDEL", redigo.Args{}.Add(key).AddFlat(fields)...)
+		return err
+	})
+}
+
+func (c *client) HDecrBy(ctx context.Context, key string, decrements map[string]int64) error {
+	if len(decrements) == 0 {
+		return nil
+	}
+
+	args := redigo.Args{}.Add(key)
+	for field, decrement := range decrements {
+		args = args.Add(field, decrement)
+	}
+
+	return c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
+		_, err := hDecrByScript.Do(conn, args...)
+		return err
+	})
+}
+
 func (c *client) Del(ctx context.Context, key string) error {
 	return c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
 		_, err := conn.Do("DEL", key)
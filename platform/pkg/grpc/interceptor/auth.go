@@ -2,6 +2,7 @@ package interceptor
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 
 	"google.golang.org/grpc"
@@ -16,6 +17,8 @@ import (
 const (
 	// SessionUUIDMetadataKey ключ для передачи UUID сессии в gRPC metadata
 	SessionUUIDMetadataKey = "session-uuid"
+	// ServiceTokenMetadataKey ключ для передачи токена сервиса в gRPC metadata
+	ServiceTokenMetadataKey = "service-token"
 )
 
 type contextKey string
@@ -32,14 +35,37 @@ type AuthClient = authV1.AuthServiceClient
 
 // AuthInterceptor interceptor для аутентификации gRPC запросов
 type AuthInterceptor struct {
-	authClient AuthClient
+	authClient     AuthClient
+	serviceToken   string
+	serviceMethods map[string]struct{}
+}
+
+// AuthOption настраивает AuthInterceptor
+type AuthOption func(*AuthInterceptor)
+
+// WithServiceMethods делает методы внутренними: они доступны только другим сервисам,
+// которые передают token в metadata, а сессии пользователей для них не принимаются.
+// С пустым token внутренние методы недоступны никому.
+func WithServiceMethods(token string, fullMethods ...string) AuthOption {
+	return func(i *AuthInterceptor) {
+		i.serviceToken = token
+		for _, method := range fullMethods {
+			i.serviceMethods[method] = struct{}{}
+		}
+	}
 }
 
 // NewAuthInterceptor создает новый interceptor аутентификации
-func NewAuthInterceptor(authClient AuthClient) *AuthInterceptor {
-	return &AuthInterceptor{
-		authClient: authClient,
+func NewAuthInterceptor(authClient AuthClient, opts ...AuthOption) *AuthInterceptor {
+	i := &AuthInterceptor{
+		authClient:     authClient,
+		serviceMethods: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(i)
 	}
+
+	return i
 }
 
 // Unary возвращает unary server interceptor для аутентификации
@@ -50,6 +76,13 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
+		if _, ok := i.serviceMethods[info.FullMethod]; ok {
+			if err := i.authenticateService(ctx); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+
 		authCtx, err := i.authenticate(ctx)
 		if err != nil {
 			return nil, err
@@ -94,6 +127,29 @@ func (i *AuthInterceptor) authenticate(ctx context.Context) (context.Context, er
 	return authCtx, nil
 }
 
+// authenticateService проверяет токен сервиса из metadata
+func (i *AuthInterceptor) authenticateService(ctx context.Context) error {
+	if i.serviceToken == "" {
+		return status.Error(codes.PermissionDenied, "method is available to internal services only")
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	tokens := md.Get(ServiceTokenMetadataKey)
+	if len(tokens) == 0 || tokens[0] == "" {
+		return status.Error(codes.Unauthenticated, "missing service-token in metadata")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(i.serviceToken)) != 1 {
+		return status.Error(codes.PermissionDenied, "invalid service token")
+	}
+
+	return nil
+}
+
 // GetUserFromContext извлекает пользователя из контекста
 func GetUserFromContext(ctx context.Context) (*commonV1.User, bool) {
 	user, ok := ctx.Value(userContextKey).(*commonV1.User)
@@ -131,3 +187,8 @@ func ForwardSessionUUIDToGRPC(ctx context.Context) context.Context {
 	}
 	return metadata.AppendToOutgoingContext(ctx, SessionUUIDMetadataKey, sessionUUID)
 }
+
+// AddServiceTokenToGRPC добавляет токен сервиса в исходящие gRPC metadata
+func AddServiceTokenToGRPC(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, ServiceTokenMetadataKey, token)
+}
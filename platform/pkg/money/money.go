@@ -0,0 +1,89 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// DefaultCurrency валюта, в которой ведётся каталог по умолчанию.
+const DefaultCurrency = "RUB"
+
+// minorUnits количество минимальных единиц в одной основной (копеек в рубле, центов в долларе).
+// Все используемые валюты имеют две цифры после запятой.
+const minorUnits = 100
+
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// Money денежная сумма в минимальных единицах валюты.
+// Арифметика выполняется в целых числах, поэтому суммы не накапливают ошибок округления.
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// New создаёт сумму из минимальных единиц.
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Zero нулевая сумма в валюте currency.
+func Zero(currency string) Money {
+	return Money{Currency: currency}
+}
+
+// FromMajor переводит сумму в основных единицах (например, 12.34 рубля) в Money
+// с округлением до ближайшей минимальной единицы. Нужен только на границах со старыми данными.
+func FromMajor(major float64, currency string) Money {
+	return Money{Amount: int64(math.Round(major * minorUnits)), Currency: currency}
+}
+
+// Major сумма в основных единицах. Только для отображения: в расчётах не использовать.
+func (m Money) Major() float64 {
+	return float64(m.Amount) / minorUnits
+}
+
+// Add складывает суммы одной валюты. Нулевая сумма без валюты принимает валюту второго слагаемого.
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency == "" && m.Amount == 0 {
+		return other, nil
+	}
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+// Sub вычитает сумму той же валюты. Нулевая сумма без валюты ничего не меняет.
+func (m Money) Sub(other Money) (Money, error) {
+	if other.Currency == "" && other.Amount == 0 {
+		return m, nil
+	}
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+
+	return Money{Amount: m.Amount - other.Amount, Currency: m.Currency}, nil
+}
+
+// Mul умножает сумму на целое количество.
+func (m Money) Mul(quantity int64) Money {
+	return Money{Amount: m.Amount * quantity, Currency: m.Currency}
+}
+
+// IsZero сообщает, равна ли сумма нулю.
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) String() string {
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	return fmt.Sprintf("%s%d.%02d %s", sign, amount/minorUnits, amount%minorUnits, m.Currency)
+}
@@ -0,0 +1,25 @@
+package money
+
+import (
+	commonV1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/common/v1"
+)
+
+// ToProto конвертирует Money → proto Money.
+func ToProto(m Money) *commonV1.Money {
+	return &commonV1.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+// FromProto конвертирует proto Money → Money. nil превращается в нулевую сумму без валюты.
+func FromProto(m *commonV1.Money) Money {
+	if m == nil {
+		return Money{}
+	}
+
+	return Money{
+		Amount:   m.GetAmount(),
+		Currency: m.GetCurrency(),
+	}
+}
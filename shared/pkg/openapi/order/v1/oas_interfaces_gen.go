@@ -1,22 +1,70 @@
 // Code generated by ogen, DO NOT EDIT.
 package order_v1
 
+type AddCartItemRes interface {
+	addCartItemRes()
+}
+
 type CancelOrderRes interface {
 	cancelOrderRes()
 }
 
+type CheckoutCartRes interface {
+	checkoutCartRes()
+}
+
 type CreateOrderRes interface {
 	createOrderRes()
 }
 
+type CreatePromoCodeRes interface {
+	createPromoCodeRes()
+}
+
+type DeactivatePromoCodeRes interface {
+	deactivatePromoCodeRes()
+}
+
+type GetCartRes interface {
+	getCartRes()
+}
+
 type GetOrderRes interface {
 	getOrderRes()
 }
 
+type GetOrderStatusHistoryRes interface {
+	getOrderStatusHistoryRes()
+}
+
+type GetPromoCodeRes interface {
+	getPromoCodeRes()
+}
+
 type HealthRes interface {
 	healthRes()
 }
 
+type ListOrdersRes interface {
+	listOrdersRes()
+}
+
+type ListPromoCodesRes interface {
+	listPromoCodesRes()
+}
+
 type PayOrderRes interface {
 	payOrderRes()
 }
+
+type QuoteOrderRes interface {
+	quoteOrderRes()
+}
+
+type RemoveCartItemRes interface {
+	removeCartItemRes()
+}
+
+type UpdateOrderRes interface {
+	updateOrderRes()
+}
@@ -6,9 +6,21 @@ package order_v1
 type OperationName = string
 
 const (
-	CancelOrderOperation OperationName = "CancelOrder"
-	CreateOrderOperation OperationName = "CreateOrder"
-	GetOrderOperation    OperationName = "GetOrder"
-	HealthOperation      OperationName = "Health"
-	PayOrderOperation    OperationName = "PayOrder"
+	AddCartItemOperation           OperationName = "AddCartItem"
+	CancelOrderOperation           OperationName = "CancelOrder"
+	CheckoutCartOperation          OperationName = "CheckoutCart"
+	CreateOrderOperation           OperationName = "CreateOrder"
+	CreatePromoCodeOperation       OperationName = "CreatePromoCode"
+	DeactivatePromoCodeOperation   OperationName = "DeactivatePromoCode"
+	GetCartOperation               OperationName = "GetCart"
+	GetOrderOperation              OperationName = "GetOrder"
+	GetOrderStatusHistoryOperation OperationName = "GetOrderStatusHistory"
+	GetPromoCodeOperation          OperationName = "GetPromoCode"
+	HealthOperation                OperationName = "Health"
+	ListOrdersOperation            OperationName = "ListOrders"
+	ListPromoCodesOperation        OperationName = "ListPromoCodes"
+	PayOrderOperation              OperationName = "PayOrder"
+	QuoteOrderOperation            OperationName = "QuoteOrder"
+	RemoveCartItemOperation        OperationName = "RemoveCartItem"
+	UpdateOrderOperation           OperationName = "UpdateOrder"
 )
@@ -61,48 +61,105 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
-			case 'a': // Prefix: "api/v1/orders"
+			case 'a': // Prefix: "api/v1/"
 
-				if l := len("api/v1/orders"); len(elem) >= l && elem[0:l] == "api/v1/orders" {
+				if l := len("api/v1/"); len(elem) >= l && elem[0:l] == "api/v1/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					switch r.Method {
-					case "POST":
-						s.handleCreateOrderRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "POST")
-					}
-
-					return
+					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/"
+				case 'a': // Prefix: "admin/promo-codes"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("admin/promo-codes"); len(elem) >= l && elem[0:l] == "admin/promo-codes" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "order_uuid"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleListPromoCodesRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreatePromoCodeRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "code"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleGetPromoCodeRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/deactivate"
+
+							if l := len("/deactivate"); len(elem) >= l && elem[0:l] == "/deactivate" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleDeactivatePromoCodeRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+						}
+					}
+
+				case 'c': // Prefix: "cart"
+
+					if l := len("cart"); len(elem) >= l && elem[0:l] == "cart" {
+						elem = elem[l:]
+					} else {
+						break
 					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
 
 					if len(elem) == 0 {
 						switch r.Method {
 						case "GET":
-							s.handleGetOrderRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
+							s.handleGetCartRequest([0]string{}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, "GET")
 						}
@@ -122,9 +179,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 						switch elem[0] {
-						case 'c': // Prefix: "cancel"
+						case 'c': // Prefix: "checkout"
 
-							if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+							if l := len("checkout"); len(elem) >= l && elem[0:l] == "checkout" {
 								elem = elem[l:]
 							} else {
 								break
@@ -134,9 +191,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								// Leaf node.
 								switch r.Method {
 								case "POST":
-									s.handleCancelOrderRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
+									s.handleCheckoutCartRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, "POST")
 								}
@@ -144,9 +199,96 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								return
 							}
 
-						case 'p': // Prefix: "pay"
+						case 'i': // Prefix: "items"
 
-							if l := len("pay"); len(elem) >= l && elem[0:l] == "pay" {
+							if l := len("items"); len(elem) >= l && elem[0:l] == "items" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "POST":
+									s.handleAddCartItemRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "part_uuid"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleRemoveCartItemRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE")
+									}
+
+									return
+								}
+							}
+
+						}
+					}
+
+				case 'o': // Prefix: "orders"
+
+					if l := len("orders"); len(elem) >= l && elem[0:l] == "orders" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleListOrdersRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreateOrderRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'q': // Prefix: "quote"
+							origElem := elem
+							if l := len("quote"); len(elem) >= l && elem[0:l] == "quote" {
 								elem = elem[l:]
 							} else {
 								break
@@ -156,9 +298,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								// Leaf node.
 								switch r.Method {
 								case "POST":
-									s.handlePayOrderRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
+									s.handleQuoteOrderRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, "POST")
 								}
@@ -166,8 +306,114 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								return
 							}
 
+							elem = origElem
+						}
+						// Param: "order_uuid"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
 						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
 
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleGetOrderRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handleUpdateOrderRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,PATCH")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "cancel"
+
+								if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleCancelOrderRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'h': // Prefix: "history"
+
+								if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetOrderStatusHistoryRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							case 'p': // Prefix: "pay"
+
+								if l := len("pay"); len(elem) >= l && elem[0:l] == "pay" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handlePayOrderRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+						}
 					}
 
 				}
@@ -193,7 +439,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 			}
-
 		}
 	}
 	s.notFound(w, r)
@@ -286,55 +531,124 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				break
 			}
 			switch elem[0] {
-			case 'a': // Prefix: "api/v1/orders"
+			case 'a': // Prefix: "api/v1/"
 
-				if l := len("api/v1/orders"); len(elem) >= l && elem[0:l] == "api/v1/orders" {
+				if l := len("api/v1/"); len(elem) >= l && elem[0:l] == "api/v1/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					switch method {
-					case "POST":
-						r.name = CreateOrderOperation
-						r.summary = "Создать заказ"
-						r.operationID = "CreateOrder"
-						r.pathPattern = "/api/v1/orders"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
+					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/"
+				case 'a': // Prefix: "admin/promo-codes"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("admin/promo-codes"); len(elem) >= l && elem[0:l] == "admin/promo-codes" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "order_uuid"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ListPromoCodesOperation
+							r.summary = "Получить список промокодов"
+							r.operationID = "ListPromoCodes"
+							r.pathPattern = "/api/v1/admin/promo-codes"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = CreatePromoCodeOperation
+							r.summary = "Создать промокод"
+							r.operationID = "CreatePromoCode"
+							r.pathPattern = "/api/v1/admin/promo-codes"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "code"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = GetPromoCodeOperation
+								r.summary = "Получить промокод"
+								r.operationID = "GetPromoCode"
+								r.pathPattern = "/api/v1/admin/promo-codes/{code}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/deactivate"
+
+							if l := len("/deactivate"); len(elem) >= l && elem[0:l] == "/deactivate" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = DeactivatePromoCodeOperation
+									r.summary = "Отключить промокод"
+									r.operationID = "DeactivatePromoCode"
+									r.pathPattern = "/api/v1/admin/promo-codes/{code}/deactivate"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+						}
+					}
+
+				case 'c': // Prefix: "cart"
+
+					if l := len("cart"); len(elem) >= l && elem[0:l] == "cart" {
+						elem = elem[l:]
+					} else {
+						break
 					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
 
 					if len(elem) == 0 {
 						switch method {
 						case "GET":
-							r.name = GetOrderOperation
-							r.summary = "Получить заказ"
-							r.operationID = "GetOrder"
-							r.pathPattern = "/api/v1/orders/{order_uuid}"
+							r.name = GetCartOperation
+							r.summary = "Получить корзину"
+							r.operationID = "GetCart"
+							r.pathPattern = "/api/v1/cart"
 							r.args = args
-							r.count = 1
+							r.count = 0
 							return r, true
 						default:
 							return
@@ -353,9 +667,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 						switch elem[0] {
-						case 'c': // Prefix: "cancel"
+						case 'c': // Prefix: "checkout"
 
-							if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+							if l := len("checkout"); len(elem) >= l && elem[0:l] == "checkout" {
 								elem = elem[l:]
 							} else {
 								break
@@ -365,21 +679,124 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								// Leaf node.
 								switch method {
 								case "POST":
-									r.name = CancelOrderOperation
-									r.summary = "Отменить заказ"
-									r.operationID = "CancelOrder"
-									r.pathPattern = "/api/v1/orders/{order_uuid}/cancel"
+									r.name = CheckoutCartOperation
+									r.summary = "Оформить заказ из корзины"
+									r.operationID = "CheckoutCart"
+									r.pathPattern = "/api/v1/cart/checkout"
 									r.args = args
-									r.count = 1
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'i': // Prefix: "items"
+
+							if l := len("items"); len(elem) >= l && elem[0:l] == "items" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									r.name = AddCartItemOperation
+									r.summary = "Добавить деталь в корзину"
+									r.operationID = "AddCartItem"
+									r.pathPattern = "/api/v1/cart/items"
+									r.args = args
+									r.count = 0
 									return r, true
 								default:
 									return
 								}
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "part_uuid"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = RemoveCartItemOperation
+										r.summary = "Убрать деталь из корзины"
+										r.operationID = "RemoveCartItem"
+										r.pathPattern = "/api/v1/cart/items/{part_uuid}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+							}
+
+						}
+					}
+
+				case 'o': // Prefix: "orders"
+
+					if l := len("orders"); len(elem) >= l && elem[0:l] == "orders" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ListOrdersOperation
+							r.summary = "Получить список заказов"
+							r.operationID = "ListOrders"
+							r.pathPattern = "/api/v1/orders"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = CreateOrderOperation
+							r.summary = "Создать заказ"
+							r.operationID = "CreateOrder"
+							r.pathPattern = "/api/v1/orders"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 
-						case 'p': // Prefix: "pay"
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-							if l := len("pay"); len(elem) >= l && elem[0:l] == "pay" {
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'q': // Prefix: "quote"
+							origElem := elem
+							if l := len("quote"); len(elem) >= l && elem[0:l] == "quote" {
 								elem = elem[l:]
 							} else {
 								break
@@ -389,20 +806,138 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								// Leaf node.
 								switch method {
 								case "POST":
-									r.name = PayOrderOperation
-									r.summary = "Оплатить заказ"
-									r.operationID = "PayOrder"
-									r.pathPattern = "/api/v1/orders/{order_uuid}/pay"
+									r.name = QuoteOrderOperation
+									r.summary = "Рассчитать стоимость заказа"
+									r.operationID = "QuoteOrder"
+									r.pathPattern = "/api/v1/orders/quote"
 									r.args = args
-									r.count = 1
+									r.count = 0
 									return r, true
 								default:
 									return
 								}
 							}
 
+							elem = origElem
+						}
+						// Param: "order_uuid"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
 						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
 
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = GetOrderOperation
+								r.summary = "Получить заказ"
+								r.operationID = "GetOrder"
+								r.pathPattern = "/api/v1/orders/{order_uuid}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PATCH":
+								r.name = UpdateOrderOperation
+								r.summary = "Изменить состав заказа"
+								r.operationID = "UpdateOrder"
+								r.pathPattern = "/api/v1/orders/{order_uuid}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "cancel"
+
+								if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = CancelOrderOperation
+										r.summary = "Отменить заказ"
+										r.operationID = "CancelOrder"
+										r.pathPattern = "/api/v1/orders/{order_uuid}/cancel"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'h': // Prefix: "history"
+
+								if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetOrderStatusHistoryOperation
+										r.summary = "Получить историю статусов заказа"
+										r.operationID = "GetOrderStatusHistory"
+										r.pathPattern = "/api/v1/orders/{order_uuid}/history"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'p': // Prefix: "pay"
+
+								if l := len("pay"); len(elem) >= l && elem[0:l] == "pay" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = PayOrderOperation
+										r.summary = "Оплатить заказ"
+										r.operationID = "PayOrder"
+										r.pathPattern = "/api/v1/orders/{order_uuid}/pay"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+						}
 					}
 
 				}
@@ -432,7 +967,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 			}
-
 		}
 	}
 	return r, false
@@ -3,6 +3,8 @@
 package order_v1
 
 import (
+	"time"
+
 	"github.com/go-faster/errors"
 )
 
@@ -34,16 +36,76 @@ func (s *BadRequestError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*BadRequestError) cancelOrderRes() {}
-func (*BadRequestError) createOrderRes() {}
-func (*BadRequestError) getOrderRes()    {}
-func (*BadRequestError) payOrderRes()    {}
+func (*BadRequestError) addCartItemRes()           {}
+func (*BadRequestError) cancelOrderRes()           {}
+func (*BadRequestError) checkoutCartRes()          {}
+func (*BadRequestError) createOrderRes()           {}
+func (*BadRequestError) createPromoCodeRes()       {}
+func (*BadRequestError) getOrderRes()              {}
+func (*BadRequestError) getOrderStatusHistoryRes() {}
+func (*BadRequestError) listOrdersRes()            {}
+func (*BadRequestError) payOrderRes()              {}
+func (*BadRequestError) quoteOrderRes()            {}
+func (*BadRequestError) updateOrderRes()           {}
 
 // CancelOrderNoContent is response for CancelOrder operation.
 type CancelOrderNoContent struct{}
 
 func (*CancelOrderNoContent) cancelOrderRes() {}
 
+// Ref: #/components/schemas/cart
+type Cart struct {
+	// Позиции корзины. Цены не хранятся, их показывает
+	// расчёт стоимости.
+	Items []OrderItemRequest `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *Cart) GetItems() []OrderItemRequest {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *Cart) SetItems(val []OrderItemRequest) {
+	s.Items = val
+}
+
+func (*Cart) addCartItemRes()    {}
+func (*Cart) getCartRes()        {}
+func (*Cart) removeCartItemRes() {}
+
+// Ref: #/components/schemas/checkout_cart_request
+type CheckoutCartRequest struct {
+	// Промокод на скидку. Регистр и пробелы по краям не
+	// учитываются.
+	PromoCode OptString `json:"promo_code"`
+	// Токен расчёта из POST /api/v1/orders/quote. Позиции расчёта
+	// должны совпадать с корзиной,
+	// заказ оформляется по ценам и скидке расчёта. Расчёт
+	// одноразовый.
+	QuoteToken OptString `json:"quote_token"`
+}
+
+// GetPromoCode returns the value of PromoCode.
+func (s *CheckoutCartRequest) GetPromoCode() OptString {
+	return s.PromoCode
+}
+
+// GetQuoteToken returns the value of QuoteToken.
+func (s *CheckoutCartRequest) GetQuoteToken() OptString {
+	return s.QuoteToken
+}
+
+// SetPromoCode sets the value of PromoCode.
+func (s *CheckoutCartRequest) SetPromoCode(val OptString) {
+	s.PromoCode = val
+}
+
+// SetQuoteToken sets the value of QuoteToken.
+func (s *CheckoutCartRequest) SetQuoteToken(val OptString) {
+	s.QuoteToken = val
+}
+
 // Ref: #/components/schemas/conflict_error
 type ConflictError struct {
 	// HTTP-код ошибки.
@@ -51,6 +113,12 @@ type ConflictError struct {
 	// Конфликт состояния (например, попытка отменить уже
 	// оплаченный заказ).
 	Message string `json:"message"`
+	// Детали, которых не хватает на складе (только при
+	// нехватке остатков).
+	PartUuids []string `json:"part_uuids"`
+	// Стоимость заказа по текущему каталогу (только если
+	// цены изменились или детали сняты с продажи).
+	Quote OptPriceQuote `json:"quote"`
 }
 
 // GetCode returns the value of Code.
@@ -63,6 +131,16 @@ func (s *ConflictError) GetMessage() string {
 	return s.Message
 }
 
+// GetPartUuids returns the value of PartUuids.
+func (s *ConflictError) GetPartUuids() []string {
+	return s.PartUuids
+}
+
+// GetQuote returns the value of Quote.
+func (s *ConflictError) GetQuote() OptPriceQuote {
+	return s.Quote
+}
+
 // SetCode sets the value of Code.
 func (s *ConflictError) SetCode(val int) {
 	s.Code = val
@@ -73,42 +151,115 @@ func (s *ConflictError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*ConflictError) cancelOrderRes() {}
+// SetPartUuids sets the value of PartUuids.
+func (s *ConflictError) SetPartUuids(val []string) {
+	s.PartUuids = val
+}
+
+// SetQuote sets the value of Quote.
+func (s *ConflictError) SetQuote(val OptPriceQuote) {
+	s.Quote = val
+}
+
+func (*ConflictError) cancelOrderRes()     {}
+func (*ConflictError) checkoutCartRes()    {}
+func (*ConflictError) createOrderRes()     {}
+func (*ConflictError) createPromoCodeRes() {}
+func (*ConflictError) payOrderRes()        {}
+func (*ConflictError) quoteOrderRes()      {}
+func (*ConflictError) updateOrderRes()     {}
 
 // Ref: #/components/schemas/create_order_request
 type CreateOrderRequest struct {
-	// UUID пользователя.
-	UserUUID string `json:"user_uuid"`
-	// Список UUID деталей, входящих в заказ.
+	// UUID пользователя. Устарело: владелец заказа
+	// определяется по сессии.
+	// Если передан и не совпадает с пользователем сессии,
+	// возвращается 403.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	UserUUID OptString `json:"user_uuid"`
+	// Позиции заказа. Повторяющиеся детали объединяются с
+	// суммированием количества.
+	// Можно не передавать, если указан quote_token; если переданы,
+	//  должны совпадать с расчётом.
+	Items []OrderItemRequest `json:"items"`
+	// Список UUID деталей, входящих в заказ. Устарело:
+	// используйте items.
+	// Учитывается, только если items не передан; каждая деталь
+	// считается отдельной единицей.
+	//
+	// Deprecated: schema marks this property as deprecated.
 	PartUuids []string `json:"part_uuids"`
+	// Промокод на скидку. Регистр и пробелы по краям не
+	// учитываются.
+	PromoCode OptString `json:"promo_code"`
+	// Токен расчёта из POST /api/v1/orders/quote. Заказ оформляется по
+	// ценам и скидке расчёта.
+	// Расчёт одноразовый: истёкший или уже использованный
+	// расчёт отклоняется с 409.
+	QuoteToken OptString `json:"quote_token"`
 }
 
 // GetUserUUID returns the value of UserUUID.
-func (s *CreateOrderRequest) GetUserUUID() string {
+func (s *CreateOrderRequest) GetUserUUID() OptString {
 	return s.UserUUID
 }
 
+// GetItems returns the value of Items.
+func (s *CreateOrderRequest) GetItems() []OrderItemRequest {
+	return s.Items
+}
+
 // GetPartUuids returns the value of PartUuids.
 func (s *CreateOrderRequest) GetPartUuids() []string {
 	return s.PartUuids
 }
 
+// GetPromoCode returns the value of PromoCode.
+func (s *CreateOrderRequest) GetPromoCode() OptString {
+	return s.PromoCode
+}
+
+// GetQuoteToken returns the value of QuoteToken.
+func (s *CreateOrderRequest) GetQuoteToken() OptString {
+	return s.QuoteToken
+}
+
 // SetUserUUID sets the value of UserUUID.
-func (s *CreateOrderRequest) SetUserUUID(val string) {
+func (s *CreateOrderRequest) SetUserUUID(val OptString) {
 	s.UserUUID = val
 }
 
+// SetItems sets the value of Items.
+func (s *CreateOrderRequest) SetItems(val []OrderItemRequest) {
+	s.Items = val
+}
+
 // SetPartUuids sets the value of PartUuids.
 func (s *CreateOrderRequest) SetPartUuids(val []string) {
 	s.PartUuids = val
 }
 
+// SetPromoCode sets the value of PromoCode.
+func (s *CreateOrderRequest) SetPromoCode(val OptString) {
+	s.PromoCode = val
+}
+
+// SetQuoteToken sets the value of QuoteToken.
+func (s *CreateOrderRequest) SetQuoteToken(val OptString) {
+	s.QuoteToken = val
+}
+
 // Ref: #/components/schemas/create_order_response
 type CreateOrderResponse struct {
 	// Уникальный идентификатор заказа.
 	OrderUUID string `json:"order_uuid"`
+	// Сумма позиций до скидки.
+	Subtotal Money `json:"subtotal"`
+	// Скидка по промокоду.
+	Discount Money `json:"discount"`
 	// Итоговая стоимость заказа.
-	TotalPrice float64 `json:"total_price"`
+	TotalPrice Money `json:"total_price"`
 }
 
 // GetOrderUUID returns the value of OrderUUID.
@@ -116,8 +267,18 @@ func (s *CreateOrderResponse) GetOrderUUID() string {
 	return s.OrderUUID
 }
 
+// GetSubtotal returns the value of Subtotal.
+func (s *CreateOrderResponse) GetSubtotal() Money {
+	return s.Subtotal
+}
+
+// GetDiscount returns the value of Discount.
+func (s *CreateOrderResponse) GetDiscount() Money {
+	return s.Discount
+}
+
 // GetTotalPrice returns the value of TotalPrice.
-func (s *CreateOrderResponse) GetTotalPrice() float64 {
+func (s *CreateOrderResponse) GetTotalPrice() Money {
 	return s.TotalPrice
 }
 
@@ -126,12 +287,199 @@ func (s *CreateOrderResponse) SetOrderUUID(val string) {
 	s.OrderUUID = val
 }
 
+// SetSubtotal sets the value of Subtotal.
+func (s *CreateOrderResponse) SetSubtotal(val Money) {
+	s.Subtotal = val
+}
+
+// SetDiscount sets the value of Discount.
+func (s *CreateOrderResponse) SetDiscount(val Money) {
+	s.Discount = val
+}
+
 // SetTotalPrice sets the value of TotalPrice.
-func (s *CreateOrderResponse) SetTotalPrice(val float64) {
+func (s *CreateOrderResponse) SetTotalPrice(val Money) {
 	s.TotalPrice = val
 }
 
-func (*CreateOrderResponse) createOrderRes() {}
+func (*CreateOrderResponse) checkoutCartRes() {}
+func (*CreateOrderResponse) createOrderRes()  {}
+
+// Ref: #/components/schemas/create_promo_code_request
+type CreatePromoCodeRequest struct {
+	// Промокод. Хранится в верхнем регистре; допустимы A-Z, 0-9,
+	//  '_' и '-'.
+	Code         string       `json:"code"`
+	DiscountType DiscountType `json:"discount_type"`
+	// Процент скидки. Обязателен для PERCENT.
+	PercentOff OptInt64 `json:"percent_off"`
+	// Сумма скидки. Обязательна для FIXED.
+	AmountOff OptMoney `json:"amount_off"`
+	// Категории деталей, на которые действует скидка. Не
+	// передан — все детали.
+	Categories []PartCategory `json:"categories"`
+	// Сколько заказов можно оформить с кодом. Не передан —
+	// без ограничения.
+	MaxUses OptInt64 `json:"max_uses"`
+	// Момент, после которого код перестаёт действовать. Не
+	// передан — бессрочно.
+	ExpiresAt OptDateTime `json:"expires_at"`
+}
+
+// GetCode returns the value of Code.
+func (s *CreatePromoCodeRequest) GetCode() string {
+	return s.Code
+}
+
+// GetDiscountType returns the value of DiscountType.
+func (s *CreatePromoCodeRequest) GetDiscountType() DiscountType {
+	return s.DiscountType
+}
+
+// GetPercentOff returns the value of PercentOff.
+func (s *CreatePromoCodeRequest) GetPercentOff() OptInt64 {
+	return s.PercentOff
+}
+
+// GetAmountOff returns the value of AmountOff.
+func (s *CreatePromoCodeRequest) GetAmountOff() OptMoney {
+	return s.AmountOff
+}
+
+// GetCategories returns the value of Categories.
+func (s *CreatePromoCodeRequest) GetCategories() []PartCategory {
+	return s.Categories
+}
+
+// GetMaxUses returns the value of MaxUses.
+func (s *CreatePromoCodeRequest) GetMaxUses() OptInt64 {
+	return s.MaxUses
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *CreatePromoCodeRequest) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// SetCode sets the value of Code.
+func (s *CreatePromoCodeRequest) SetCode(val string) {
+	s.Code = val
+}
+
+// SetDiscountType sets the value of DiscountType.
+func (s *CreatePromoCodeRequest) SetDiscountType(val DiscountType) {
+	s.DiscountType = val
+}
+
+// SetPercentOff sets the value of PercentOff.
+func (s *CreatePromoCodeRequest) SetPercentOff(val OptInt64) {
+	s.PercentOff = val
+}
+
+// SetAmountOff sets the value of AmountOff.
+func (s *CreatePromoCodeRequest) SetAmountOff(val OptMoney) {
+	s.AmountOff = val
+}
+
+// SetCategories sets the value of Categories.
+func (s *CreatePromoCodeRequest) SetCategories(val []PartCategory) {
+	s.Categories = val
+}
+
+// SetMaxUses sets the value of MaxUses.
+func (s *CreatePromoCodeRequest) SetMaxUses(val OptInt64) {
+	s.MaxUses = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *CreatePromoCodeRequest) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
+// Тип скидки:
+// - PERCENT — процент от стоимости подходящих позиций
+// - FIXED — фиксированная сумма, но не больше стоимости
+// подходящих позиций.
+// Ref: #/components/schemas/discount_type
+type DiscountType string
+
+const (
+	DiscountTypePERCENT DiscountType = "PERCENT"
+	DiscountTypeFIXED   DiscountType = "FIXED"
+)
+
+// AllValues returns all DiscountType values.
+func (DiscountType) AllValues() []DiscountType {
+	return []DiscountType{
+		DiscountTypePERCENT,
+		DiscountTypeFIXED,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s DiscountType) MarshalText() ([]byte, error) {
+	switch s {
+	case DiscountTypePERCENT:
+		return []byte(s), nil
+	case DiscountTypeFIXED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *DiscountType) UnmarshalText(data []byte) error {
+	switch DiscountType(data) {
+	case DiscountTypePERCENT:
+		*s = DiscountTypePERCENT
+		return nil
+	case DiscountTypeFIXED:
+		*s = DiscountTypeFIXED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/forbidden_error
+type ForbiddenError struct {
+	// HTTP-код ошибки.
+	Code int `json:"code"`
+	// Доступ к ресурсу запрещён.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *ForbiddenError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *ForbiddenError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *ForbiddenError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ForbiddenError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ForbiddenError) cancelOrderRes()           {}
+func (*ForbiddenError) createOrderRes()           {}
+func (*ForbiddenError) createPromoCodeRes()       {}
+func (*ForbiddenError) deactivatePromoCodeRes()   {}
+func (*ForbiddenError) getOrderRes()              {}
+func (*ForbiddenError) getOrderStatusHistoryRes() {}
+func (*ForbiddenError) getPromoCodeRes()          {}
+func (*ForbiddenError) listPromoCodesRes()        {}
+func (*ForbiddenError) payOrderRes()              {}
+func (*ForbiddenError) updateOrderRes()           {}
 
 // Ref: #/components/schemas/generic_error
 type GenericError struct {
@@ -187,10 +535,22 @@ func (s *GenericErrorStatusCode) SetResponse(val GenericError) {
 	s.Response = val
 }
 
-func (*GenericErrorStatusCode) cancelOrderRes() {}
-func (*GenericErrorStatusCode) createOrderRes() {}
-func (*GenericErrorStatusCode) getOrderRes()    {}
-func (*GenericErrorStatusCode) payOrderRes()    {}
+func (*GenericErrorStatusCode) addCartItemRes()           {}
+func (*GenericErrorStatusCode) cancelOrderRes()           {}
+func (*GenericErrorStatusCode) checkoutCartRes()          {}
+func (*GenericErrorStatusCode) createOrderRes()           {}
+func (*GenericErrorStatusCode) createPromoCodeRes()       {}
+func (*GenericErrorStatusCode) deactivatePromoCodeRes()   {}
+func (*GenericErrorStatusCode) getCartRes()               {}
+func (*GenericErrorStatusCode) getOrderRes()              {}
+func (*GenericErrorStatusCode) getOrderStatusHistoryRes() {}
+func (*GenericErrorStatusCode) getPromoCodeRes()          {}
+func (*GenericErrorStatusCode) listOrdersRes()            {}
+func (*GenericErrorStatusCode) listPromoCodesRes()        {}
+func (*GenericErrorStatusCode) payOrderRes()              {}
+func (*GenericErrorStatusCode) quoteOrderRes()            {}
+func (*GenericErrorStatusCode) removeCartItemRes()        {}
+func (*GenericErrorStatusCode) updateOrderRes()           {}
 
 // Ref: #/components/schemas/health_request
 type HealthRequest struct {
@@ -250,11 +610,102 @@ func (s *InternalServerError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*InternalServerError) cancelOrderRes() {}
-func (*InternalServerError) createOrderRes() {}
-func (*InternalServerError) getOrderRes()    {}
-func (*InternalServerError) healthRes()      {}
-func (*InternalServerError) payOrderRes()    {}
+func (*InternalServerError) addCartItemRes()           {}
+func (*InternalServerError) cancelOrderRes()           {}
+func (*InternalServerError) checkoutCartRes()          {}
+func (*InternalServerError) createOrderRes()           {}
+func (*InternalServerError) createPromoCodeRes()       {}
+func (*InternalServerError) deactivatePromoCodeRes()   {}
+func (*InternalServerError) getCartRes()               {}
+func (*InternalServerError) getOrderRes()              {}
+func (*InternalServerError) getOrderStatusHistoryRes() {}
+func (*InternalServerError) getPromoCodeRes()          {}
+func (*InternalServerError) healthRes()                {}
+func (*InternalServerError) listOrdersRes()            {}
+func (*InternalServerError) listPromoCodesRes()        {}
+func (*InternalServerError) payOrderRes()              {}
+func (*InternalServerError) quoteOrderRes()            {}
+func (*InternalServerError) removeCartItemRes()        {}
+func (*InternalServerError) updateOrderRes()           {}
+
+// Ref: #/components/schemas/list_orders_response
+type ListOrdersResponse struct {
+	// Заказы пользователя, отсортированные от новых к
+	// старым.
+	Orders []OrderDto `json:"orders"`
+	// Курсор следующей страницы. Отсутствует, если
+	// страница последняя.
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetOrders returns the value of Orders.
+func (s *ListOrdersResponse) GetOrders() []OrderDto {
+	return s.Orders
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListOrdersResponse) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetOrders sets the value of Orders.
+func (s *ListOrdersResponse) SetOrders(val []OrderDto) {
+	s.Orders = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListOrdersResponse) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*ListOrdersResponse) listOrdersRes() {}
+
+// Ref: #/components/schemas/list_promo_codes_response
+type ListPromoCodesResponse struct {
+	// Промокоды, отсортированные от новых к старым.
+	PromoCodes []PromoCode `json:"promo_codes"`
+}
+
+// GetPromoCodes returns the value of PromoCodes.
+func (s *ListPromoCodesResponse) GetPromoCodes() []PromoCode {
+	return s.PromoCodes
+}
+
+// SetPromoCodes sets the value of PromoCodes.
+func (s *ListPromoCodesResponse) SetPromoCodes(val []PromoCode) {
+	s.PromoCodes = val
+}
+
+func (*ListPromoCodesResponse) listPromoCodesRes() {}
+
+// Денежная сумма в минимальных единицах валюты.
+// Ref: #/components/schemas/money
+type Money struct {
+	// Сумма в минимальных единицах валюты (копейках, центах).
+	Amount int64 `json:"amount"`
+	// Код валюты ISO 4217.
+	Currency string `json:"currency"`
+}
+
+// GetAmount returns the value of Amount.
+func (s *Money) GetAmount() int64 {
+	return s.Amount
+}
+
+// GetCurrency returns the value of Currency.
+func (s *Money) GetCurrency() string {
+	return s.Currency
+}
+
+// SetAmount sets the value of Amount.
+func (s *Money) SetAmount(val int64) {
+	s.Amount = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *Money) SetCurrency(val string) {
+	s.Currency = val
+}
 
 // Ref: #/components/schemas/not_found_error
 type NotFoundError struct {
@@ -284,10 +735,63 @@ func (s *NotFoundError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*NotFoundError) cancelOrderRes() {}
-func (*NotFoundError) createOrderRes() {}
-func (*NotFoundError) getOrderRes()    {}
-func (*NotFoundError) payOrderRes()    {}
+func (*NotFoundError) addCartItemRes()           {}
+func (*NotFoundError) cancelOrderRes()           {}
+func (*NotFoundError) checkoutCartRes()          {}
+func (*NotFoundError) createOrderRes()           {}
+func (*NotFoundError) deactivatePromoCodeRes()   {}
+func (*NotFoundError) getOrderRes()              {}
+func (*NotFoundError) getOrderStatusHistoryRes() {}
+func (*NotFoundError) getPromoCodeRes()          {}
+func (*NotFoundError) payOrderRes()              {}
+func (*NotFoundError) quoteOrderRes()            {}
+func (*NotFoundError) updateOrderRes()           {}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
 // NewOptInt returns new OptInt with value set to v.
 func NewOptInt(v int) OptInt {
@@ -335,38 +839,38 @@ func (o OptInt) Or(d int) int {
 	return d
 }
 
-// NewOptPaymentMethod returns new OptPaymentMethod with value set to v.
-func NewOptPaymentMethod(v PaymentMethod) OptPaymentMethod {
-	return OptPaymentMethod{
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptPaymentMethod is optional PaymentMethod.
-type OptPaymentMethod struct {
-	Value PaymentMethod
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
 	Set   bool
 }
 
-// IsSet returns true if OptPaymentMethod was set.
-func (o OptPaymentMethod) IsSet() bool { return o.Set }
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptPaymentMethod) Reset() {
-	var v PaymentMethod
+func (o *OptInt64) Reset() {
+	var v int64
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptPaymentMethod) SetTo(v PaymentMethod) {
+func (o *OptInt64) SetTo(v int64) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptPaymentMethod) Get() (v PaymentMethod, ok bool) {
+func (o OptInt64) Get() (v int64, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -374,45 +878,45 @@ func (o OptPaymentMethod) Get() (v PaymentMethod, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptPaymentMethod) Or(d PaymentMethod) PaymentMethod {
+func (o OptInt64) Or(d int64) int64 {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
+// NewOptMoney returns new OptMoney with value set to v.
+func NewOptMoney(v Money) OptMoney {
+	return OptMoney{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
+// OptMoney is optional Money.
+type OptMoney struct {
+	Value Money
 	Set   bool
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// IsSet returns true if OptMoney was set.
+func (o OptMoney) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
+func (o *OptMoney) Reset() {
+	var v Money
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
+func (o *OptMoney) SetTo(v Money) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
+func (o OptMoney) Get() (v Money, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -420,49 +924,221 @@ func (o OptString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
+func (o OptMoney) Or(d Money) Money {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// Ref: #/components/schemas/order_dto
-type OrderDto struct {
-	// UUID заказа.
-	OrderUUID string `json:"order_uuid"`
-	// UUID пользователя.
-	UserUUID string `json:"user_uuid"`
-	// Список UUID деталей.
-	PartUuids []string `json:"part_uuids"`
-	// Итоговая стоимость заказа.
-	TotalPrice float64 `json:"total_price"`
-	// UUID транзакции оплаты (если оплачен).
-	TransactionUUID OptString        `json:"transaction_uuid"`
-	PaymentMethod   OptPaymentMethod `json:"payment_method"`
-	Status          OrderStatus      `json:"status"`
+// NewOptPaymentMethod returns new OptPaymentMethod with value set to v.
+func NewOptPaymentMethod(v PaymentMethod) OptPaymentMethod {
+	return OptPaymentMethod{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetOrderUUID returns the value of OrderUUID.
-func (s *OrderDto) GetOrderUUID() string {
-	return s.OrderUUID
+// OptPaymentMethod is optional PaymentMethod.
+type OptPaymentMethod struct {
+	Value PaymentMethod
+	Set   bool
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *OrderDto) GetUserUUID() string {
-	return s.UserUUID
+// IsSet returns true if OptPaymentMethod was set.
+func (o OptPaymentMethod) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPaymentMethod) Reset() {
+	var v PaymentMethod
+	o.Value = v
+	o.Set = false
 }
 
-// GetPartUuids returns the value of PartUuids.
+// SetTo sets value to v.
+func (o *OptPaymentMethod) SetTo(v PaymentMethod) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPaymentMethod) Get() (v PaymentMethod, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPaymentMethod) Or(d PaymentMethod) PaymentMethod {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPriceQuote returns new OptPriceQuote with value set to v.
+func NewOptPriceQuote(v PriceQuote) OptPriceQuote {
+	return OptPriceQuote{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPriceQuote is optional PriceQuote.
+type OptPriceQuote struct {
+	Value PriceQuote
+	Set   bool
+}
+
+// IsSet returns true if OptPriceQuote was set.
+func (o OptPriceQuote) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPriceQuote) Reset() {
+	var v PriceQuote
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPriceQuote) SetTo(v PriceQuote) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPriceQuote) Get() (v PriceQuote, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPriceQuote) Or(d PriceQuote) PriceQuote {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/order_dto
+type OrderDto struct {
+	// UUID заказа.
+	OrderUUID string `json:"order_uuid"`
+	// UUID пользователя.
+	UserUUID string `json:"user_uuid"`
+	// Список UUID деталей.
+	PartUuids []string `json:"part_uuids"`
+	// Позиции заказа с зафиксированными ценой и названием
+	// детали.
+	Items []OrderItem `json:"items"`
+	// Сумма позиций до скидки.
+	Subtotal Money `json:"subtotal"`
+	// Скидка по промокоду.
+	Discount Money `json:"discount"`
+	// Итоговая стоимость заказа.
+	TotalPrice Money `json:"total_price"`
+	// Промокод, применённый при оформлении заказа.
+	PromoCode OptString `json:"promo_code"`
+	// UUID транзакции оплаты (если оплачен).
+	TransactionUUID OptString        `json:"transaction_uuid"`
+	PaymentMethod   OptPaymentMethod `json:"payment_method"`
+	Status          OrderStatus      `json:"status"`
+	// Версия заказа, растёт с каждым изменением. Передаётся
+	// при редактировании.
+	Version int64 `json:"version"`
+	// Дата и время создания заказа.
+	CreatedAt OptDateTime `json:"created_at"`
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *OrderDto) GetOrderUUID() string {
+	return s.OrderUUID
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *OrderDto) GetUserUUID() string {
+	return s.UserUUID
+}
+
+// GetPartUuids returns the value of PartUuids.
 func (s *OrderDto) GetPartUuids() []string {
 	return s.PartUuids
 }
 
+// GetItems returns the value of Items.
+func (s *OrderDto) GetItems() []OrderItem {
+	return s.Items
+}
+
+// GetSubtotal returns the value of Subtotal.
+func (s *OrderDto) GetSubtotal() Money {
+	return s.Subtotal
+}
+
+// GetDiscount returns the value of Discount.
+func (s *OrderDto) GetDiscount() Money {
+	return s.Discount
+}
+
 // GetTotalPrice returns the value of TotalPrice.
-func (s *OrderDto) GetTotalPrice() float64 {
+func (s *OrderDto) GetTotalPrice() Money {
 	return s.TotalPrice
 }
 
+// GetPromoCode returns the value of PromoCode.
+func (s *OrderDto) GetPromoCode() OptString {
+	return s.PromoCode
+}
+
 // GetTransactionUUID returns the value of TransactionUUID.
 func (s *OrderDto) GetTransactionUUID() OptString {
 	return s.TransactionUUID
@@ -478,6 +1154,16 @@ func (s *OrderDto) GetStatus() OrderStatus {
 	return s.Status
 }
 
+// GetVersion returns the value of Version.
+func (s *OrderDto) GetVersion() int64 {
+	return s.Version
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OrderDto) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
 // SetOrderUUID sets the value of OrderUUID.
 func (s *OrderDto) SetOrderUUID(val string) {
 	s.OrderUUID = val
@@ -493,11 +1179,31 @@ func (s *OrderDto) SetPartUuids(val []string) {
 	s.PartUuids = val
 }
 
+// SetItems sets the value of Items.
+func (s *OrderDto) SetItems(val []OrderItem) {
+	s.Items = val
+}
+
+// SetSubtotal sets the value of Subtotal.
+func (s *OrderDto) SetSubtotal(val Money) {
+	s.Subtotal = val
+}
+
+// SetDiscount sets the value of Discount.
+func (s *OrderDto) SetDiscount(val Money) {
+	s.Discount = val
+}
+
 // SetTotalPrice sets the value of TotalPrice.
-func (s *OrderDto) SetTotalPrice(val float64) {
+func (s *OrderDto) SetTotalPrice(val Money) {
 	s.TotalPrice = val
 }
 
+// SetPromoCode sets the value of PromoCode.
+func (s *OrderDto) SetPromoCode(val OptString) {
+	s.PromoCode = val
+}
+
 // SetTransactionUUID sets the value of TransactionUUID.
 func (s *OrderDto) SetTransactionUUID(val OptString) {
 	s.TransactionUUID = val
@@ -513,7 +1219,98 @@ func (s *OrderDto) SetStatus(val OrderStatus) {
 	s.Status = val
 }
 
-func (*OrderDto) getOrderRes() {}
+// SetVersion sets the value of Version.
+func (s *OrderDto) SetVersion(val int64) {
+	s.Version = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OrderDto) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+func (*OrderDto) getOrderRes()    {}
+func (*OrderDto) updateOrderRes() {}
+
+// Ref: #/components/schemas/order_item
+type OrderItem struct {
+	// UUID детали.
+	PartUUID string `json:"part_uuid"`
+	// Название детали на момент оформления заказа.
+	PartName string `json:"part_name"`
+	// Количество деталей.
+	Quantity int64 `json:"quantity"`
+	// Цена за единицу на момент оформления заказа.
+	UnitPrice Money `json:"unit_price"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderItem) GetPartUUID() string {
+	return s.PartUUID
+}
+
+// GetPartName returns the value of PartName.
+func (s *OrderItem) GetPartName() string {
+	return s.PartName
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderItem) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// GetUnitPrice returns the value of UnitPrice.
+func (s *OrderItem) GetUnitPrice() Money {
+	return s.UnitPrice
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderItem) SetPartUUID(val string) {
+	s.PartUUID = val
+}
+
+// SetPartName sets the value of PartName.
+func (s *OrderItem) SetPartName(val string) {
+	s.PartName = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderItem) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// SetUnitPrice sets the value of UnitPrice.
+func (s *OrderItem) SetUnitPrice(val Money) {
+	s.UnitPrice = val
+}
+
+// Ref: #/components/schemas/order_item_request
+type OrderItemRequest struct {
+	// UUID детали.
+	PartUUID string `json:"part_uuid"`
+	// Количество деталей.
+	Quantity int64 `json:"quantity"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderItemRequest) GetPartUUID() string {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderItemRequest) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderItemRequest) SetPartUUID(val string) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderItemRequest) SetQuantity(val int64) {
+	s.Quantity = val
+}
 
 // Статус заказа.
 // Ref: #/components/schemas/order_status
@@ -525,6 +1322,8 @@ const (
 	OrderStatusPAID           OrderStatus = "PAID"
 	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
 	OrderStatusASSEMBLED      OrderStatus = "ASSEMBLED"
+	OrderStatusREFUNDED       OrderStatus = "REFUNDED"
+	OrderStatusREFUNDING      OrderStatus = "REFUNDING"
 )
 
 // AllValues returns all OrderStatus values.
@@ -535,6 +1334,8 @@ func (OrderStatus) AllValues() []OrderStatus {
 		OrderStatusPAID,
 		OrderStatusCANCELLED,
 		OrderStatusASSEMBLED,
+		OrderStatusREFUNDED,
+		OrderStatusREFUNDING,
 	}
 }
 
@@ -551,6 +1352,10 @@ func (s OrderStatus) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case OrderStatusASSEMBLED:
 		return []byte(s), nil
+	case OrderStatusREFUNDED:
+		return []byte(s), nil
+	case OrderStatusREFUNDING:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -574,6 +1379,151 @@ func (s *OrderStatus) UnmarshalText(data []byte) error {
 	case OrderStatusASSEMBLED:
 		*s = OrderStatusASSEMBLED
 		return nil
+	case OrderStatusREFUNDED:
+		*s = OrderStatusREFUNDED
+		return nil
+	case OrderStatusREFUNDING:
+		*s = OrderStatusREFUNDING
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/order_status_change
+type OrderStatusChange struct {
+	FromStatus OrderStatus `json:"from_status"`
+	ToStatus   OrderStatus `json:"to_status"`
+	// UUID пользователя либо имя сервиса, изменившего статус.
+	// У первой записи (создание заказа, from_status NOT_SET) —
+	// владелец заказа.
+	Actor string `json:"actor"`
+	// Причина смены статуса.
+	Reason OptString `json:"reason"`
+	// Дата и время смены статуса.
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// GetFromStatus returns the value of FromStatus.
+func (s *OrderStatusChange) GetFromStatus() OrderStatus {
+	return s.FromStatus
+}
+
+// GetToStatus returns the value of ToStatus.
+func (s *OrderStatusChange) GetToStatus() OrderStatus {
+	return s.ToStatus
+}
+
+// GetActor returns the value of Actor.
+func (s *OrderStatusChange) GetActor() string {
+	return s.Actor
+}
+
+// GetReason returns the value of Reason.
+func (s *OrderStatusChange) GetReason() OptString {
+	return s.Reason
+}
+
+// GetChangedAt returns the value of ChangedAt.
+func (s *OrderStatusChange) GetChangedAt() time.Time {
+	return s.ChangedAt
+}
+
+// SetFromStatus sets the value of FromStatus.
+func (s *OrderStatusChange) SetFromStatus(val OrderStatus) {
+	s.FromStatus = val
+}
+
+// SetToStatus sets the value of ToStatus.
+func (s *OrderStatusChange) SetToStatus(val OrderStatus) {
+	s.ToStatus = val
+}
+
+// SetActor sets the value of Actor.
+func (s *OrderStatusChange) SetActor(val string) {
+	s.Actor = val
+}
+
+// SetReason sets the value of Reason.
+func (s *OrderStatusChange) SetReason(val OptString) {
+	s.Reason = val
+}
+
+// SetChangedAt sets the value of ChangedAt.
+func (s *OrderStatusChange) SetChangedAt(val time.Time) {
+	s.ChangedAt = val
+}
+
+// Ref: #/components/schemas/order_status_history_response
+type OrderStatusHistoryResponse struct {
+	// Смены статуса заказа в хронологическом порядке.
+	History []OrderStatusChange `json:"history"`
+}
+
+// GetHistory returns the value of History.
+func (s *OrderStatusHistoryResponse) GetHistory() []OrderStatusChange {
+	return s.History
+}
+
+// SetHistory sets the value of History.
+func (s *OrderStatusHistoryResponse) SetHistory(val []OrderStatusChange) {
+	s.History = val
+}
+
+func (*OrderStatusHistoryResponse) getOrderStatusHistoryRes() {}
+
+// Категория детали.
+// Ref: #/components/schemas/part_category
+type PartCategory string
+
+const (
+	PartCategoryENGINE   PartCategory = "ENGINE"
+	PartCategoryFUEL     PartCategory = "FUEL"
+	PartCategoryPORTHOLE PartCategory = "PORTHOLE"
+	PartCategoryWING     PartCategory = "WING"
+)
+
+// AllValues returns all PartCategory values.
+func (PartCategory) AllValues() []PartCategory {
+	return []PartCategory{
+		PartCategoryENGINE,
+		PartCategoryFUEL,
+		PartCategoryPORTHOLE,
+		PartCategoryWING,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PartCategory) MarshalText() ([]byte, error) {
+	switch s {
+	case PartCategoryENGINE:
+		return []byte(s), nil
+	case PartCategoryFUEL:
+		return []byte(s), nil
+	case PartCategoryPORTHOLE:
+		return []byte(s), nil
+	case PartCategoryWING:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PartCategory) UnmarshalText(data []byte) error {
+	switch PartCategory(data) {
+	case PartCategoryENGINE:
+		*s = PartCategoryENGINE
+		return nil
+	case PartCategoryFUEL:
+		*s = PartCategoryFUEL
+		return nil
+	case PartCategoryPORTHOLE:
+		*s = PartCategoryPORTHOLE
+		return nil
+	case PartCategoryWING:
+		*s = PartCategoryWING
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
@@ -675,3 +1625,528 @@ func (s *PaymentMethod) UnmarshalText(data []byte) error {
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
+
+// Ref: #/components/schemas/price_quote
+type PriceQuote struct {
+	// Доступные позиции заказа по текущим ценам каталога.
+	Items []QuoteLine `json:"items"`
+	// Сумма позиций до скидки.
+	Subtotal Money `json:"subtotal"`
+	// Скидка по промокоду заказа.
+	Discount Money `json:"discount"`
+	// Итоговая стоимость по текущим ценам.
+	TotalPrice Money `json:"total_price"`
+	// Промокод заказа.
+	PromoCode OptString `json:"promo_code"`
+	// Детали, цена которых изменилась сильнее допустимого.
+	ChangedPartUuids []string `json:"changed_part_uuids"`
+	// Детали, снятые с продажи; в расчёт не вошли.
+	UnavailablePartUuids []string `json:"unavailable_part_uuids"`
+}
+
+// GetItems returns the value of Items.
+func (s *PriceQuote) GetItems() []QuoteLine {
+	return s.Items
+}
+
+// GetSubtotal returns the value of Subtotal.
+func (s *PriceQuote) GetSubtotal() Money {
+	return s.Subtotal
+}
+
+// GetDiscount returns the value of Discount.
+func (s *PriceQuote) GetDiscount() Money {
+	return s.Discount
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *PriceQuote) GetTotalPrice() Money {
+	return s.TotalPrice
+}
+
+// GetPromoCode returns the value of PromoCode.
+func (s *PriceQuote) GetPromoCode() OptString {
+	return s.PromoCode
+}
+
+// GetChangedPartUuids returns the value of ChangedPartUuids.
+func (s *PriceQuote) GetChangedPartUuids() []string {
+	return s.ChangedPartUuids
+}
+
+// GetUnavailablePartUuids returns the value of UnavailablePartUuids.
+func (s *PriceQuote) GetUnavailablePartUuids() []string {
+	return s.UnavailablePartUuids
+}
+
+// SetItems sets the value of Items.
+func (s *PriceQuote) SetItems(val []QuoteLine) {
+	s.Items = val
+}
+
+// SetSubtotal sets the value of Subtotal.
+func (s *PriceQuote) SetSubtotal(val Money) {
+	s.Subtotal = val
+}
+
+// SetDiscount sets the value of Discount.
+func (s *PriceQuote) SetDiscount(val Money) {
+	s.Discount = val
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *PriceQuote) SetTotalPrice(val Money) {
+	s.TotalPrice = val
+}
+
+// SetPromoCode sets the value of PromoCode.
+func (s *PriceQuote) SetPromoCode(val OptString) {
+	s.PromoCode = val
+}
+
+// SetChangedPartUuids sets the value of ChangedPartUuids.
+func (s *PriceQuote) SetChangedPartUuids(val []string) {
+	s.ChangedPartUuids = val
+}
+
+// SetUnavailablePartUuids sets the value of UnavailablePartUuids.
+func (s *PriceQuote) SetUnavailablePartUuids(val []string) {
+	s.UnavailablePartUuids = val
+}
+
+// Ref: #/components/schemas/promo_code
+type PromoCode struct {
+	// Промокод.
+	Code         string       `json:"code"`
+	DiscountType DiscountType `json:"discount_type"`
+	// Процент скидки для PERCENT.
+	PercentOff OptInt64 `json:"percent_off"`
+	// Сумма скидки для FIXED.
+	AmountOff OptMoney `json:"amount_off"`
+	// Категории деталей, на которые действует скидка.
+	// Пустой список — все детали.
+	Categories []PartCategory `json:"categories"`
+	// Сколько заказов можно оформить с кодом. Отсутствует
+	// — без ограничения.
+	MaxUses OptInt64 `json:"max_uses"`
+	// Сколько заказов уже оформлено с кодом.
+	UsedCount int64 `json:"used_count"`
+	// Момент, после которого код перестаёт действовать.
+	ExpiresAt OptDateTime `json:"expires_at"`
+	// Действует ли код.
+	Active bool `json:"active"`
+	// Дата и время создания кода.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetCode returns the value of Code.
+func (s *PromoCode) GetCode() string {
+	return s.Code
+}
+
+// GetDiscountType returns the value of DiscountType.
+func (s *PromoCode) GetDiscountType() DiscountType {
+	return s.DiscountType
+}
+
+// GetPercentOff returns the value of PercentOff.
+func (s *PromoCode) GetPercentOff() OptInt64 {
+	return s.PercentOff
+}
+
+// GetAmountOff returns the value of AmountOff.
+func (s *PromoCode) GetAmountOff() OptMoney {
+	return s.AmountOff
+}
+
+// GetCategories returns the value of Categories.
+func (s *PromoCode) GetCategories() []PartCategory {
+	return s.Categories
+}
+
+// GetMaxUses returns the value of MaxUses.
+func (s *PromoCode) GetMaxUses() OptInt64 {
+	return s.MaxUses
+}
+
+// GetUsedCount returns the value of UsedCount.
+func (s *PromoCode) GetUsedCount() int64 {
+	return s.UsedCount
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *PromoCode) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// GetActive returns the value of Active.
+func (s *PromoCode) GetActive() bool {
+	return s.Active
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PromoCode) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetCode sets the value of Code.
+func (s *PromoCode) SetCode(val string) {
+	s.Code = val
+}
+
+// SetDiscountType sets the value of DiscountType.
+func (s *PromoCode) SetDiscountType(val DiscountType) {
+	s.DiscountType = val
+}
+
+// SetPercentOff sets the value of PercentOff.
+func (s *PromoCode) SetPercentOff(val OptInt64) {
+	s.PercentOff = val
+}
+
+// SetAmountOff sets the value of AmountOff.
+func (s *PromoCode) SetAmountOff(val OptMoney) {
+	s.AmountOff = val
+}
+
+// SetCategories sets the value of Categories.
+func (s *PromoCode) SetCategories(val []PartCategory) {
+	s.Categories = val
+}
+
+// SetMaxUses sets the value of MaxUses.
+func (s *PromoCode) SetMaxUses(val OptInt64) {
+	s.MaxUses = val
+}
+
+// SetUsedCount sets the value of UsedCount.
+func (s *PromoCode) SetUsedCount(val int64) {
+	s.UsedCount = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *PromoCode) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
+// SetActive sets the value of Active.
+func (s *PromoCode) SetActive(val bool) {
+	s.Active = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PromoCode) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*PromoCode) createPromoCodeRes()     {}
+func (*PromoCode) deactivatePromoCodeRes() {}
+func (*PromoCode) getPromoCodeRes()        {}
+
+// Ref: #/components/schemas/quote_line
+type QuoteLine struct {
+	// UUID детали.
+	PartUUID string `json:"part_uuid"`
+	// Название детали.
+	PartName string `json:"part_name"`
+	// Количество деталей.
+	Quantity int64 `json:"quantity"`
+	// Цена за единицу.
+	UnitPrice Money `json:"unit_price"`
+	// Стоимость позиции до скидки.
+	LineTotal Money `json:"line_total"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *QuoteLine) GetPartUUID() string {
+	return s.PartUUID
+}
+
+// GetPartName returns the value of PartName.
+func (s *QuoteLine) GetPartName() string {
+	return s.PartName
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *QuoteLine) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// GetUnitPrice returns the value of UnitPrice.
+func (s *QuoteLine) GetUnitPrice() Money {
+	return s.UnitPrice
+}
+
+// GetLineTotal returns the value of LineTotal.
+func (s *QuoteLine) GetLineTotal() Money {
+	return s.LineTotal
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *QuoteLine) SetPartUUID(val string) {
+	s.PartUUID = val
+}
+
+// SetPartName sets the value of PartName.
+func (s *QuoteLine) SetPartName(val string) {
+	s.PartName = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *QuoteLine) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// SetUnitPrice sets the value of UnitPrice.
+func (s *QuoteLine) SetUnitPrice(val Money) {
+	s.UnitPrice = val
+}
+
+// SetLineTotal sets the value of LineTotal.
+func (s *QuoteLine) SetLineTotal(val Money) {
+	s.LineTotal = val
+}
+
+// Ref: #/components/schemas/quote_request
+type QuoteRequest struct {
+	// Позиции для расчёта. Повторяющиеся детали
+	// объединяются с суммированием количества.
+	Items []OrderItemRequest `json:"items"`
+	// Промокод на скидку. Регистр и пробелы по краям не
+	// учитываются.
+	PromoCode OptString `json:"promo_code"`
+}
+
+// GetItems returns the value of Items.
+func (s *QuoteRequest) GetItems() []OrderItemRequest {
+	return s.Items
+}
+
+// GetPromoCode returns the value of PromoCode.
+func (s *QuoteRequest) GetPromoCode() OptString {
+	return s.PromoCode
+}
+
+// SetItems sets the value of Items.
+func (s *QuoteRequest) SetItems(val []OrderItemRequest) {
+	s.Items = val
+}
+
+// SetPromoCode sets the value of PromoCode.
+func (s *QuoteRequest) SetPromoCode(val OptString) {
+	s.PromoCode = val
+}
+
+// Ref: #/components/schemas/quote_response
+type QuoteResponse struct {
+	// Токен расчёта. Передайте его в quote_token при создании
+	// заказа, чтобы сохранить цены расчёта. По токену
+	// оформляется только один заказ.
+	QuoteToken string `json:"quote_token"`
+	// Позиции расчёта.
+	Items []QuoteLine `json:"items"`
+	// Сумма позиций до скидки.
+	Subtotal Money `json:"subtotal"`
+	// Скидка по промокоду.
+	Discount Money `json:"discount"`
+	// Итоговая стоимость.
+	TotalPrice Money `json:"total_price"`
+	// Применённый промокод.
+	PromoCode OptString `json:"promo_code"`
+	// Время, до которого по расчёту можно оформить заказ.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// GetQuoteToken returns the value of QuoteToken.
+func (s *QuoteResponse) GetQuoteToken() string {
+	return s.QuoteToken
+}
+
+// GetItems returns the value of Items.
+func (s *QuoteResponse) GetItems() []QuoteLine {
+	return s.Items
+}
+
+// GetSubtotal returns the value of Subtotal.
+func (s *QuoteResponse) GetSubtotal() Money {
+	return s.Subtotal
+}
+
+// GetDiscount returns the value of Discount.
+func (s *QuoteResponse) GetDiscount() Money {
+	return s.Discount
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *QuoteResponse) GetTotalPrice() Money {
+	return s.TotalPrice
+}
+
+// GetPromoCode returns the value of PromoCode.
+func (s *QuoteResponse) GetPromoCode() OptString {
+	return s.PromoCode
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *QuoteResponse) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// SetQuoteToken sets the value of QuoteToken.
+func (s *QuoteResponse) SetQuoteToken(val string) {
+	s.QuoteToken = val
+}
+
+// SetItems sets the value of Items.
+func (s *QuoteResponse) SetItems(val []QuoteLine) {
+	s.Items = val
+}
+
+// SetSubtotal sets the value of Subtotal.
+func (s *QuoteResponse) SetSubtotal(val Money) {
+	s.Subtotal = val
+}
+
+// SetDiscount sets the value of Discount.
+func (s *QuoteResponse) SetDiscount(val Money) {
+	s.Discount = val
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *QuoteResponse) SetTotalPrice(val Money) {
+	s.TotalPrice = val
+}
+
+// SetPromoCode sets the value of PromoCode.
+func (s *QuoteResponse) SetPromoCode(val OptString) {
+	s.PromoCode = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *QuoteResponse) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+func (*QuoteResponse) quoteOrderRes() {}
+
+// Ref: #/components/schemas/unauthorized_error
+type UnauthorizedError struct {
+	// HTTP-код ошибки.
+	Code int `json:"code"`
+	// Запрос без аутентификации или с неверными учётными
+	// данными.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *UnauthorizedError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *UnauthorizedError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *UnauthorizedError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *UnauthorizedError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*UnauthorizedError) addCartItemRes()           {}
+func (*UnauthorizedError) cancelOrderRes()           {}
+func (*UnauthorizedError) checkoutCartRes()          {}
+func (*UnauthorizedError) createOrderRes()           {}
+func (*UnauthorizedError) createPromoCodeRes()       {}
+func (*UnauthorizedError) deactivatePromoCodeRes()   {}
+func (*UnauthorizedError) getCartRes()               {}
+func (*UnauthorizedError) getOrderRes()              {}
+func (*UnauthorizedError) getOrderStatusHistoryRes() {}
+func (*UnauthorizedError) getPromoCodeRes()          {}
+func (*UnauthorizedError) listOrdersRes()            {}
+func (*UnauthorizedError) listPromoCodesRes()        {}
+func (*UnauthorizedError) payOrderRes()              {}
+func (*UnauthorizedError) quoteOrderRes()            {}
+func (*UnauthorizedError) removeCartItemRes()        {}
+func (*UnauthorizedError) updateOrderRes()           {}
+
+// Ref: #/components/schemas/update_order_request
+type UpdateOrderRequest struct {
+	// Новый состав заказа. Заменяет текущие позиции
+	// целиком; повторяющиеся детали объединяются.
+	Items []OrderItemRequest `json:"items"`
+	// Версия заказа, которую видел клиент. Если заказ
+	// успели изменить, возвращается 409.
+	Version int64 `json:"version"`
+}
+
+// GetItems returns the value of Items.
+func (s *UpdateOrderRequest) GetItems() []OrderItemRequest {
+	return s.Items
+}
+
+// GetVersion returns the value of Version.
+func (s *UpdateOrderRequest) GetVersion() int64 {
+	return s.Version
+}
+
+// SetItems sets the value of Items.
+func (s *UpdateOrderRequest) SetItems(val []OrderItemRequest) {
+	s.Items = val
+}
+
+// SetVersion sets the value of Version.
+func (s *UpdateOrderRequest) SetVersion(val int64) {
+	s.Version = val
+}
+
+// Ref: #/components/schemas/validation_error
+type ValidationError struct {
+	// HTTP-код ошибки.
+	Code int `json:"code"`
+	// Ошибка бизнес-валидации запроса.
+	Message string `json:"message"`
+	// Нарушенные правила, например недостающие детали для
+	// комплектации корабля.
+	Violations []string `json:"violations"`
+}
+
+// GetCode returns the value of Code.
+func (s *ValidationError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *ValidationError) GetMessage() string {
+	return s.Message
+}
+
+// GetViolations returns the value of Violations.
+func (s *ValidationError) GetViolations() []string {
+	return s.Violations
+}
+
+// SetCode sets the value of Code.
+func (s *ValidationError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ValidationError) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetViolations sets the value of Violations.
+func (s *ValidationError) SetViolations(val []string) {
+	s.Violations = val
+}
+
+func (*ValidationError) checkoutCartRes() {}
+func (*ValidationError) createOrderRes()  {}
+func (*ValidationError) quoteOrderRes()   {}
+func (*ValidationError) updateOrderRes()  {}
@@ -8,12 +8,30 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AddCartItem implements AddCartItem operation.
+	//
+	// Увеличивает количество детали в корзине. Деталь
+	// должна существовать в каталоге; цены и остатки
+	// проверяются при расчёте стоимости и оформлении.
+	//
+	// POST /api/v1/cart/items
+	AddCartItem(ctx context.Context, req *OrderItemRequest, params AddCartItemParams) (AddCartItemRes, error)
 	// CancelOrder implements CancelOrder operation.
 	//
-	// Отменяет заказ.
+	// Отменяет заказ. Оплаченный, но ещё не собранный заказ
+	// отменяется с возвратом оплаты. На время возврата он
+	// переходит в статус REFUNDING, после возврата — в REFUNDED.
 	//
 	// POST /api/v1/orders/{order_uuid}/cancel
 	CancelOrder(ctx context.Context, params CancelOrderParams) (CancelOrderRes, error)
+	// CheckoutCart implements CheckoutCart operation.
+	//
+	// Создаёт заказ из позиций корзины так же, как POST
+	// /api/v1/orders, и очищает корзину. Повтор с тем же Idempotency-Key
+	// возвращает уже созданный заказ.
+	//
+	// POST /api/v1/cart/checkout
+	CheckoutCart(ctx context.Context, req *CheckoutCartRequest, params CheckoutCartParams) (CheckoutCartRes, error)
 	// CreateOrder implements CreateOrder operation.
 	//
 	// Создаёт новый заказ на основе выбранных
@@ -22,24 +40,104 @@ type Handler interface {
 	//
 	// POST /api/v1/orders
 	CreateOrder(ctx context.Context, req *CreateOrderRequest, params CreateOrderParams) (CreateOrderRes, error)
+	// CreatePromoCode implements CreatePromoCode operation.
+	//
+	// Создаёт промокод с процентной или фиксированной
+	// скидкой, ограничением по категориям деталей, числу
+	// использований и сроку действия.
+	//
+	// POST /api/v1/admin/promo-codes
+	CreatePromoCode(ctx context.Context, req *CreatePromoCodeRequest, params CreatePromoCodeParams) (CreatePromoCodeRes, error)
+	// DeactivatePromoCode implements DeactivatePromoCode operation.
+	//
+	// Отключает промокод. Уже оформленные заказы сохраняют
+	// скидку.
+	//
+	// POST /api/v1/admin/promo-codes/{code}/deactivate
+	DeactivatePromoCode(ctx context.Context, params DeactivatePromoCodeParams) (DeactivatePromoCodeRes, error)
+	// GetCart implements GetCart operation.
+	//
+	// Возвращает корзину текущего пользователя. Истёкшая
+	// или ещё не созданная корзина пуста.
+	//
+	// GET /api/v1/cart
+	GetCart(ctx context.Context, params GetCartParams) (GetCartRes, error)
 	// GetOrder implements GetOrder operation.
 	//
 	// Возвращает информацию о заказе.
 	//
 	// GET /api/v1/orders/{order_uuid}
 	GetOrder(ctx context.Context, params GetOrderParams) (GetOrderRes, error)
+	// GetOrderStatusHistory implements GetOrderStatusHistory operation.
+	//
+	// Возвращает все смены статуса заказа с инициатором и
+	// причиной.
+	//
+	// GET /api/v1/orders/{order_uuid}/history
+	GetOrderStatusHistory(ctx context.Context, params GetOrderStatusHistoryParams) (GetOrderStatusHistoryRes, error)
+	// GetPromoCode implements GetPromoCode operation.
+	//
+	// Возвращает промокод вместе с числом использований.
+	//
+	// GET /api/v1/admin/promo-codes/{code}
+	GetPromoCode(ctx context.Context, params GetPromoCodeParams) (GetPromoCodeRes, error)
 	// Health implements Health operation.
 	//
 	// Проверить работоспособность сервиса.
 	//
 	// GET /health
 	Health(ctx context.Context) (HealthRes, error)
+	// ListOrders implements ListOrders operation.
+	//
+	// Возвращает заказы текущего пользователя с
+	// фильтрацией по статусу, способу оплаты и дате
+	// создания. Постраничная навигация выполняется с
+	// помощью непрозрачного курсора.
+	//
+	// GET /api/v1/orders
+	ListOrders(ctx context.Context, params ListOrdersParams) (ListOrdersRes, error)
+	// ListPromoCodes implements ListPromoCodes operation.
+	//
+	// Возвращает все промокоды, включая отключённые и
+	// исчерпанные.
+	//
+	// GET /api/v1/admin/promo-codes
+	ListPromoCodes(ctx context.Context, params ListPromoCodesParams) (ListPromoCodesRes, error)
 	// PayOrder implements PayOrder operation.
 	//
-	// Проводит оплату ранее созданного заказа.
+	// Проводит оплату ранее созданного заказа. Перед
+	// списанием позиции сверяются с каталогом; если цены
+	// изменились сильнее допустимого или детали сняты с
+	// продажи, возвращается 409 с новым расчётом.
 	//
 	// POST /api/v1/orders/{order_uuid}/pay
 	PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (PayOrderRes, error)
+	// QuoteOrder implements QuoteOrder operation.
+	//
+	// Проверяет детали и их наличие на складе, применяет
+	// промокод и возвращает стоимость по позициям, не
+	// создавая заказ. Токен расчёта действует ограниченное
+	// время; заказ, созданный с этим токеном, оформляется по
+	// ценам расчёта.
+	//
+	// POST /api/v1/orders/quote
+	QuoteOrder(ctx context.Context, req *QuoteRequest, params QuoteOrderParams) (QuoteOrderRes, error)
+	// RemoveCartItem implements RemoveCartItem operation.
+	//
+	// Убирает деталь из корзины целиком. Если детали в
+	// корзине нет, корзина не меняется.
+	//
+	// DELETE /api/v1/cart/items/{part_uuid}
+	RemoveCartItem(ctx context.Context, params RemoveCartItemParams) (RemoveCartItemRes, error)
+	// UpdateOrder implements UpdateOrder operation.
+	//
+	// Заменяет позиции заказа, ожидающего оплаты. Цены и
+	// наличие деталей проверяются заново, стоимость и
+	// скидка по промокоду пересчитываются. Параллельные
+	// правки отсекаются по версии заказа.
+	//
+	// PATCH /api/v1/orders/{order_uuid}
+	UpdateOrder(ctx context.Context, req *UpdateOrderRequest, params UpdateOrderParams) (UpdateOrderRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
@@ -13,15 +13,39 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AddCartItem implements AddCartItem operation.
+//
+// Увеличивает количество детали в корзине. Деталь
+// должна существовать в каталоге; цены и остатки
+// проверяются при расчёте стоимости и оформлении.
+//
+// POST /api/v1/cart/items
+func (UnimplementedHandler) AddCartItem(ctx context.Context, req *OrderItemRequest, params AddCartItemParams) (r AddCartItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CancelOrder implements CancelOrder operation.
 //
-// Отменяет заказ.
+// Отменяет заказ. Оплаченный, но ещё не собранный заказ
+// отменяется с возвратом оплаты. На время возврата он
+// переходит в статус REFUNDING, после возврата — в REFUNDED.
 //
 // POST /api/v1/orders/{order_uuid}/cancel
 func (UnimplementedHandler) CancelOrder(ctx context.Context, params CancelOrderParams) (r CancelOrderRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// CheckoutCart implements CheckoutCart operation.
+//
+// Создаёт заказ из позиций корзины так же, как POST
+// /api/v1/orders, и очищает корзину. Повтор с тем же Idempotency-Key
+// возвращает уже созданный заказ.
+//
+// POST /api/v1/cart/checkout
+func (UnimplementedHandler) CheckoutCart(ctx context.Context, req *CheckoutCartRequest, params CheckoutCartParams) (r CheckoutCartRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateOrder implements CreateOrder operation.
 //
 // Создаёт новый заказ на основе выбранных
@@ -33,6 +57,37 @@ func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderReq
 	return r, ht.ErrNotImplemented
 }
 
+// CreatePromoCode implements CreatePromoCode operation.
+//
+// Создаёт промокод с процентной или фиксированной
+// скидкой, ограничением по категориям деталей, числу
+// использований и сроку действия.
+//
+// POST /api/v1/admin/promo-codes
+func (UnimplementedHandler) CreatePromoCode(ctx context.Context, req *CreatePromoCodeRequest, params CreatePromoCodeParams) (r CreatePromoCodeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeactivatePromoCode implements DeactivatePromoCode operation.
+//
+// Отключает промокод. Уже оформленные заказы сохраняют
+// скидку.
+//
+// POST /api/v1/admin/promo-codes/{code}/deactivate
+func (UnimplementedHandler) DeactivatePromoCode(ctx context.Context, params DeactivatePromoCodeParams) (r DeactivatePromoCodeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetCart implements GetCart operation.
+//
+// Возвращает корзину текущего пользователя. Истёкшая
+// или ещё не созданная корзина пуста.
+//
+// GET /api/v1/cart
+func (UnimplementedHandler) GetCart(ctx context.Context, params GetCartParams) (r GetCartRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetOrder implements GetOrder operation.
 //
 // Возвращает информацию о заказе.
@@ -42,6 +97,25 @@ func (UnimplementedHandler) GetOrder(ctx context.Context, params GetOrderParams)
 	return r, ht.ErrNotImplemented
 }
 
+// GetOrderStatusHistory implements GetOrderStatusHistory operation.
+//
+// Возвращает все смены статуса заказа с инициатором и
+// причиной.
+//
+// GET /api/v1/orders/{order_uuid}/history
+func (UnimplementedHandler) GetOrderStatusHistory(ctx context.Context, params GetOrderStatusHistoryParams) (r GetOrderStatusHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPromoCode implements GetPromoCode operation.
+//
+// Возвращает промокод вместе с числом использований.
+//
+// GET /api/v1/admin/promo-codes/{code}
+func (UnimplementedHandler) GetPromoCode(ctx context.Context, params GetPromoCodeParams) (r GetPromoCodeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // Health implements Health operation.
 //
 // Проверить работоспособность сервиса.
@@ -51,11 +125,71 @@ func (UnimplementedHandler) Health(ctx context.Context) (r HealthRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// ListOrders implements ListOrders operation.
+//
+// Возвращает заказы текущего пользователя с
+// фильтрацией по статусу, способу оплаты и дате
+// создания. Постраничная навигация выполняется с
+// помощью непрозрачного курсора.
+//
+// GET /api/v1/orders
+func (UnimplementedHandler) ListOrders(ctx context.Context, params ListOrdersParams) (r ListOrdersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListPromoCodes implements ListPromoCodes operation.
+//
+// Возвращает все промокоды, включая отключённые и
+// исчерпанные.
+//
+// GET /api/v1/admin/promo-codes
+func (UnimplementedHandler) ListPromoCodes(ctx context.Context, params ListPromoCodesParams) (r ListPromoCodesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PayOrder implements PayOrder operation.
 //
-// Проводит оплату ранее созданного заказа.
+// Проводит оплату ранее созданного заказа. Перед
+// списанием позиции сверяются с каталогом; если цены
+// изменились сильнее допустимого или детали сняты с
+// продажи, возвращается 409 с новым расчётом.
 //
 // POST /api/v1/orders/{order_uuid}/pay
 func (UnimplementedHandler) PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (r PayOrderRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
+
+// QuoteOrder implements QuoteOrder operation.
+//
+// Проверяет детали и их наличие на складе, применяет
+// промокод и возвращает стоимость по позициям, не
+// создавая заказ. Токен расчёта действует ограниченное
+// время; заказ, созданный с этим токеном, оформляется по
+// ценам расчёта.
+//
+// POST /api/v1/orders/quote
+func (UnimplementedHandler) QuoteOrder(ctx context.Context, req *QuoteRequest, params QuoteOrderParams) (r QuoteOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RemoveCartItem implements RemoveCartItem operation.
+//
+// Убирает деталь из корзины целиком. Если детали в
+// корзине нет, корзина не меняется.
+//
+// DELETE /api/v1/cart/items/{part_uuid}
+func (UnimplementedHandler) RemoveCartItem(ctx context.Context, params RemoveCartItemParams) (r RemoveCartItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateOrder implements UpdateOrder operation.
+//
+// Заменяет позиции заказа, ожидающего оплаты. Цены и
+// наличие деталей проверяются заново, стоимость и
+// скидка по промокоду пересчитываются. Параллельные
+// правки отсекаются по версии заказа.
+//
+// PATCH /api/v1/orders/{order_uuid}
+func (UnimplementedHandler) UpdateOrder(ctx context.Context, req *UpdateOrderRequest, params UpdateOrderParams) (r UpdateOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
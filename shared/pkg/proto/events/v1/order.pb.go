@@ -7,9 +7,11 @@
 package events_v1
 
 import (
+	v1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/common/v1"
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -78,6 +80,68 @@ func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
 	return file_events_v1_order_proto_rawDescGZIP(), []int{0}
 }
 
+// Статус заказа
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_STATUS_UNSPECIFIED     OrderStatus = 0 // Неизвестный статус
+	OrderStatus_ORDER_STATUS_PENDING_PAYMENT OrderStatus = 1 // Ожидает оплаты
+	OrderStatus_ORDER_STATUS_PAID            OrderStatus = 2 // Оплачен
+	OrderStatus_ORDER_STATUS_CANCELLED       OrderStatus = 3 // Отменён
+	OrderStatus_ORDER_STATUS_ASSEMBLED       OrderStatus = 4 // Собран
+	OrderStatus_ORDER_STATUS_REFUNDED        OrderStatus = 5 // Отменён после оплаты, оплата возвращена
+	OrderStatus_ORDER_STATUS_REFUNDING       OrderStatus = 6 // Отменён после оплаты, оплата возвращается
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0: "ORDER_STATUS_UNSPECIFIED",
+		1: "ORDER_STATUS_PENDING_PAYMENT",
+		2: "ORDER_STATUS_PAID",
+		3: "ORDER_STATUS_CANCELLED",
+		4: "ORDER_STATUS_ASSEMBLED",
+		5: "ORDER_STATUS_REFUNDED",
+		6: "ORDER_STATUS_REFUNDING",
+	}
+	OrderStatus_value = map[string]int32{
+		"ORDER_STATUS_UNSPECIFIED":     0,
+		"ORDER_STATUS_PENDING_PAYMENT": 1,
+		"ORDER_STATUS_PAID":            2,
+		"ORDER_STATUS_CANCELLED":       3,
+		"ORDER_STATUS_ASSEMBLED":       4,
+		"ORDER_STATUS_REFUNDED":        5,
+		"ORDER_STATUS_REFUNDING":       6,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_events_v1_order_proto_enumTypes[1].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_events_v1_order_proto_enumTypes[1]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{1}
+}
+
 // Событие: успешная оплата
 type OrderPaid struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -224,11 +288,516 @@ func (x *ShipAssembledEvent) GetBuildTimeSec() int64 {
 	return 0
 }
 
+// Событие: оплата заказа возвращена, сборку нужно остановить
+type OrderRefunded struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid       string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	OrderUuid       string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid        string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	TransactionUuid string                 `protobuf:"bytes,4,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	RefundUuid      string                 `protobuf:"bytes,5,opt,name=refund_uuid,json=refundUuid,proto3" json:"refund_uuid,omitempty"`
+	Amount          *v1.Money              `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrderRefunded) Reset() {
+	*x = OrderRefunded{}
+	mi := &file_events_v1_order_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderRefunded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderRefunded) ProtoMessage() {}
+
+func (x *OrderRefunded) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderRefunded.ProtoReflect.Descriptor instead.
+func (*OrderRefunded) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OrderRefunded) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderRefunded) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *OrderRefunded) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderRefunded) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *OrderRefunded) GetRefundUuid() string {
+	if x != nil {
+		return x.RefundUuid
+	}
+	return ""
+}
+
+func (x *OrderRefunded) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// Событие: заказ не оплачен вовремя и отменён
+type OrderExpired struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid      string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	ExpiredAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderExpired) Reset() {
+	*x = OrderExpired{}
+	mi := &file_events_v1_order_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderExpired) ProtoMessage() {}
+
+func (x *OrderExpired) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderExpired.ProtoReflect.Descriptor instead.
+func (*OrderExpired) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrderExpired) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderExpired) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *OrderExpired) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderExpired) GetExpiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return nil
+}
+
+// Позиция заказа с ценой на момент создания
+type OrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	PartName      string                 `protobuf:"bytes,2,opt,name=part_name,json=partName,proto3" json:"part_name,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     *v1.Money              `protobuf:"bytes,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_events_v1_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OrderItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *OrderItem) GetPartName() string {
+	if x != nil {
+		return x.PartName
+	}
+	return ""
+}
+
+func (x *OrderItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetUnitPrice() *v1.Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+// Событие: заказ создан и ожидает оплаты
+type OrderCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid      string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	Items         []*OrderItem           `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	TotalPrice    *v1.Money              `protobuf:"bytes,5,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCreated) Reset() {
+	*x = OrderCreated{}
+	mi := &file_events_v1_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCreated) ProtoMessage() {}
+
+func (x *OrderCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCreated.ProtoReflect.Descriptor instead.
+func (*OrderCreated) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OrderCreated) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderCreated) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *OrderCreated) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderCreated) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OrderCreated) GetTotalPrice() *v1.Money {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
+func (x *OrderCreated) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Событие: заказ отменён пользователем или по истечении срока оплаты
+type OrderCancelled struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	OrderUuid string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid  string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// Статус, из которого заказ был отменён
+	PreviousStatus OrderStatus `protobuf:"varint,4,opt,name=previous_status,json=previousStatus,proto3,enum=events.v1.OrderStatus" json:"previous_status,omitempty"`
+	// UUID пользователя либо имя сервиса-инициатора
+	Actor         string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CancelledAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCancelled) Reset() {
+	*x = OrderCancelled{}
+	mi := &file_events_v1_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCancelled) ProtoMessage() {}
+
+func (x *OrderCancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCancelled.ProtoReflect.Descriptor instead.
+func (*OrderCancelled) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OrderCancelled) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderCancelled) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *OrderCancelled) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderCancelled) GetPreviousStatus() OrderStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *OrderCancelled) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *OrderCancelled) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderCancelled) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+// Событие: статус заказа изменён, публикуется на каждый переход
+type OrderStatusChanged struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid  string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	OrderUuid  string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid   string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	FromStatus OrderStatus            `protobuf:"varint,4,opt,name=from_status,json=fromStatus,proto3,enum=events.v1.OrderStatus" json:"from_status,omitempty"`
+	ToStatus   OrderStatus            `protobuf:"varint,5,opt,name=to_status,json=toStatus,proto3,enum=events.v1.OrderStatus" json:"to_status,omitempty"`
+	// UUID пользователя либо имя сервиса-инициатора
+	Actor         string                 `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusChanged) Reset() {
+	*x = OrderStatusChanged{}
+	mi := &file_events_v1_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusChanged) ProtoMessage() {}
+
+func (x *OrderStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusChanged.ProtoReflect.Descriptor instead.
+func (*OrderStatusChanged) Descriptor() ([]byte, []int) {
+	return file_events_v1_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OrderStatusChanged) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderStatusChanged) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *OrderStatusChanged) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderStatusChanged) GetFromStatus() OrderStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *OrderStatusChanged) GetToStatus() OrderStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *OrderStatusChanged) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *OrderStatusChanged) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderStatusChanged) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
 var File_events_v1_order_proto protoreflect.FileDescriptor
 
 const file_events_v1_order_proto_rawDesc = "" +
 	"\n" +
-	"\x15events/v1/order.proto\x12\tevents.v1\x1a\x17validate/validate.proto\"\x84\x02\n" +
+	"\x15events/v1/order.proto\x12\tevents.v1\x1a\x17validate/validate.proto\x1a\x15common/v1/money.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x84\x02\n" +
 	"\tOrderPaid\x12'\n" +
 	"\n" +
 	"event_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\teventUuid\x12'\n" +
@@ -243,13 +812,79 @@ const file_events_v1_order_proto_rawDesc = "" +
 	"\n" +
 	"order_uuid\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\torderUuid\x12%\n" +
 	"\tuser_uuid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\buserUuid\x12-\n" +
-	"\x0ebuild_time_sec\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\fbuildTimeSec*\xa3\x01\n" +
+	"\x0ebuild_time_sec\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\fbuildTimeSec\"\x9c\x02\n" +
+	"\rOrderRefunded\x12'\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\teventUuid\x12'\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\torderUuid\x12%\n" +
+	"\tuser_uuid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\buserUuid\x123\n" +
+	"\x10transaction_uuid\x18\x04 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x0ftransactionUuid\x12)\n" +
+	"\vrefund_uuid\x18\x05 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"refundUuid\x122\n" +
+	"\x06amount\x18\x06 \x01(\v2\x10.common.v1.MoneyB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06amount\"\xcc\x01\n" +
+	"\fOrderExpired\x12'\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\teventUuid\x12'\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\torderUuid\x12%\n" +
+	"\tuser_uuid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\buserUuid\x12C\n" +
+	"\n" +
+	"expired_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\texpiredAt\"\xaf\x01\n" +
+	"\tOrderItem\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\bpartUuid\x12\x1b\n" +
+	"\tpart_name\x18\x02 \x01(\tR\bpartName\x12#\n" +
+	"\bquantity\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bquantity\x129\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\v2\x10.common.v1.MoneyB\b\xfaB\x05\x8a\x01\x02\x10\x01R\tunitPrice\"\xbf\x02\n" +
+	"\fOrderCreated\x12'\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\teventUuid\x12'\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\torderUuid\x12%\n" +
+	"\tuser_uuid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\buserUuid\x124\n" +
+	"\x05items\x18\x04 \x03(\v2\x14.events.v1.OrderItemB\b\xfaB\x05\x92\x01\x02\b\x01R\x05items\x12;\n" +
+	"\vtotal_price\x18\x05 \x01(\v2\x10.common.v1.MoneyB\b\xfaB\x05\x8a\x01\x02\x10\x01R\n" +
+	"totalPrice\x12C\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\tcreatedAt\"\xcb\x02\n" +
+	"\x0eOrderCancelled\x12'\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\teventUuid\x12'\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\torderUuid\x12%\n" +
+	"\tuser_uuid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\buserUuid\x12I\n" +
+	"\x0fprevious_status\x18\x04 \x01(\x0e2\x16.events.v1.OrderStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x0epreviousStatus\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12G\n" +
+	"\fcancelled_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\vcancelledAt\"\x82\x03\n" +
+	"\x12OrderStatusChanged\x12'\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\teventUuid\x12'\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\torderUuid\x12%\n" +
+	"\tuser_uuid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\buserUuid\x12A\n" +
+	"\vfrom_status\x18\x04 \x01(\x0e2\x16.events.v1.OrderStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\n" +
+	"fromStatus\x12=\n" +
+	"\tto_status\x18\x05 \x01(\x0e2\x16.events.v1.OrderStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\btoStatus\x12\x14\n" +
+	"\x05actor\x18\x06 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12C\n" +
+	"\n" +
+	"changed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\tchangedAt*\xa3\x01\n" +
 	"\rPaymentMethod\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13PAYMENT_METHOD_CARD\x10\x01\x12\x16\n" +
 	"\x12PAYMENT_METHOD_SBP\x10\x02\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_CREDIT_CARD\x10\x03\x12!\n" +
-	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x04BAZ?github.com/ZanDattSu/star-factory/shared/pkg/proto/v1;events_v1b\x06proto3"
+	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x04*\xd3\x01\n" +
+	"\vOrderStatus\x12\x1c\n" +
+	"\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cORDER_STATUS_PENDING_PAYMENT\x10\x01\x12\x15\n" +
+	"\x11ORDER_STATUS_PAID\x10\x02\x12\x1a\n" +
+	"\x16ORDER_STATUS_CANCELLED\x10\x03\x12\x1a\n" +
+	"\x16ORDER_STATUS_ASSEMBLED\x10\x04\x12\x19\n" +
+	"\x15ORDER_STATUS_REFUNDED\x10\x05\x12\x1a\n" +
+	"\x16ORDER_STATUS_REFUNDING\x10\x06BAZ?github.com/ZanDattSu/star-factory/shared/pkg/proto/v1;events_v1b\x06proto3"
 
 var (
 	file_events_v1_order_proto_rawDescOnce sync.Once
@@ -263,20 +898,40 @@ func file_events_v1_order_proto_rawDescGZIP() []byte {
 	return file_events_v1_order_proto_rawDescData
 }
 
-var file_events_v1_order_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_events_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_v1_order_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_events_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_events_v1_order_proto_goTypes = []any{
-	(PaymentMethod)(0),         // 0: events.v1.PaymentMethod
-	(*OrderPaid)(nil),          // 1: events.v1.OrderPaid
-	(*ShipAssembledEvent)(nil), // 2: events.v1.ShipAssembledEvent
+	(PaymentMethod)(0),            // 0: events.v1.PaymentMethod
+	(OrderStatus)(0),              // 1: events.v1.OrderStatus
+	(*OrderPaid)(nil),             // 2: events.v1.OrderPaid
+	(*ShipAssembledEvent)(nil),    // 3: events.v1.ShipAssembledEvent
+	(*OrderRefunded)(nil),         // 4: events.v1.OrderRefunded
+	(*OrderExpired)(nil),          // 5: events.v1.OrderExpired
+	(*OrderItem)(nil),             // 6: events.v1.OrderItem
+	(*OrderCreated)(nil),          // 7: events.v1.OrderCreated
+	(*OrderCancelled)(nil),        // 8: events.v1.OrderCancelled
+	(*OrderStatusChanged)(nil),    // 9: events.v1.OrderStatusChanged
+	(*v1.Money)(nil),              // 10: common.v1.Money
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
 }
 var file_events_v1_order_proto_depIdxs = []int32{
-	0, // 0: events.v1.OrderPaid.payment_method:type_name -> events.v1.PaymentMethod
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: events.v1.OrderPaid.payment_method:type_name -> events.v1.PaymentMethod
+	10, // 1: events.v1.OrderRefunded.amount:type_name -> common.v1.Money
+	11, // 2: events.v1.OrderExpired.expired_at:type_name -> google.protobuf.Timestamp
+	10, // 3: events.v1.OrderItem.unit_price:type_name -> common.v1.Money
+	6,  // 4: events.v1.OrderCreated.items:type_name -> events.v1.OrderItem
+	10, // 5: events.v1.OrderCreated.total_price:type_name -> common.v1.Money
+	11, // 6: events.v1.OrderCreated.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 7: events.v1.OrderCancelled.previous_status:type_name -> events.v1.OrderStatus
+	11, // 8: events.v1.OrderCancelled.cancelled_at:type_name -> google.protobuf.Timestamp
+	1,  // 9: events.v1.OrderStatusChanged.from_status:type_name -> events.v1.OrderStatus
+	1,  // 10: events.v1.OrderStatusChanged.to_status:type_name -> events.v1.OrderStatus
+	11, // 11: events.v1.OrderStatusChanged.changed_at:type_name -> google.protobuf.Timestamp
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_events_v1_order_proto_init() }
@@ -289,8 +944,8 @@ func file_events_v1_order_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_order_proto_rawDesc), len(file_events_v1_order_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
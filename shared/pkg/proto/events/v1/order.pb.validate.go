@@ -360,3 +360,1129 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ShipAssembledEventValidationError{}
+
+// Validate checks the field values on OrderRefunded with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderRefunded) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderRefunded with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderRefundedMultiError, or
+// nil if none found.
+func (m *OrderRefunded) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderRefunded) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEventUuid()); err != nil {
+		err = OrderRefundedValidationError{
+			field:  "EventUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetOrderUuid()); err != nil {
+		err = OrderRefundedValidationError{
+			field:  "OrderUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserUuid()); err != nil {
+		err = OrderRefundedValidationError{
+			field:  "UserUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetTransactionUuid()); err != nil {
+		err = OrderRefundedValidationError{
+			field:  "TransactionUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetRefundUuid()); err != nil {
+		err = OrderRefundedValidationError{
+			field:  "RefundUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() == nil {
+		err := OrderRefundedValidationError{
+			field:  "Amount",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderRefundedValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderRefundedValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderRefundedValidationError{
+				field:  "Amount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderRefundedMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *OrderRefunded) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// OrderRefundedMultiError is an error wrapping multiple validation errors
+// returned by OrderRefunded.ValidateAll() if the designated constraints
+// aren't met.
+type OrderRefundedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderRefundedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderRefundedMultiError) AllErrors() []error { return m }
+
+// OrderRefundedValidationError is the validation error returned by
+// OrderRefunded.Validate if the designated constraints aren't met.
+type OrderRefundedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderRefundedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderRefundedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderRefundedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderRefundedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderRefundedValidationError) ErrorName() string { return "OrderRefundedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderRefundedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderRefunded.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderRefundedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderRefundedValidationError{}
+
+// Validate checks the field values on OrderExpired with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderExpired) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderExpired with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderExpiredMultiError, or
+// nil if none found.
+func (m *OrderExpired) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderExpired) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEventUuid()); err != nil {
+		err = OrderExpiredValidationError{
+			field:  "EventUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetOrderUuid()); err != nil {
+		err = OrderExpiredValidationError{
+			field:  "OrderUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserUuid()); err != nil {
+		err = OrderExpiredValidationError{
+			field:  "UserUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpiredAt() == nil {
+		err := OrderExpiredValidationError{
+			field:  "ExpiredAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OrderExpiredMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *OrderExpired) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// OrderExpiredMultiError is an error wrapping multiple validation errors
+// returned by OrderExpired.ValidateAll() if the designated constraints aren't met.
+type OrderExpiredMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderExpiredMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderExpiredMultiError) AllErrors() []error { return m }
+
+// OrderExpiredValidationError is the validation error returned by
+// OrderExpired.Validate if the designated constraints aren't met.
+type OrderExpiredValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderExpiredValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderExpiredValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderExpiredValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderExpiredValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderExpiredValidationError) ErrorName() string { return "OrderExpiredValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderExpiredValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderExpired.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderExpiredValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderExpiredValidationError{}
+
+// Validate checks the field values on OrderItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderItemMultiError, or nil
+// if none found.
+func (m *OrderItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPartUuid()); err != nil {
+		err = OrderItemValidationError{
+			field:  "PartUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PartName
+
+	if m.GetQuantity() <= 0 {
+		err := OrderItemValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUnitPrice() == nil {
+		err := OrderItemValidationError{
+			field:  "UnitPrice",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUnitPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderItemValidationError{
+					field:  "UnitPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderItemValidationError{
+					field:  "UnitPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUnitPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderItemValidationError{
+				field:  "UnitPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderItemMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *OrderItem) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// OrderItemMultiError is an error wrapping multiple validation errors returned
+// by OrderItem.ValidateAll() if the designated constraints aren't met.
+type OrderItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderItemMultiError) AllErrors() []error { return m }
+
+// OrderItemValidationError is the validation error returned by
+// OrderItem.Validate if the designated constraints aren't met.
+type OrderItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderItemValidationError) ErrorName() string { return "OrderItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderItemValidationError{}
+
+// Validate checks the field values on OrderCreated with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderCreated) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderCreated with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderCreatedMultiError, or
+// nil if none found.
+func (m *OrderCreated) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCreated) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEventUuid()); err != nil {
+		err = OrderCreatedValidationError{
+			field:  "EventUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetOrderUuid()); err != nil {
+		err = OrderCreatedValidationError{
+			field:  "OrderUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserUuid()); err != nil {
+		err = OrderCreatedValidationError{
+			field:  "UserUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetItems()) < 1 {
+		err := OrderCreatedValidationError{
+			field:  "Items",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderCreatedValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderCreatedValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderCreatedValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetTotalPrice() == nil {
+		err := OrderCreatedValidationError{
+			field:  "TotalPrice",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotalPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderCreatedValidationError{
+					field:  "TotalPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderCreatedValidationError{
+					field:  "TotalPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotalPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderCreatedValidationError{
+				field:  "TotalPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetCreatedAt() == nil {
+		err := OrderCreatedValidationError{
+			field:  "CreatedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OrderCreatedMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *OrderCreated) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// OrderCreatedMultiError is an error wrapping multiple validation errors
+// returned by OrderCreated.ValidateAll() if the designated constraints aren't met.
+type OrderCreatedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCreatedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCreatedMultiError) AllErrors() []error { return m }
+
+// OrderCreatedValidationError is the validation error returned by
+// OrderCreated.Validate if the designated constraints aren't met.
+type OrderCreatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCreatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCreatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCreatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCreatedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCreatedValidationError) ErrorName() string { return "OrderCreatedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderCreatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCreated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCreatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCreatedValidationError{}
+
+// Validate checks the field values on OrderCancelled with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderCancelled) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderCancelled with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderCancelledMultiError,
+// or nil if none found.
+func (m *OrderCancelled) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCancelled) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEventUuid()); err != nil {
+		err = OrderCancelledValidationError{
+			field:  "EventUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetOrderUuid()); err != nil {
+		err = OrderCancelledValidationError{
+			field:  "OrderUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserUuid()); err != nil {
+		err = OrderCancelledValidationError{
+			field:  "UserUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := OrderStatus_name[int32(m.GetPreviousStatus())]; !ok {
+		err := OrderCancelledValidationError{
+			field:  "PreviousStatus",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Actor
+
+	// no validation rules for Reason
+
+	if m.GetCancelledAt() == nil {
+		err := OrderCancelledValidationError{
+			field:  "CancelledAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OrderCancelledMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *OrderCancelled) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// OrderCancelledMultiError is an error wrapping multiple validation errors
+// returned by OrderCancelled.ValidateAll() if the designated constraints
+// aren't met.
+type OrderCancelledMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCancelledMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCancelledMultiError) AllErrors() []error { return m }
+
+// OrderCancelledValidationError is the validation error returned by
+// OrderCancelled.Validate if the designated constraints aren't met.
+type OrderCancelledValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCancelledValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCancelledValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCancelledValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCancelledValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCancelledValidationError) ErrorName() string { return "OrderCancelledValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderCancelledValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCancelled.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCancelledValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCancelledValidationError{}
+
+// Validate checks the field values on OrderStatusChanged with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderStatusChanged) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderStatusChanged with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderStatusChangedMultiError, or nil if none found.
+func (m *OrderStatusChanged) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderStatusChanged) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetEventUuid()); err != nil {
+		err = OrderStatusChangedValidationError{
+			field:  "EventUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetOrderUuid()); err != nil {
+		err = OrderStatusChangedValidationError{
+			field:  "OrderUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserUuid()); err != nil {
+		err = OrderStatusChangedValidationError{
+			field:  "UserUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := OrderStatus_name[int32(m.GetFromStatus())]; !ok {
+		err := OrderStatusChangedValidationError{
+			field:  "FromStatus",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := OrderStatus_name[int32(m.GetToStatus())]; !ok {
+		err := OrderStatusChangedValidationError{
+			field:  "ToStatus",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Actor
+
+	// no validation rules for Reason
+
+	if m.GetChangedAt() == nil {
+		err := OrderStatusChangedValidationError{
+			field:  "ChangedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OrderStatusChangedMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *OrderStatusChanged) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// OrderStatusChangedMultiError is an error wrapping multiple validation errors
+// returned by OrderStatusChanged.ValidateAll() if the designated constraints
+// aren't met.
+type OrderStatusChangedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderStatusChangedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderStatusChangedMultiError) AllErrors() []error { return m }
+
+// OrderStatusChangedValidationError is the validation error returned by
+// OrderStatusChanged.Validate if the designated constraints aren't met.
+type OrderStatusChangedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderStatusChangedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderStatusChangedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderStatusChangedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderStatusChangedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderStatusChangedValidationError) ErrorName() string {
+	return "OrderStatusChangedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderStatusChangedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderStatusChanged.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderStatusChangedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderStatusChangedValidationError{}
@@ -7,11 +7,14 @@
 package inventory_v1
 
 import (
+	v1 "github.com/ZanDattSu/star-factory/shared/pkg/proto/common/v1"
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -81,6 +84,121 @@ func (Category) EnumDescriptor() ([]byte, []int) {
 	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
 }
 
+// Поле сортировки списка деталей
+type PartsOrderField int32
+
+const (
+	PartsOrderField_PARTS_ORDER_FIELD_UNSPECIFIED    PartsOrderField = 0 // по UUID
+	PartsOrderField_PARTS_ORDER_FIELD_PRICE          PartsOrderField = 1
+	PartsOrderField_PARTS_ORDER_FIELD_NAME           PartsOrderField = 2
+	PartsOrderField_PARTS_ORDER_FIELD_CREATED_AT     PartsOrderField = 3
+	PartsOrderField_PARTS_ORDER_FIELD_STOCK_QUANTITY PartsOrderField = 4
+)
+
+// Enum value maps for PartsOrderField.
+var (
+	PartsOrderField_name = map[int32]string{
+		0: "PARTS_ORDER_FIELD_UNSPECIFIED",
+		1: "PARTS_ORDER_FIELD_PRICE",
+		2: "PARTS_ORDER_FIELD_NAME",
+		3: "PARTS_ORDER_FIELD_CREATED_AT",
+		4: "PARTS_ORDER_FIELD_STOCK_QUANTITY",
+	}
+	PartsOrderField_value = map[string]int32{
+		"PARTS_ORDER_FIELD_UNSPECIFIED":    0,
+		"PARTS_ORDER_FIELD_PRICE":          1,
+		"PARTS_ORDER_FIELD_NAME":           2,
+		"PARTS_ORDER_FIELD_CREATED_AT":     3,
+		"PARTS_ORDER_FIELD_STOCK_QUANTITY": 4,
+	}
+)
+
+func (x PartsOrderField) Enum() *PartsOrderField {
+	p := new(PartsOrderField)
+	*p = x
+	return p
+}
+
+func (x PartsOrderField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartsOrderField) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (PartsOrderField) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[1]
+}
+
+func (x PartsOrderField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartsOrderField.Descriptor instead.
+func (PartsOrderField) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+// Причина изменения остатка
+type StockMovementType int32
+
+const (
+	StockMovementType_STOCK_MOVEMENT_TYPE_UNSPECIFIED StockMovementType = 0
+	StockMovementType_STOCK_MOVEMENT_TYPE_RECEIPT     StockMovementType = 1 // поступление на склад
+	StockMovementType_STOCK_MOVEMENT_TYPE_RESERVATION StockMovementType = 2 // списание под резерв или возврат снятого резерва
+	StockMovementType_STOCK_MOVEMENT_TYPE_SALE        StockMovementType = 3 // продажа зарезервированных деталей; остаток уже списан резервом
+	StockMovementType_STOCK_MOVEMENT_TYPE_RETURN      StockMovementType = 4 // возврат от покупателя
+	StockMovementType_STOCK_MOVEMENT_TYPE_CORRECTION  StockMovementType = 5 // ручная корректировка
+)
+
+// Enum value maps for StockMovementType.
+var (
+	StockMovementType_name = map[int32]string{
+		0: "STOCK_MOVEMENT_TYPE_UNSPECIFIED",
+		1: "STOCK_MOVEMENT_TYPE_RECEIPT",
+		2: "STOCK_MOVEMENT_TYPE_RESERVATION",
+		3: "STOCK_MOVEMENT_TYPE_SALE",
+		4: "STOCK_MOVEMENT_TYPE_RETURN",
+		5: "STOCK_MOVEMENT_TYPE_CORRECTION",
+	}
+	StockMovementType_value = map[string]int32{
+		"STOCK_MOVEMENT_TYPE_UNSPECIFIED": 0,
+		"STOCK_MOVEMENT_TYPE_RECEIPT":     1,
+		"STOCK_MOVEMENT_TYPE_RESERVATION": 2,
+		"STOCK_MOVEMENT_TYPE_SALE":        3,
+		"STOCK_MOVEMENT_TYPE_RETURN":      4,
+		"STOCK_MOVEMENT_TYPE_CORRECTION":  5,
+	}
+)
+
+func (x StockMovementType) Enum() *StockMovementType {
+	p := new(StockMovementType)
+	*p = x
+	return p
+}
+
+func (x StockMovementType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StockMovementType) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[2].Descriptor()
+}
+
+func (StockMovementType) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[2]
+}
+
+func (x StockMovementType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StockMovementType.Descriptor instead.
+func (StockMovementType) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
 // Универсальное значение для метаданных
 type Value struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -332,7 +450,6 @@ type Part struct {
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                                                    // ID детали
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                                                    // имя
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                                                      // описание
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`                                                                                // цена
 	StockQuantity int64                  `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`                                            // остаток на складе
 	Category      Category               `protobuf:"varint,6,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`                                                // категория
 	Dimensions    *Dimensions            `protobuf:"bytes,7,opt,name=dimensions,proto3" json:"dimensions,omitempty"`                                                                        // размеры
@@ -341,6 +458,8 @@ type Part struct {
 	Metadata      map[string]*Value      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // доп. данные
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                        // дата создания
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                                        // дата обновления
+	Price         *v1.Money              `protobuf:"bytes,13,opt,name=price,proto3" json:"price,omitempty"`                                                                                 // цена
+	Discontinued  bool                   `protobuf:"varint,14,opt,name=discontinued,proto3" json:"discontinued,omitempty"`                                                                  // снята с продажи
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -396,13 +515,6 @@ func (x *Part) GetDescription() string {
 	return ""
 }
 
-func (x *Part) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
 func (x *Part) GetStockQuantity() int64 {
 	if x != nil {
 		return x.StockQuantity
@@ -459,6 +571,20 @@ func (x *Part) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Part) GetPrice() *v1.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Part) GetDiscontinued() bool {
+	if x != nil {
+		return x.Discontinued
+	}
+	return false
+}
+
 // Запрос детали
 type GetPartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -557,6 +683,7 @@ type PartsFilter struct {
 	Categories            []Category             `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=inventory.v1.Category" json:"categories,omitempty"`                 // фильтр по категории
 	ManufacturerCountries []string               `protobuf:"bytes,4,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"` // фильтр по стране производителя
 	Tags                  []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                                                                // фильтр по тегам
+	IncludeDiscontinued   bool                   `protobuf:"varint,6,opt,name=include_discontinued,json=includeDiscontinued,proto3" json:"include_discontinued,omitempty"`      // вернуть и снятые с продажи детали
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -626,17 +753,80 @@ func (x *PartsFilter) GetTags() []string {
 	return nil
 }
 
+func (x *PartsFilter) GetIncludeDiscontinued() bool {
+	if x != nil {
+		return x.IncludeDiscontinued
+	}
+	return false
+}
+
+// Порядок сортировки списка деталей. При равных значениях детали упорядочиваются по UUID
+type PartsOrderBy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         PartsOrderField        `protobuf:"varint,1,opt,name=field,proto3,enum=inventory.v1.PartsOrderField" json:"field,omitempty"` // поле сортировки
+	Desc          bool                   `protobuf:"varint,2,opt,name=desc,proto3" json:"desc,omitempty"`                                     // по убыванию
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartsOrderBy) Reset() {
+	*x = PartsOrderBy{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartsOrderBy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartsOrderBy) ProtoMessage() {}
+
+func (x *PartsOrderBy) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartsOrderBy.ProtoReflect.Descriptor instead.
+func (*PartsOrderBy) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PartsOrderBy) GetField() PartsOrderField {
+	if x != nil {
+		return x.Field
+	}
+	return PartsOrderField_PARTS_ORDER_FIELD_UNSPECIFIED
+}
+
+func (x *PartsOrderBy) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
 // Запрос списка деталей
 type ListPartsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filter        *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"` // параметры фильтрации (все поля опциональны)
+	Filter        *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`                        // параметры фильтрации (все поля опциональны)
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // размер страницы; 0 — все найденные детали
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token предыдущей страницы
+	OrderBy       *PartsOrderBy          `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // сортировка; должна совпадать на всех страницах
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListPartsRequest) Reset() {
 	*x = ListPartsRequest{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -648,7 +838,7 @@ func (x *ListPartsRequest) String() string {
 func (*ListPartsRequest) ProtoMessage() {}
 
 func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -661,7 +851,7 @@ func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
 func (*ListPartsRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListPartsRequest) GetFilter() *PartsFilter {
@@ -671,17 +861,40 @@ func (x *ListPartsRequest) GetFilter() *PartsFilter {
 	return nil
 }
 
+func (x *ListPartsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPartsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetOrderBy() *PartsOrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
 // Ответ со списком деталей
 type ListPartsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Parts         []*Part                `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"` // найденные детали
+	Parts         []*Part                `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`                                        // найденные детали
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // токен следующей страницы; пусто, если страниц больше нет
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // число деталей под фильтром на всех страницах
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListPartsResponse) Reset() {
 	*x = ListPartsResponse{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -693,7 +906,7 @@ func (x *ListPartsResponse) String() string {
 func (*ListPartsResponse) ProtoMessage() {}
 
 func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +919,7 @@ func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
 func (*ListPartsResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListPartsResponse) GetParts() []*Part {
@@ -716,76 +929,1781 @@ func (x *ListPartsResponse) GetParts() []*Part {
 	return nil
 }
 
-var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
+func (x *ListPartsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
 
-const file_inventory_v1_inventory_proto_rawDesc = "" +
-	"\n" +
-	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x9d\x01\n" +
-	"\x05Value\x12#\n" +
-	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12!\n" +
-	"\vint64_value\x18\x02 \x01(\x03H\x00R\n" +
-	"int64Value\x12#\n" +
-	"\fdouble_value\x18\x03 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
-	"\n" +
-	"bool_value\x18\x04 \x01(\bH\x00R\tboolValueB\x06\n" +
-	"\x04kind\"\xaa\x01\n" +
-	"\n" +
-	"Dimensions\x12&\n" +
-	"\x06length\x18\x01 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x06length\x12$\n" +
-	"\x05width\x18\x02 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x05width\x12&\n" +
-	"\x06height\x18\x03 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x06height\x12&\n" +
-	"\x06weight\x18\x04 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x06weight\"c\n" +
-	"\fManufacturer\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\acountry\x18\x02 \x01(\tR\acountry\x12%\n" +
-	"\awebsite\x18\x03 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\x88\x01\x01R\awebsite\"\xb6\x05\n" +
-	"\x04Part\x12\x1c\n" +
-	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12$\n" +
-	"\x05price\x18\x04 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x05price\x12.\n" +
-	"\x0estock_quantity\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\rstockQuantity\x12>\n" +
-	"\bcategory\x18\x06 \x01(\x0e2\x16.inventory.v1.CategoryB\n" +
-	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\bcategory\x12B\n" +
-	"\n" +
-	"dimensions\x18\a \x01(\v2\x18.inventory.v1.DimensionsB\b\xfaB\x05\x8a\x01\x02\x10\x01R\n" +
-	"dimensions\x12H\n" +
-	"\fmanufacturer\x18\b \x01(\v2\x1a.inventory.v1.ManufacturerB\b\xfaB\x05\x8a\x01\x02\x10\x01R\fmanufacturer\x12\x1c\n" +
-	"\x04tags\x18\t \x03(\tB\b\xfaB\x05\x92\x01\x02\x18\x01R\x04tags\x12<\n" +
-	"\bmetadata\x18\n" +
-	" \x03(\v2 .inventory.v1.Part.MetadataEntryR\bmetadata\x12C\n" +
-	"\n" +
-	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\tcreatedAt\x12C\n" +
-	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\tupdatedAt\x1aP\n" +
-	"\rMetadataEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\".\n" +
-	"\x0eGetPartRequest\x12\x1c\n" +
-	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\"C\n" +
-	"\x0fGetPartResponse\x120\n" +
-	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04part\"\xbc\x01\n" +
-	"\vPartsFilter\x12\x14\n" +
-	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12\x14\n" +
-	"\x05names\x18\x02 \x03(\tR\x05names\x126\n" +
-	"\n" +
-	"categories\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryR\n" +
-	"categories\x125\n" +
-	"\x16manufacturer_countries\x18\x04 \x03(\tR\x15manufacturerCountries\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags\"E\n" +
-	"\x10ListPartsRequest\x121\n" +
-	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"=\n" +
-	"\x11ListPartsResponse\x12(\n" +
-	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts*v\n" +
-	"\bCategory\x12\x18\n" +
-	"\x14CATEGORY_UNSPECIFIED\x10\x00\x12\x13\n" +
-	"\x0fCATEGORY_ENGINE\x10\x01\x12\x11\n" +
-	"\rCATEGORY_FUEL\x10\x02\x12\x15\n" +
-	"\x11CATEGORY_PORTHOLE\x10\x03\x12\x11\n" +
-	"\rCATEGORY_WING\x10\x042\xe3\x01\n" +
-	"\x10InventoryService\x12c\n" +
-	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/part/{uuid}\x12j\n" +
-	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/part/listB\xbc\x01\x92Au\x12K\n" +
+func (x *ListPartsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Запрос полнотекстового поиска деталей по названию, описанию, тегам и производителю
+type SearchPartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`   // ключевые слова; деталь подходит, если найдено хотя бы одно
+	Filter        *PartsFilter           `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"` // дополнительный фильтр (все поля опциональны)
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`  // максимум результатов; 0 — 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPartsRequest) Reset() {
+	*x = SearchPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPartsRequest) ProtoMessage() {}
+
+func (x *SearchPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPartsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchPartsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchPartsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Фрагмент поля детали с подсвеченными совпадениями
+type SearchHighlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`     // поле: name, description, tags или manufacturer.name
+	Snippet       string                 `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"` // текст поля, экранированный как HTML; совпавшие слова обёрнуты в <em></em>
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHighlight) Reset() {
+	*x = SearchHighlight{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHighlight) ProtoMessage() {}
+
+func (x *SearchHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHighlight.ProtoReflect.Descriptor instead.
+func (*SearchHighlight) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchHighlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+// Найденная деталь
+type PartSearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`             // деталь
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`         // релевантность; чем больше, тем выше в выдаче
+	Highlights    []*SearchHighlight     `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"` // подсвеченные совпадения
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartSearchHit) Reset() {
+	*x = PartSearchHit{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartSearchHit) ProtoMessage() {}
+
+func (x *PartSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartSearchHit.ProtoReflect.Descriptor instead.
+func (*PartSearchHit) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PartSearchHit) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *PartSearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PartSearchHit) GetHighlights() []*SearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+// Ответ полнотекстового поиска, отсортированный по убыванию релевантности
+type SearchPartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*PartSearchHit       `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPartsResponse) Reset() {
+	*x = SearchPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPartsResponse) ProtoMessage() {}
+
+func (x *SearchPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPartsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchPartsResponse) GetHits() []*PartSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// Данные детали, которые задаёт мерчендайзер
+type PartInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                   // имя
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                                                     // описание
+	Price         *v1.Money              `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`                                                                                 // цена
+	StockQuantity int64                  `protobuf:"varint,4,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`                                           // начальный остаток на складе
+	Category      Category               `protobuf:"varint,5,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`                                               // категория
+	Dimensions    *Dimensions            `protobuf:"bytes,6,opt,name=dimensions,proto3" json:"dimensions,omitempty"`                                                                       // размеры
+	Manufacturer  *Manufacturer          `protobuf:"bytes,7,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`                                                                   // производитель
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`                                                                                   // теги
+	Metadata      map[string]*Value      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // доп. данные
+	Discontinued  bool                   `protobuf:"varint,10,opt,name=discontinued,proto3" json:"discontinued,omitempty"`                                                                 // снята с продажи
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartInfo) Reset() {
+	*x = PartInfo{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartInfo) ProtoMessage() {}
+
+func (x *PartInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartInfo.ProtoReflect.Descriptor instead.
+func (*PartInfo) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PartInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PartInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PartInfo) GetPrice() *v1.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PartInfo) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *PartInfo) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_CATEGORY_UNSPECIFIED
+}
+
+func (x *PartInfo) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *PartInfo) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+func (x *PartInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PartInfo) GetMetadata() map[string]*Value {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PartInfo) GetDiscontinued() bool {
+	if x != nil {
+		return x.Discontinued
+	}
+	return false
+}
+
+// Запрос создания детали
+type CreatePartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *PartInfo              `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartRequest) Reset() {
+	*x = CreatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartRequest) ProtoMessage() {}
+
+func (x *CreatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartRequest.ProtoReflect.Descriptor instead.
+func (*CreatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreatePartRequest) GetInfo() *PartInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// Ответ с созданной деталью
+type CreatePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartResponse) Reset() {
+	*x = CreatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartResponse) ProtoMessage() {}
+
+func (x *CreatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartResponse.ProtoReflect.Descriptor instead.
+func (*CreatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// Запрос изменения детали. Меняются только поля из update_mask; остаток меняется только через AdjustStock и резервы
+type UpdatePartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                               // ID детали
+	Info          *PartInfo              `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`                               // новые значения; правила проверяются только для полей из update_mask
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // изменяемые поля PartInfo, кроме stock_quantity
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartRequest) Reset() {
+	*x = UpdatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartRequest) ProtoMessage() {}
+
+func (x *UpdatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdatePartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdatePartRequest) GetInfo() *PartInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *UpdatePartRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// Ответ с изменённой деталью
+type UpdatePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartResponse) Reset() {
+	*x = UpdatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartResponse) ProtoMessage() {}
+
+func (x *UpdatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// Запрос снятия детали с продажи. Деталь остаётся в каталоге с discontinued = true
+type DeletePartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // ID детали
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePartRequest) Reset() {
+	*x = DeletePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartRequest) ProtoMessage() {}
+
+func (x *DeletePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartRequest.ProtoReflect.Descriptor instead.
+func (*DeletePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeletePartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Ответ на снятие детали с продажи
+type DeletePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePartResponse) Reset() {
+	*x = DeletePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartResponse) ProtoMessage() {}
+
+func (x *DeletePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartResponse.ProtoReflect.Descriptor instead.
+func (*DeletePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+// Деталь для массовой загрузки
+type UpsertPart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // ID детали
+	Info          *PartInfo              `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"` // данные детали
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertPart) Reset() {
+	*x = UpsertPart{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertPart) ProtoMessage() {}
+
+func (x *UpsertPart) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertPart.ProtoReflect.Descriptor instead.
+func (*UpsertPart) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpsertPart) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpsertPart) GetInfo() *PartInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// Запрос массовой загрузки деталей: новые создаются, существующие заменяются целиком
+type BatchUpsertPartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parts         []*UpsertPart          `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpsertPartsRequest) Reset() {
+	*x = BatchUpsertPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpsertPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpsertPartsRequest) ProtoMessage() {}
+
+func (x *BatchUpsertPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpsertPartsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpsertPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatchUpsertPartsRequest) GetParts() []*UpsertPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+// Ответ с загруженными деталями
+type BatchUpsertPartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parts         []*Part                `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpsertPartsResponse) Reset() {
+	*x = BatchUpsertPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpsertPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpsertPartsResponse) ProtoMessage() {}
+
+func (x *BatchUpsertPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpsertPartsResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpsertPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BatchUpsertPartsResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+// Позиция резерва
+type ReservationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"` // ID детали
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`                // количество
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationItem) Reset() {
+	*x = ReservationItem{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationItem) ProtoMessage() {}
+
+func (x *ReservationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationItem.ProtoReflect.Descriptor instead.
+func (*ReservationItem) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReservationItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ReservationItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Запрос резервирования деталей под заказ
+type ReservePartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"` // ID заказа
+	Items         []*ReservationItem     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                          // резервируемые позиции
+	Ttl           *durationpb.Duration   `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`                              // время жизни резерва (по умолчанию из конфига)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservePartsRequest) Reset() {
+	*x = ReservePartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservePartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservePartsRequest) ProtoMessage() {}
+
+func (x *ReservePartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservePartsRequest.ProtoReflect.Descriptor instead.
+func (*ReservePartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReservePartsRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *ReservePartsRequest) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReservePartsRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// Ответ с созданным резервом
+type ReservePartsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReservationUuid string                 `protobuf:"bytes,1,opt,name=reservation_uuid,json=reservationUuid,proto3" json:"reservation_uuid,omitempty"` // ID резерва
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                   // момент истечения резерва
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReservePartsResponse) Reset() {
+	*x = ReservePartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservePartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservePartsResponse) ProtoMessage() {}
+
+func (x *ReservePartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservePartsResponse.ProtoReflect.Descriptor instead.
+func (*ReservePartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReservePartsResponse) GetReservationUuid() string {
+	if x != nil {
+		return x.ReservationUuid
+	}
+	return ""
+}
+
+func (x *ReservePartsResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Запрос снятия резерва
+type ReleaseReservationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReservationUuid string                 `protobuf:"bytes,1,opt,name=reservation_uuid,json=reservationUuid,proto3" json:"reservation_uuid,omitempty"` // ID резерва
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationRequest) Reset() {
+	*x = ReleaseReservationRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationRequest) ProtoMessage() {}
+
+func (x *ReleaseReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReleaseReservationRequest) GetReservationUuid() string {
+	if x != nil {
+		return x.ReservationUuid
+	}
+	return ""
+}
+
+// Ответ на снятие резерва
+type ReleaseReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationResponse) Reset() {
+	*x = ReleaseReservationResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationResponse) ProtoMessage() {}
+
+func (x *ReleaseReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+// Запрос подтверждения резерва
+type CommitReservationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReservationUuid string                 `protobuf:"bytes,1,opt,name=reservation_uuid,json=reservationUuid,proto3" json:"reservation_uuid,omitempty"` // ID резерва
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CommitReservationRequest) Reset() {
+	*x = CommitReservationRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationRequest) ProtoMessage() {}
+
+func (x *CommitReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationRequest.ProtoReflect.Descriptor instead.
+func (*CommitReservationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CommitReservationRequest) GetReservationUuid() string {
+	if x != nil {
+		return x.ReservationUuid
+	}
+	return ""
+}
+
+// Ответ на подтверждение резерва
+type CommitReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitReservationResponse) Reset() {
+	*x = CommitReservationResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationResponse) ProtoMessage() {}
+
+func (x *CommitReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationResponse.ProtoReflect.Descriptor instead.
+func (*CommitReservationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+// Нехватка остатка по детали
+type StockShortage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"` // ID детали
+	Requested     int64                  `protobuf:"varint,2,opt,name=requested,proto3" json:"requested,omitempty"`              // запрошено
+	Available     int64                  `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`              // доступно на складе
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockShortage) Reset() {
+	*x = StockShortage{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockShortage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockShortage) ProtoMessage() {}
+
+func (x *StockShortage) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockShortage.ProtoReflect.Descriptor instead.
+func (*StockShortage) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StockShortage) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *StockShortage) GetRequested() int64 {
+	if x != nil {
+		return x.Requested
+	}
+	return 0
+}
+
+func (x *StockShortage) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+// Детали ошибки FailedPrecondition при нехватке остатков
+type InsufficientStock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shortages     []*StockShortage       `protobuf:"bytes,1,rep,name=shortages,proto3" json:"shortages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsufficientStock) Reset() {
+	*x = InsufficientStock{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsufficientStock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsufficientStock) ProtoMessage() {}
+
+func (x *InsufficientStock) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsufficientStock.ProtoReflect.Descriptor instead.
+func (*InsufficientStock) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InsufficientStock) GetShortages() []*StockShortage {
+	if x != nil {
+		return x.Shortages
+	}
+	return nil
+}
+
+// Запись журнала движений остатка
+type StockMovement struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uuid            string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                              // ID движения
+	PartUuid        string                 `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`                      // ID детали
+	Sequence        int64                  `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`                                     // порядковый номер в истории детали
+	Type            StockMovementType      `protobuf:"varint,4,opt,name=type,proto3,enum=inventory.v1.StockMovementType" json:"type,omitempty"`         // причина
+	Delta           int64                  `protobuf:"varint,5,opt,name=delta,proto3" json:"delta,omitempty"`                                           // изменение остатка
+	QuantityAfter   int64                  `protobuf:"varint,6,opt,name=quantity_after,json=quantityAfter,proto3" json:"quantity_after,omitempty"`      // остаток после движения
+	Reason          string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`                                          // пояснение
+	Actor           string                 `protobuf:"bytes,8,opt,name=actor,proto3" json:"actor,omitempty"`                                            // UUID пользователя или system
+	ReservationUuid string                 `protobuf:"bytes,9,opt,name=reservation_uuid,json=reservationUuid,proto3" json:"reservation_uuid,omitempty"` // ID резерва; пусто у ручных движений
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                  // момент движения
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StockMovement) Reset() {
+	*x = StockMovement{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovement) ProtoMessage() {}
+
+func (x *StockMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovement.ProtoReflect.Descriptor instead.
+func (*StockMovement) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StockMovement) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *StockMovement) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *StockMovement) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *StockMovement) GetType() StockMovementType {
+	if x != nil {
+		return x.Type
+	}
+	return StockMovementType_STOCK_MOVEMENT_TYPE_UNSPECIFIED
+}
+
+func (x *StockMovement) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockMovement) GetQuantityAfter() int64 {
+	if x != nil {
+		return x.QuantityAfter
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockMovement) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *StockMovement) GetReservationUuid() string {
+	if x != nil {
+		return x.ReservationUuid
+	}
+	return ""
+}
+
+func (x *StockMovement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Запрос ручного изменения остатка. Резервы и продажи проводятся только через резервирование
+type AdjustStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`              // ID детали
+	Type          StockMovementType      `protobuf:"varint,2,opt,name=type,proto3,enum=inventory.v1.StockMovementType" json:"type,omitempty"` // RECEIPT, RETURN или CORRECTION
+	Delta         int64                  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`                                   // изменение остатка; у RECEIPT и RETURN положительное
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                                  // пояснение для журнала
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockRequest) ProtoMessage() {}
+
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AdjustStockRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetType() StockMovementType {
+	if x != nil {
+		return x.Type
+	}
+	return StockMovementType_STOCK_MOVEMENT_TYPE_UNSPECIFIED
+}
+
+func (x *AdjustStockRequest) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Ответ с проведённым движением
+type AdjustStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movement      *StockMovement         `protobuf:"bytes,1,opt,name=movement,proto3" json:"movement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockResponse) Reset() {
+	*x = AdjustStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockResponse) ProtoMessage() {}
+
+func (x *AdjustStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockResponse.ProtoReflect.Descriptor instead.
+func (*AdjustStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AdjustStockResponse) GetMovement() *StockMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return nil
+}
+
+// Запрос журнала движений детали
+type ListStockMovementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`    // ID детали
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // размер страницы; 0 — 100
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token предыдущей страницы
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsRequest) Reset() {
+	*x = ListStockMovementsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsRequest) ProtoMessage() {}
+
+func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListStockMovementsRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Ответ с журналом движений от новых к старым
+type ListStockMovementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movements     []*StockMovement       `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // токен следующей страницы; пусто, если страниц больше нет
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsResponse) Reset() {
+	*x = ListStockMovementsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsResponse) ProtoMessage() {}
+
+func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListStockMovementsResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+func (x *ListStockMovementsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
+
+const file_inventory_v1_inventory_proto_rawDesc = "" +
+	"\n" +
+	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x15common/v1/money.proto\x1a\x17validate/validate.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x9d\x01\n" +
+	"\x05Value\x12#\n" +
+	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12!\n" +
+	"\vint64_value\x18\x02 \x01(\x03H\x00R\n" +
+	"int64Value\x12#\n" +
+	"\fdouble_value\x18\x03 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x04 \x01(\bH\x00R\tboolValueB\x06\n" +
+	"\x04kind\"\xaa\x01\n" +
+	"\n" +
+	"Dimensions\x12&\n" +
+	"\x06length\x18\x01 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x06length\x12$\n" +
+	"\x05width\x18\x02 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x05width\x12&\n" +
+	"\x06height\x18\x03 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x06height\x12&\n" +
+	"\x06weight\x18\x04 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x06weight\"c\n" +
+	"\fManufacturer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\x12%\n" +
+	"\awebsite\x18\x03 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\x88\x01\x01R\awebsite\"\xec\x05\n" +
+	"\x04Part\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12.\n" +
+	"\x0estock_quantity\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\rstockQuantity\x12>\n" +
+	"\bcategory\x18\x06 \x01(\x0e2\x16.inventory.v1.CategoryB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\bcategory\x12B\n" +
+	"\n" +
+	"dimensions\x18\a \x01(\v2\x18.inventory.v1.DimensionsB\b\xfaB\x05\x8a\x01\x02\x10\x01R\n" +
+	"dimensions\x12H\n" +
+	"\fmanufacturer\x18\b \x01(\v2\x1a.inventory.v1.ManufacturerB\b\xfaB\x05\x8a\x01\x02\x10\x01R\fmanufacturer\x12\x1c\n" +
+	"\x04tags\x18\t \x03(\tB\b\xfaB\x05\x92\x01\x02\x18\x01R\x04tags\x12<\n" +
+	"\bmetadata\x18\n" +
+	" \x03(\v2 .inventory.v1.Part.MetadataEntryR\bmetadata\x12C\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\tcreatedAt\x12C\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\tupdatedAt\x120\n" +
+	"\x05price\x18\r \x01(\v2\x10.common.v1.MoneyB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05price\x12\"\n" +
+	"\fdiscontinued\x18\x0e \x01(\bR\fdiscontinued\x1aP\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01J\x04\b\x04\x10\x05\".\n" +
+	"\x0eGetPartRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\"C\n" +
+	"\x0fGetPartResponse\x120\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04part\"\xef\x01\n" +
+	"\vPartsFilter\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12\x14\n" +
+	"\x05names\x18\x02 \x03(\tR\x05names\x126\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryR\n" +
+	"categories\x125\n" +
+	"\x16manufacturer_countries\x18\x04 \x03(\tR\x15manufacturerCountries\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x121\n" +
+	"\x14include_discontinued\x18\x06 \x01(\bR\x13includeDiscontinued\"a\n" +
+	"\fPartsOrderBy\x12=\n" +
+	"\x05field\x18\x01 \x01(\x0e2\x1d.inventory.v1.PartsOrderFieldB\b\xfaB\x05\x82\x01\x02\x10\x01R\x05field\x12\x12\n" +
+	"\x04desc\x18\x02 \x01(\bR\x04desc\"\xc4\x01\n" +
+	"\x10ListPartsRequest\x121\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x125\n" +
+	"\border_by\x18\x04 \x01(\v2\x1a.inventory.v1.PartsOrderByR\aorderBy\"\x86\x01\n" +
+	"\x11ListPartsResponse\x12(\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"\x8a\x01\n" +
+	"\x12SearchPartsRequest\x12 \n" +
+	"\x05query\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\x05query\x121\n" +
+	"\x06filter\x18\x02 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12\x1f\n" +
+	"\x05limit\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\x05limit\"A\n" +
+	"\x0fSearchHighlight\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
+	"\asnippet\x18\x02 \x01(\tR\asnippet\"\x8c\x01\n" +
+	"\rPartSearchHit\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12=\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x1d.inventory.v1.SearchHighlightR\n" +
+	"highlights\"F\n" +
+	"\x13SearchPartsResponse\x12/\n" +
+	"\x04hits\x18\x01 \x03(\v2\x1b.inventory.v1.PartSearchHitR\x04hits\"\xdc\x04\n" +
+	"\bPartInfo\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\x04name\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80 R\vdescription\x120\n" +
+	"\x05price\x18\x03 \x01(\v2\x10.common.v1.MoneyB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05price\x12.\n" +
+	"\x0estock_quantity\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\rstockQuantity\x12>\n" +
+	"\bcategory\x18\x05 \x01(\x0e2\x16.inventory.v1.CategoryB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\bcategory\x12B\n" +
+	"\n" +
+	"dimensions\x18\x06 \x01(\v2\x18.inventory.v1.DimensionsB\b\xfaB\x05\x8a\x01\x02\x10\x01R\n" +
+	"dimensions\x12H\n" +
+	"\fmanufacturer\x18\a \x01(\v2\x1a.inventory.v1.ManufacturerB\b\xfaB\x05\x8a\x01\x02\x10\x01R\fmanufacturer\x12\x1c\n" +
+	"\x04tags\x18\b \x03(\tB\b\xfaB\x05\x92\x01\x02\x18\x01R\x04tags\x12@\n" +
+	"\bmetadata\x18\t \x03(\v2$.inventory.v1.PartInfo.MetadataEntryR\bmetadata\x12\"\n" +
+	"\fdiscontinued\x18\n" +
+	" \x01(\bR\fdiscontinued\x1aP\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"I\n" +
+	"\x11CreatePartRequest\x124\n" +
+	"\x04info\x18\x01 \x01(\v2\x16.inventory.v1.PartInfoB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04info\"<\n" +
+	"\x12CreatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\xae\x01\n" +
+	"\x11UpdatePartRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\x124\n" +
+	"\x04info\x18\x02 \x01(\v2\x16.inventory.v1.PartInfoB\b\xfaB\x05\x8a\x01\x02\b\x01R\x04info\x12E\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskB\b\xfaB\x05\x8a\x01\x02\x10\x01R\n" +
+	"updateMask\"<\n" +
+	"\x12UpdatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"1\n" +
+	"\x11DeletePartRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\"\x14\n" +
+	"\x12DeletePartResponse\"`\n" +
+	"\n" +
+	"UpsertPart\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x04uuid\x124\n" +
+	"\x04info\x18\x02 \x01(\v2\x16.inventory.v1.PartInfoB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04info\"V\n" +
+	"\x17BatchUpsertPartsRequest\x12;\n" +
+	"\x05parts\x18\x01 \x03(\v2\x18.inventory.v1.UpsertPartB\v\xfaB\b\x92\x01\x05\b\x01\x10\xf4\x03R\x05parts\"D\n" +
+	"\x18BatchUpsertPartsResponse\x12(\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"]\n" +
+	"\x0fReservationItem\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\bpartUuid\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bquantity\"\xaa\x01\n" +
+	"\x13ReservePartsRequest\x12'\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\torderUuid\x12=\n" +
+	"\x05items\x18\x02 \x03(\v2\x1d.inventory.v1.ReservationItemB\b\xfaB\x05\x92\x01\x02\b\x01R\x05items\x12+\n" +
+	"\x03ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\"|\n" +
+	"\x14ReservePartsResponse\x12)\n" +
+	"\x10reservation_uuid\x18\x01 \x01(\tR\x0freservationUuid\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"P\n" +
+	"\x19ReleaseReservationRequest\x123\n" +
+	"\x10reservation_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x0freservationUuid\"\x1c\n" +
+	"\x1aReleaseReservationResponse\"O\n" +
+	"\x18CommitReservationRequest\x123\n" +
+	"\x10reservation_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x0freservationUuid\"\x1b\n" +
+	"\x19CommitReservationResponse\"h\n" +
+	"\rStockShortage\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1c\n" +
+	"\trequested\x18\x02 \x01(\x03R\trequested\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x03R\tavailable\"N\n" +
+	"\x11InsufficientStock\x129\n" +
+	"\tshortages\x18\x01 \x03(\v2\x1b.inventory.v1.StockShortageR\tshortages\"\xe2\x02\n" +
+	"\rStockMovement\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bsequence\x18\x03 \x01(\x03R\bsequence\x123\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1f.inventory.v1.StockMovementTypeR\x04type\x12\x14\n" +
+	"\x05delta\x18\x05 \x01(\x03R\x05delta\x12%\n" +
+	"\x0equantity_after\x18\x06 \x01(\x03R\rquantityAfter\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\b \x01(\tR\x05actor\x12)\n" +
+	"\x10reservation_uuid\x18\t \x01(\tR\x0freservationUuid\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc1\x01\n" +
+	"\x12AdjustStockRequest\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\bpartUuid\x12A\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1f.inventory.v1.StockMovementTypeB\f\xfaB\t\x82\x01\x06\x18\x01\x18\x04\x18\x05R\x04type\x12\x1d\n" +
+	"\x05delta\x18\x03 \x01(\x03B\a\xfaB\x04\"\x028\x00R\x05delta\x12\"\n" +
+	"\x06reason\x18\x04 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x04R\x06reason\"N\n" +
+	"\x13AdjustStockResponse\x127\n" +
+	"\bmovement\x18\x01 \x01(\v2\x1b.inventory.v1.StockMovementR\bmovement\"\x8a\x01\n" +
+	"\x19ListStockMovementsRequest\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\bpartUuid\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"\x1aListStockMovementsResponse\x129\n" +
+	"\tmovements\x18\x01 \x03(\v2\x1b.inventory.v1.StockMovementR\tmovements\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*v\n" +
+	"\bCategory\x12\x18\n" +
+	"\x14CATEGORY_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fCATEGORY_ENGINE\x10\x01\x12\x11\n" +
+	"\rCATEGORY_FUEL\x10\x02\x12\x15\n" +
+	"\x11CATEGORY_PORTHOLE\x10\x03\x12\x11\n" +
+	"\rCATEGORY_WING\x10\x04*\xb5\x01\n" +
+	"\x0fPartsOrderField\x12!\n" +
+	"\x1dPARTS_ORDER_FIELD_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PARTS_ORDER_FIELD_PRICE\x10\x01\x12\x1a\n" +
+	"\x16PARTS_ORDER_FIELD_NAME\x10\x02\x12 \n" +
+	"\x1cPARTS_ORDER_FIELD_CREATED_AT\x10\x03\x12$\n" +
+	" PARTS_ORDER_FIELD_STOCK_QUANTITY\x10\x04*\xe0\x01\n" +
+	"\x11StockMovementType\x12#\n" +
+	"\x1fSTOCK_MOVEMENT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSTOCK_MOVEMENT_TYPE_RECEIPT\x10\x01\x12#\n" +
+	"\x1fSTOCK_MOVEMENT_TYPE_RESERVATION\x10\x02\x12\x1c\n" +
+	"\x18STOCK_MOVEMENT_TYPE_SALE\x10\x03\x12\x1e\n" +
+	"\x1aSTOCK_MOVEMENT_TYPE_RETURN\x10\x04\x12\"\n" +
+	"\x1eSTOCK_MOVEMENT_TYPE_CORRECTION\x10\x052\xe4\n" +
+	"\n" +
+	"\x10InventoryService\x12c\n" +
+	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/part/{uuid}\x12j\n" +
+	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/part/list\x12r\n" +
+	"\vSearchParts\x12 .inventory.v1.SearchPartsRequest\x1a!.inventory.v1.SearchPartsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/part/search\x12h\n" +
+	"\n" +
+	"CreatePart\x12\x1f.inventory.v1.CreatePartRequest\x1a .inventory.v1.CreatePartResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/api/v1/part\x12o\n" +
+	"\n" +
+	"UpdatePart\x12\x1f.inventory.v1.UpdatePartRequest\x1a .inventory.v1.UpdatePartResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/api/v1/part/{uuid}\x12l\n" +
+	"\n" +
+	"DeletePart\x12\x1f.inventory.v1.DeletePartRequest\x1a .inventory.v1.DeletePartResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/part/{uuid}\x12\x80\x01\n" +
+	"\x10BatchUpsertParts\x12%.inventory.v1.BatchUpsertPartsRequest\x1a&.inventory.v1.BatchUpsertPartsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/part/batch\x12U\n" +
+	"\fReserveParts\x12!.inventory.v1.ReservePartsRequest\x1a\".inventory.v1.ReservePartsResponse\x12g\n" +
+	"\x12ReleaseReservation\x12'.inventory.v1.ReleaseReservationRequest\x1a(.inventory.v1.ReleaseReservationResponse\x12d\n" +
+	"\x11CommitReservation\x12&.inventory.v1.CommitReservationRequest\x1a'.inventory.v1.CommitReservationResponse\x12}\n" +
+	"\vAdjustStock\x12 .inventory.v1.AdjustStockRequest\x1a!.inventory.v1.AdjustStockResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/part/{part_uuid}/stock\x12\x99\x01\n" +
+	"\x12ListStockMovements\x12'.inventory.v1.ListStockMovementsRequest\x1a(.inventory.v1.ListStockMovementsResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/part/{part_uuid}/stock/movementsB\xbc\x01\x92Au\x12K\n" +
 	"\x15Inventory Service API\x12+API for managing spacecraft parts inventory2\x051.0.0*\x02\x01\x022\x10application/json:\x10application/jsonZBgithub.com/ZanDattSu/star-factory/shared/pkg/proto/v1;inventory_v1b\x06proto3"
 
 var (
@@ -800,43 +2718,128 @@ func file_inventory_v1_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_v1_inventory_proto_rawDescData
 }
 
-var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_inventory_v1_inventory_proto_goTypes = []any{
-	(Category)(0),                 // 0: inventory.v1.Category
-	(*Value)(nil),                 // 1: inventory.v1.Value
-	(*Dimensions)(nil),            // 2: inventory.v1.Dimensions
-	(*Manufacturer)(nil),          // 3: inventory.v1.Manufacturer
-	(*Part)(nil),                  // 4: inventory.v1.Part
-	(*GetPartRequest)(nil),        // 5: inventory.v1.GetPartRequest
-	(*GetPartResponse)(nil),       // 6: inventory.v1.GetPartResponse
-	(*PartsFilter)(nil),           // 7: inventory.v1.PartsFilter
-	(*ListPartsRequest)(nil),      // 8: inventory.v1.ListPartsRequest
-	(*ListPartsResponse)(nil),     // 9: inventory.v1.ListPartsResponse
-	nil,                           // 10: inventory.v1.Part.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(Category)(0),                      // 0: inventory.v1.Category
+	(PartsOrderField)(0),               // 1: inventory.v1.PartsOrderField
+	(StockMovementType)(0),             // 2: inventory.v1.StockMovementType
+	(*Value)(nil),                      // 3: inventory.v1.Value
+	(*Dimensions)(nil),                 // 4: inventory.v1.Dimensions
+	(*Manufacturer)(nil),               // 5: inventory.v1.Manufacturer
+	(*Part)(nil),                       // 6: inventory.v1.Part
+	(*GetPartRequest)(nil),             // 7: inventory.v1.GetPartRequest
+	(*GetPartResponse)(nil),            // 8: inventory.v1.GetPartResponse
+	(*PartsFilter)(nil),                // 9: inventory.v1.PartsFilter
+	(*PartsOrderBy)(nil),               // 10: inventory.v1.PartsOrderBy
+	(*ListPartsRequest)(nil),           // 11: inventory.v1.ListPartsRequest
+	(*ListPartsResponse)(nil),          // 12: inventory.v1.ListPartsResponse
+	(*SearchPartsRequest)(nil),         // 13: inventory.v1.SearchPartsRequest
+	(*SearchHighlight)(nil),            // 14: inventory.v1.SearchHighlight
+	(*PartSearchHit)(nil),              // 15: inventory.v1.PartSearchHit
+	(*SearchPartsResponse)(nil),        // 16: inventory.v1.SearchPartsResponse
+	(*PartInfo)(nil),                   // 17: inventory.v1.PartInfo
+	(*CreatePartRequest)(nil),          // 18: inventory.v1.CreatePartRequest
+	(*CreatePartResponse)(nil),         // 19: inventory.v1.CreatePartResponse
+	(*UpdatePartRequest)(nil),          // 20: inventory.v1.UpdatePartRequest
+	(*UpdatePartResponse)(nil),         // 21: inventory.v1.UpdatePartResponse
+	(*DeletePartRequest)(nil),          // 22: inventory.v1.DeletePartRequest
+	(*DeletePartResponse)(nil),         // 23: inventory.v1.DeletePartResponse
+	(*UpsertPart)(nil),                 // 24: inventory.v1.UpsertPart
+	(*BatchUpsertPartsRequest)(nil),    // 25: inventory.v1.BatchUpsertPartsRequest
+	(*BatchUpsertPartsResponse)(nil),   // 26: inventory.v1.BatchUpsertPartsResponse
+	(*ReservationItem)(nil),            // 27: inventory.v1.ReservationItem
+	(*ReservePartsRequest)(nil),        // 28: inventory.v1.ReservePartsRequest
+	(*ReservePartsResponse)(nil),       // 29: inventory.v1.ReservePartsResponse
+	(*ReleaseReservationRequest)(nil),  // 30: inventory.v1.ReleaseReservationRequest
+	(*ReleaseReservationResponse)(nil), // 31: inventory.v1.ReleaseReservationResponse
+	(*CommitReservationRequest)(nil),   // 32: inventory.v1.CommitReservationRequest
+	(*CommitReservationResponse)(nil),  // 33: inventory.v1.CommitReservationResponse
+	(*StockShortage)(nil),              // 34: inventory.v1.StockShortage
+	(*InsufficientStock)(nil),          // 35: inventory.v1.InsufficientStock
+	(*StockMovement)(nil),              // 36: inventory.v1.StockMovement
+	(*AdjustStockRequest)(nil),         // 37: inventory.v1.AdjustStockRequest
+	(*AdjustStockResponse)(nil),        // 38: inventory.v1.AdjustStockResponse
+	(*ListStockMovementsRequest)(nil),  // 39: inventory.v1.ListStockMovementsRequest
+	(*ListStockMovementsResponse)(nil), // 40: inventory.v1.ListStockMovementsResponse
+	nil,                                // 41: inventory.v1.Part.MetadataEntry
+	nil,                                // 42: inventory.v1.PartInfo.MetadataEntry
+	(*timestamppb.Timestamp)(nil),      // 43: google.protobuf.Timestamp
+	(*v1.Money)(nil),                   // 44: common.v1.Money
+	(*fieldmaskpb.FieldMask)(nil),      // 45: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),        // 46: google.protobuf.Duration
 }
 var file_inventory_v1_inventory_proto_depIdxs = []int32{
 	0,  // 0: inventory.v1.Part.category:type_name -> inventory.v1.Category
-	2,  // 1: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
-	3,  // 2: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
-	10, // 3: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
-	11, // 4: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
-	11, // 5: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 6: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
-	0,  // 7: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
-	7,  // 8: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
-	4,  // 9: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
-	1,  // 10: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
-	5,  // 11: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
-	8,  // 12: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
-	6,  // 13: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
-	9,  // 14: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	4,  // 1: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
+	5,  // 2: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
+	41, // 3: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
+	43, // 4: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
+	43, // 5: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
+	44, // 6: inventory.v1.Part.price:type_name -> common.v1.Money
+	6,  // 7: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
+	0,  // 8: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
+	1,  // 9: inventory.v1.PartsOrderBy.field:type_name -> inventory.v1.PartsOrderField
+	9,  // 10: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	10, // 11: inventory.v1.ListPartsRequest.order_by:type_name -> inventory.v1.PartsOrderBy
+	6,  // 12: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
+	9,  // 13: inventory.v1.SearchPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	6,  // 14: inventory.v1.PartSearchHit.part:type_name -> inventory.v1.Part
+	14, // 15: inventory.v1.PartSearchHit.highlights:type_name -> inventory.v1.SearchHighlight
+	15, // 16: inventory.v1.SearchPartsResponse.hits:type_name -> inventory.v1.PartSearchHit
+	44, // 17: inventory.v1.PartInfo.price:type_name -> common.v1.Money
+	0,  // 18: inventory.v1.PartInfo.category:type_name -> inventory.v1.Category
+	4,  // 19: inventory.v1.PartInfo.dimensions:type_name -> inventory.v1.Dimensions
+	5,  // 20: inventory.v1.PartInfo.manufacturer:type_name -> inventory.v1.Manufacturer
+	42, // 21: inventory.v1.PartInfo.metadata:type_name -> inventory.v1.PartInfo.MetadataEntry
+	17, // 22: inventory.v1.CreatePartRequest.info:type_name -> inventory.v1.PartInfo
+	6,  // 23: inventory.v1.CreatePartResponse.part:type_name -> inventory.v1.Part
+	17, // 24: inventory.v1.UpdatePartRequest.info:type_name -> inventory.v1.PartInfo
+	45, // 25: inventory.v1.UpdatePartRequest.update_mask:type_name -> google.protobuf.FieldMask
+	6,  // 26: inventory.v1.UpdatePartResponse.part:type_name -> inventory.v1.Part
+	17, // 27: inventory.v1.UpsertPart.info:type_name -> inventory.v1.PartInfo
+	24, // 28: inventory.v1.BatchUpsertPartsRequest.parts:type_name -> inventory.v1.UpsertPart
+	6,  // 29: inventory.v1.BatchUpsertPartsResponse.parts:type_name -> inventory.v1.Part
+	27, // 30: inventory.v1.ReservePartsRequest.items:type_name -> inventory.v1.ReservationItem
+	46, // 31: inventory.v1.ReservePartsRequest.ttl:type_name -> google.protobuf.Duration
+	43, // 32: inventory.v1.ReservePartsResponse.expires_at:type_name -> google.protobuf.Timestamp
+	34, // 33: inventory.v1.InsufficientStock.shortages:type_name -> inventory.v1.StockShortage
+	2,  // 34: inventory.v1.StockMovement.type:type_name -> inventory.v1.StockMovementType
+	43, // 35: inventory.v1.StockMovement.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 36: inventory.v1.AdjustStockRequest.type:type_name -> inventory.v1.StockMovementType
+	36, // 37: inventory.v1.AdjustStockResponse.movement:type_name -> inventory.v1.StockMovement
+	36, // 38: inventory.v1.ListStockMovementsResponse.movements:type_name -> inventory.v1.StockMovement
+	3,  // 39: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
+	3,  // 40: inventory.v1.PartInfo.MetadataEntry.value:type_name -> inventory.v1.Value
+	7,  // 41: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
+	11, // 42: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
+	13, // 43: inventory.v1.InventoryService.SearchParts:input_type -> inventory.v1.SearchPartsRequest
+	18, // 44: inventory.v1.InventoryService.CreatePart:input_type -> inventory.v1.CreatePartRequest
+	20, // 45: inventory.v1.InventoryService.UpdatePart:input_type -> inventory.v1.UpdatePartRequest
+	22, // 46: inventory.v1.InventoryService.DeletePart:input_type -> inventory.v1.DeletePartRequest
+	25, // 47: inventory.v1.InventoryService.BatchUpsertParts:input_type -> inventory.v1.BatchUpsertPartsRequest
+	28, // 48: inventory.v1.InventoryService.ReserveParts:input_type -> inventory.v1.ReservePartsRequest
+	30, // 49: inventory.v1.InventoryService.ReleaseReservation:input_type -> inventory.v1.ReleaseReservationRequest
+	32, // 50: inventory.v1.InventoryService.CommitReservation:input_type -> inventory.v1.CommitReservationRequest
+	37, // 51: inventory.v1.InventoryService.AdjustStock:input_type -> inventory.v1.AdjustStockRequest
+	39, // 52: inventory.v1.InventoryService.ListStockMovements:input_type -> inventory.v1.ListStockMovementsRequest
+	8,  // 53: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
+	12, // 54: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
+	16, // 55: inventory.v1.InventoryService.SearchParts:output_type -> inventory.v1.SearchPartsResponse
+	19, // 56: inventory.v1.InventoryService.CreatePart:output_type -> inventory.v1.CreatePartResponse
+	21, // 57: inventory.v1.InventoryService.UpdatePart:output_type -> inventory.v1.UpdatePartResponse
+	23, // 58: inventory.v1.InventoryService.DeletePart:output_type -> inventory.v1.DeletePartResponse
+	26, // 59: inventory.v1.InventoryService.BatchUpsertParts:output_type -> inventory.v1.BatchUpsertPartsResponse
+	29, // 60: inventory.v1.InventoryService.ReserveParts:output_type -> inventory.v1.ReservePartsResponse
+	31, // 61: inventory.v1.InventoryService.ReleaseReservation:output_type -> inventory.v1.ReleaseReservationResponse
+	33, // 62: inventory.v1.InventoryService.CommitReservation:output_type -> inventory.v1.CommitReservationResponse
+	38, // 63: inventory.v1.InventoryService.AdjustStock:output_type -> inventory.v1.AdjustStockResponse
+	40, // 64: inventory.v1.InventoryService.ListStockMovements:output_type -> inventory.v1.ListStockMovementsResponse
+	53, // [53:65] is the sub-list for method output_type
+	41, // [41:53] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_inventory_v1_inventory_proto_init() }
@@ -855,8 +2858,8 @@ func file_inventory_v1_inventory_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      3,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -497,17 +497,6 @@ func (m *Part) validate(all bool) error {
 
 	// no validation rules for Description
 
-	if m.GetPrice() <= 0 {
-		err := PartValidationError{
-			field:  "Price",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if m.GetStockQuantity() < 0 {
 		err := PartValidationError{
 			field:  "StockQuantity",
@@ -710,6 +699,48 @@ func (m *Part) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetPrice() == nil {
+		err := PartValidationError{
+			field:  "Price",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Discontinued
+
 	if len(errors) > 0 {
 		return PartMultiError(errors)
 	}
@@ -1081,6 +1112,8 @@ func (m *PartsFilter) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for IncludeDiscontinued
+
 	if len(errors) > 0 {
 		return PartsFilterMultiError(errors)
 	}
@@ -1158,6 +1191,118 @@ var _ interface {
 	ErrorName() string
 } = PartsFilterValidationError{}
 
+// Validate checks the field values on PartsOrderBy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PartsOrderBy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartsOrderBy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PartsOrderByMultiError, or
+// nil if none found.
+func (m *PartsOrderBy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartsOrderBy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := PartsOrderField_name[int32(m.GetField())]; !ok {
+		err := PartsOrderByValidationError{
+			field:  "Field",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Desc
+
+	if len(errors) > 0 {
+		return PartsOrderByMultiError(errors)
+	}
+
+	return nil
+}
+
+// PartsOrderByMultiError is an error wrapping multiple validation errors
+// returned by PartsOrderBy.ValidateAll() if the designated constraints aren't met.
+type PartsOrderByMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartsOrderByMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartsOrderByMultiError) AllErrors() []error { return m }
+
+// PartsOrderByValidationError is the validation error returned by
+// PartsOrderBy.Validate if the designated constraints aren't met.
+type PartsOrderByValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PartsOrderByValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PartsOrderByValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PartsOrderByValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PartsOrderByValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PartsOrderByValidationError) ErrorName() string { return "PartsOrderByValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PartsOrderByValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPartsOrderBy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PartsOrderByValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PartsOrderByValidationError{}
+
 // Validate checks the field values on ListPartsRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -1209,6 +1354,48 @@ func (m *ListPartsRequest) validate(all bool) error {
 		}
 	}
 
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListPartsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if all {
+		switch v := interface{}(m.GetOrderBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListPartsRequestValidationError{
+					field:  "OrderBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListPartsRequestValidationError{
+					field:  "OrderBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrderBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListPartsRequestValidationError{
+				field:  "OrderBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ListPartsRequestMultiError(errors)
 	}
@@ -1343,6 +1530,10 @@ func (m *ListPartsResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalCount
+
 	if len(errors) > 0 {
 		return ListPartsResponseMultiError(errors)
 	}
@@ -1422,3 +1613,4008 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListPartsResponseValidationError{}
+
+// Validate checks the field values on SearchPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPartsRequestMultiError, or nil if none found.
+func (m *SearchPartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 256 {
+		err := SearchPartsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPartsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPartsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPartsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := SearchPartsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchPartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPartsRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchPartsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchPartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPartsRequestMultiError) AllErrors() []error { return m }
+
+// SearchPartsRequestValidationError is the validation error returned by
+// SearchPartsRequest.Validate if the designated constraints aren't met.
+type SearchPartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPartsRequestValidationError) ErrorName() string {
+	return "SearchPartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPartsRequestValidationError{}
+
+// Validate checks the field values on SearchHighlight with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchHighlight) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchHighlight with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchHighlightMultiError, or nil if none found.
+func (m *SearchHighlight) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchHighlight) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Snippet
+
+	if len(errors) > 0 {
+		return SearchHighlightMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchHighlightMultiError is an error wrapping multiple validation errors
+// returned by SearchHighlight.ValidateAll() if the designated constraints
+// aren't met.
+type SearchHighlightMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchHighlightMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchHighlightMultiError) AllErrors() []error { return m }
+
+// SearchHighlightValidationError is the validation error returned by
+// SearchHighlight.Validate if the designated constraints aren't met.
+type SearchHighlightValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchHighlightValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchHighlightValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchHighlightValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchHighlightValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchHighlightValidationError) ErrorName() string { return "SearchHighlightValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchHighlightValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchHighlight.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchHighlightValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchHighlightValidationError{}
+
+// Validate checks the field values on PartSearchHit with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PartSearchHit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartSearchHit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PartSearchHitMultiError, or
+// nil if none found.
+func (m *PartSearchHit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartSearchHit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartSearchHitValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartSearchHitValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartSearchHitValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Score
+
+	for idx, item := range m.GetHighlights() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PartSearchHitValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PartSearchHitValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PartSearchHitValidationError{
+					field:  fmt.Sprintf("Highlights[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PartSearchHitMultiError(errors)
+	}
+
+	return nil
+}
+
+// PartSearchHitMultiError is an error wrapping multiple validation errors
+// returned by PartSearchHit.ValidateAll() if the designated constraints
+// aren't met.
+type PartSearchHitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartSearchHitMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartSearchHitMultiError) AllErrors() []error { return m }
+
+// PartSearchHitValidationError is the validation error returned by
+// PartSearchHit.Validate if the designated constraints aren't met.
+type PartSearchHitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PartSearchHitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PartSearchHitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PartSearchHitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PartSearchHitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PartSearchHitValidationError) ErrorName() string { return "PartSearchHitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PartSearchHitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPartSearchHit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PartSearchHitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PartSearchHitValidationError{}
+
+// Validate checks the field values on SearchPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPartsResponseMultiError, or nil if none found.
+func (m *SearchPartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchPartsResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchPartsResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchPartsResponseValidationError{
+					field:  fmt.Sprintf("Hits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchPartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPartsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchPartsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchPartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPartsResponseMultiError) AllErrors() []error { return m }
+
+// SearchPartsResponseValidationError is the validation error returned by
+// SearchPartsResponse.Validate if the designated constraints aren't met.
+type SearchPartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPartsResponseValidationError) ErrorName() string {
+	return "SearchPartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPartsResponseValidationError{}
+
+// Validate checks the field values on PartInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PartInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PartInfoMultiError, or nil
+// if none found.
+func (m *PartInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 256 {
+		err := PartInfoValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 4096 {
+		err := PartInfoValidationError{
+			field:  "Description",
+			reason: "value length must be at most 4096 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() == nil {
+		err := PartInfoValidationError{
+			field:  "Price",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartInfoValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartInfoValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartInfoValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetStockQuantity() < 0 {
+		err := PartInfoValidationError{
+			field:  "StockQuantity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _PartInfo_Category_NotInLookup[m.GetCategory()]; ok {
+		err := PartInfoValidationError{
+			field:  "Category",
+			reason: "value must not be in list [CATEGORY_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Category_name[int32(m.GetCategory())]; !ok {
+		err := PartInfoValidationError{
+			field:  "Category",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDimensions() == nil {
+		err := PartInfoValidationError{
+			field:  "Dimensions",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDimensions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartInfoValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartInfoValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDimensions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartInfoValidationError{
+				field:  "Dimensions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetManufacturer() == nil {
+		err := PartInfoValidationError{
+			field:  "Manufacturer",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetManufacturer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PartInfoValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PartInfoValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetManufacturer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PartInfoValidationError{
+				field:  "Manufacturer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	_PartInfo_Tags_Unique := make(map[string]struct{}, len(m.GetTags()))
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if _, exists := _PartInfo_Tags_Unique[item]; exists {
+			err := PartInfoValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_PartInfo_Tags_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Tags[idx]
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetMetadata()))
+		i := 0
+		for key := range m.GetMetadata() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetMetadata()[key]
+			_ = val
+
+			// no validation rules for Metadata[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, PartInfoValidationError{
+							field:  fmt.Sprintf("Metadata[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, PartInfoValidationError{
+							field:  fmt.Sprintf("Metadata[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return PartInfoValidationError{
+						field:  fmt.Sprintf("Metadata[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for Discontinued
+
+	if len(errors) > 0 {
+		return PartInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// PartInfoMultiError is an error wrapping multiple validation errors returned
+// by PartInfo.ValidateAll() if the designated constraints aren't met.
+type PartInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartInfoMultiError) AllErrors() []error { return m }
+
+// PartInfoValidationError is the validation error returned by
+// PartInfo.Validate if the designated constraints aren't met.
+type PartInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PartInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PartInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PartInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PartInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PartInfoValidationError) ErrorName() string { return "PartInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PartInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPartInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PartInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PartInfoValidationError{}
+
+var _PartInfo_Category_NotInLookup = map[Category]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CreatePartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreatePartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePartRequestMultiError, or nil if none found.
+func (m *CreatePartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetInfo() == nil {
+		err := CreatePartRequestValidationError{
+			field:  "Info",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePartRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePartRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePartRequestValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePartRequestMultiError is an error wrapping multiple validation errors
+// returned by CreatePartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreatePartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePartRequestMultiError) AllErrors() []error { return m }
+
+// CreatePartRequestValidationError is the validation error returned by
+// CreatePartRequest.Validate if the designated constraints aren't met.
+type CreatePartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePartRequestValidationError) ErrorName() string {
+	return "CreatePartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePartRequestValidationError{}
+
+// Validate checks the field values on CreatePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePartResponseMultiError, or nil if none found.
+func (m *CreatePartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePartResponseValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePartResponseMultiError is an error wrapping multiple validation errors
+// returned by CreatePartResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreatePartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePartResponseMultiError) AllErrors() []error { return m }
+
+// CreatePartResponseValidationError is the validation error returned by
+// CreatePartResponse.Validate if the designated constraints aren't met.
+type CreatePartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePartResponseValidationError) ErrorName() string {
+	return "CreatePartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePartResponseValidationError{}
+
+// Validate checks the field values on UpdatePartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePartRequestMultiError, or nil if none found.
+func (m *UpdatePartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = UpdatePartRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// skipping validation for info
+
+	if m.GetUpdateMask() == nil {
+		err := UpdatePartRequestValidationError{
+			field:  "UpdateMask",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePartRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePartRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePartRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdatePartRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdatePartRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdatePartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdatePartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePartRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePartRequestValidationError is the validation error returned by
+// UpdatePartRequest.Validate if the designated constraints aren't met.
+type UpdatePartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePartRequestValidationError) ErrorName() string {
+	return "UpdatePartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePartRequestValidationError{}
+
+// Validate checks the field values on UpdatePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePartResponseMultiError, or nil if none found.
+func (m *UpdatePartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePartResponseValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePartResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdatePartResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdatePartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePartResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePartResponseValidationError is the validation error returned by
+// UpdatePartResponse.Validate if the designated constraints aren't met.
+type UpdatePartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePartResponseValidationError) ErrorName() string {
+	return "UpdatePartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePartResponseValidationError{}
+
+// Validate checks the field values on DeletePartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeletePartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePartRequestMultiError, or nil if none found.
+func (m *DeletePartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = DeletePartRequestValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeletePartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeletePartRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeletePartRequestMultiError is an error wrapping multiple validation errors
+// returned by DeletePartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeletePartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePartRequestMultiError) AllErrors() []error { return m }
+
+// DeletePartRequestValidationError is the validation error returned by
+// DeletePartRequest.Validate if the designated constraints aren't met.
+type DeletePartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePartRequestValidationError) ErrorName() string {
+	return "DeletePartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePartRequestValidationError{}
+
+// Validate checks the field values on DeletePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePartResponseMultiError, or nil if none found.
+func (m *DeletePartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeletePartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePartResponseMultiError is an error wrapping multiple validation errors
+// returned by DeletePartResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeletePartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePartResponseMultiError) AllErrors() []error { return m }
+
+// DeletePartResponseValidationError is the validation error returned by
+// DeletePartResponse.Validate if the designated constraints aren't met.
+type DeletePartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePartResponseValidationError) ErrorName() string {
+	return "DeletePartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePartResponseValidationError{}
+
+// Validate checks the field values on UpsertPart with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpsertPart) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertPart with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpsertPartMultiError, or
+// nil if none found.
+func (m *UpsertPart) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertPart) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUuid()); err != nil {
+		err = UpsertPartValidationError{
+			field:  "Uuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetInfo() == nil {
+		err := UpsertPartValidationError{
+			field:  "Info",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertPartValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertPartValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertPartValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpsertPartMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpsertPart) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpsertPartMultiError is an error wrapping multiple validation errors
+// returned by UpsertPart.ValidateAll() if the designated constraints aren't met.
+type UpsertPartMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertPartMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertPartMultiError) AllErrors() []error { return m }
+
+// UpsertPartValidationError is the validation error returned by
+// UpsertPart.Validate if the designated constraints aren't met.
+type UpsertPartValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertPartValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertPartValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertPartValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertPartValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertPartValidationError) ErrorName() string { return "UpsertPartValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpsertPartValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertPart.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertPartValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertPartValidationError{}
+
+// Validate checks the field values on BatchUpsertPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchUpsertPartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchUpsertPartsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchUpsertPartsRequestMultiError, or nil if none found.
+func (m *BatchUpsertPartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchUpsertPartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetParts()); l < 1 || l > 500 {
+		err := BatchUpsertPartsRequestValidationError{
+			field:  "Parts",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetParts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchUpsertPartsRequestValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchUpsertPartsRequestValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchUpsertPartsRequestValidationError{
+					field:  fmt.Sprintf("Parts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchUpsertPartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchUpsertPartsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchUpsertPartsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchUpsertPartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchUpsertPartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchUpsertPartsRequestMultiError) AllErrors() []error { return m }
+
+// BatchUpsertPartsRequestValidationError is the validation error returned by
+// BatchUpsertPartsRequest.Validate if the designated constraints aren't met.
+type BatchUpsertPartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchUpsertPartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchUpsertPartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchUpsertPartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchUpsertPartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchUpsertPartsRequestValidationError) ErrorName() string {
+	return "BatchUpsertPartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUpsertPartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUpsertPartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchUpsertPartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchUpsertPartsRequestValidationError{}
+
+// Validate checks the field values on BatchUpsertPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchUpsertPartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchUpsertPartsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchUpsertPartsResponseMultiError, or nil if none found.
+func (m *BatchUpsertPartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchUpsertPartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetParts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchUpsertPartsResponseValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchUpsertPartsResponseValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchUpsertPartsResponseValidationError{
+					field:  fmt.Sprintf("Parts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchUpsertPartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchUpsertPartsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchUpsertPartsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchUpsertPartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchUpsertPartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchUpsertPartsResponseMultiError) AllErrors() []error { return m }
+
+// BatchUpsertPartsResponseValidationError is the validation error returned by
+// BatchUpsertPartsResponse.Validate if the designated constraints aren't met.
+type BatchUpsertPartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchUpsertPartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchUpsertPartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchUpsertPartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchUpsertPartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchUpsertPartsResponseValidationError) ErrorName() string {
+	return "BatchUpsertPartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUpsertPartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUpsertPartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchUpsertPartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchUpsertPartsResponseValidationError{}
+
+// Validate checks the field values on ReservationItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReservationItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReservationItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReservationItemMultiError, or nil if none found.
+func (m *ReservationItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReservationItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPartUuid()); err != nil {
+		err = ReservationItemValidationError{
+			field:  "PartUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() <= 0 {
+		err := ReservationItemValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReservationItemMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReservationItem) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReservationItemMultiError is an error wrapping multiple validation errors
+// returned by ReservationItem.ValidateAll() if the designated constraints
+// aren't met.
+type ReservationItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReservationItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReservationItemMultiError) AllErrors() []error { return m }
+
+// ReservationItemValidationError is the validation error returned by
+// ReservationItem.Validate if the designated constraints aren't met.
+type ReservationItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReservationItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReservationItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReservationItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReservationItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReservationItemValidationError) ErrorName() string { return "ReservationItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReservationItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReservationItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReservationItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReservationItemValidationError{}
+
+// Validate checks the field values on ReservePartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReservePartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReservePartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReservePartsRequestMultiError, or nil if none found.
+func (m *ReservePartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReservePartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderUuid()); err != nil {
+		err = ReservePartsRequestValidationError{
+			field:  "OrderUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetItems()) < 1 {
+		err := ReservePartsRequestValidationError{
+			field:  "Items",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReservePartsRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReservePartsRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReservePartsRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReservePartsRequestValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReservePartsRequestValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReservePartsRequestValidationError{
+				field:  "Ttl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReservePartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReservePartsRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReservePartsRequestMultiError is an error wrapping multiple validation
+// errors returned by ReservePartsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReservePartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReservePartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReservePartsRequestMultiError) AllErrors() []error { return m }
+
+// ReservePartsRequestValidationError is the validation error returned by
+// ReservePartsRequest.Validate if the designated constraints aren't met.
+type ReservePartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReservePartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReservePartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReservePartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReservePartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReservePartsRequestValidationError) ErrorName() string {
+	return "ReservePartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReservePartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReservePartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReservePartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReservePartsRequestValidationError{}
+
+// Validate checks the field values on ReservePartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReservePartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReservePartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReservePartsResponseMultiError, or nil if none found.
+func (m *ReservePartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReservePartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReservationUuid
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReservePartsResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReservePartsResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReservePartsResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReservePartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReservePartsResponseMultiError is an error wrapping multiple validation
+// errors returned by ReservePartsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReservePartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReservePartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReservePartsResponseMultiError) AllErrors() []error { return m }
+
+// ReservePartsResponseValidationError is the validation error returned by
+// ReservePartsResponse.Validate if the designated constraints aren't met.
+type ReservePartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReservePartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReservePartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReservePartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReservePartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReservePartsResponseValidationError) ErrorName() string {
+	return "ReservePartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReservePartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReservePartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReservePartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReservePartsResponseValidationError{}
+
+// Validate checks the field values on ReleaseReservationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseReservationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseReservationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseReservationRequestMultiError, or nil if none found.
+func (m *ReleaseReservationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseReservationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetReservationUuid()); err != nil {
+		err = ReleaseReservationRequestValidationError{
+			field:  "ReservationUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReleaseReservationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReleaseReservationRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReleaseReservationRequestMultiError is an error wrapping multiple validation
+// errors returned by ReleaseReservationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ReleaseReservationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseReservationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseReservationRequestMultiError) AllErrors() []error { return m }
+
+// ReleaseReservationRequestValidationError is the validation error returned by
+// ReleaseReservationRequest.Validate if the designated constraints aren't met.
+type ReleaseReservationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseReservationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseReservationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseReservationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseReservationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseReservationRequestValidationError) ErrorName() string {
+	return "ReleaseReservationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseReservationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseReservationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseReservationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseReservationRequestValidationError{}
+
+// Validate checks the field values on ReleaseReservationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseReservationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseReservationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseReservationResponseMultiError, or nil if none found.
+func (m *ReleaseReservationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseReservationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReleaseReservationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseReservationResponseMultiError is an error wrapping multiple
+// validation errors returned by ReleaseReservationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ReleaseReservationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseReservationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseReservationResponseMultiError) AllErrors() []error { return m }
+
+// ReleaseReservationResponseValidationError is the validation error returned
+// by ReleaseReservationResponse.Validate if the designated constraints aren't met.
+type ReleaseReservationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseReservationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseReservationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseReservationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseReservationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseReservationResponseValidationError) ErrorName() string {
+	return "ReleaseReservationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseReservationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseReservationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseReservationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseReservationResponseValidationError{}
+
+// Validate checks the field values on CommitReservationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitReservationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitReservationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitReservationRequestMultiError, or nil if none found.
+func (m *CommitReservationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitReservationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetReservationUuid()); err != nil {
+		err = CommitReservationRequestValidationError{
+			field:  "ReservationUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CommitReservationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CommitReservationRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CommitReservationRequestMultiError is an error wrapping multiple validation
+// errors returned by CommitReservationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CommitReservationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitReservationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitReservationRequestMultiError) AllErrors() []error { return m }
+
+// CommitReservationRequestValidationError is the validation error returned by
+// CommitReservationRequest.Validate if the designated constraints aren't met.
+type CommitReservationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitReservationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitReservationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitReservationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitReservationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitReservationRequestValidationError) ErrorName() string {
+	return "CommitReservationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitReservationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitReservationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitReservationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitReservationRequestValidationError{}
+
+// Validate checks the field values on CommitReservationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitReservationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitReservationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitReservationResponseMultiError, or nil if none found.
+func (m *CommitReservationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitReservationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CommitReservationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitReservationResponseMultiError is an error wrapping multiple validation
+// errors returned by CommitReservationResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CommitReservationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitReservationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitReservationResponseMultiError) AllErrors() []error { return m }
+
+// CommitReservationResponseValidationError is the validation error returned by
+// CommitReservationResponse.Validate if the designated constraints aren't met.
+type CommitReservationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitReservationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitReservationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitReservationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitReservationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitReservationResponseValidationError) ErrorName() string {
+	return "CommitReservationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitReservationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitReservationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitReservationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitReservationResponseValidationError{}
+
+// Validate checks the field values on StockShortage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StockShortage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockShortage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StockShortageMultiError, or
+// nil if none found.
+func (m *StockShortage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockShortage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartUuid
+
+	// no validation rules for Requested
+
+	// no validation rules for Available
+
+	if len(errors) > 0 {
+		return StockShortageMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockShortageMultiError is an error wrapping multiple validation errors
+// returned by StockShortage.ValidateAll() if the designated constraints
+// aren't met.
+type StockShortageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockShortageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockShortageMultiError) AllErrors() []error { return m }
+
+// StockShortageValidationError is the validation error returned by
+// StockShortage.Validate if the designated constraints aren't met.
+type StockShortageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockShortageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockShortageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockShortageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockShortageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockShortageValidationError) ErrorName() string { return "StockShortageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StockShortageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockShortage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockShortageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockShortageValidationError{}
+
+// Validate checks the field values on InsufficientStock with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InsufficientStock) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InsufficientStock with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InsufficientStockMultiError, or nil if none found.
+func (m *InsufficientStock) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InsufficientStock) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetShortages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InsufficientStockValidationError{
+						field:  fmt.Sprintf("Shortages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InsufficientStockValidationError{
+						field:  fmt.Sprintf("Shortages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InsufficientStockValidationError{
+					field:  fmt.Sprintf("Shortages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InsufficientStockMultiError(errors)
+	}
+
+	return nil
+}
+
+// InsufficientStockMultiError is an error wrapping multiple validation errors
+// returned by InsufficientStock.ValidateAll() if the designated constraints
+// aren't met.
+type InsufficientStockMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InsufficientStockMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InsufficientStockMultiError) AllErrors() []error { return m }
+
+// InsufficientStockValidationError is the validation error returned by
+// InsufficientStock.Validate if the designated constraints aren't met.
+type InsufficientStockValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InsufficientStockValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InsufficientStockValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InsufficientStockValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InsufficientStockValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InsufficientStockValidationError) ErrorName() string {
+	return "InsufficientStockValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InsufficientStockValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInsufficientStock.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InsufficientStockValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InsufficientStockValidationError{}
+
+// Validate checks the field values on StockMovement with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StockMovement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockMovement with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StockMovementMultiError, or
+// nil if none found.
+func (m *StockMovement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockMovement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uuid
+
+	// no validation rules for PartUuid
+
+	// no validation rules for Sequence
+
+	// no validation rules for Type
+
+	// no validation rules for Delta
+
+	// no validation rules for QuantityAfter
+
+	// no validation rules for Reason
+
+	// no validation rules for Actor
+
+	// no validation rules for ReservationUuid
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StockMovementValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StockMovementValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StockMovementValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StockMovementMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockMovementMultiError is an error wrapping multiple validation errors
+// returned by StockMovement.ValidateAll() if the designated constraints
+// aren't met.
+type StockMovementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockMovementMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockMovementMultiError) AllErrors() []error { return m }
+
+// StockMovementValidationError is the validation error returned by
+// StockMovement.Validate if the designated constraints aren't met.
+type StockMovementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockMovementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockMovementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockMovementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockMovementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockMovementValidationError) ErrorName() string { return "StockMovementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StockMovementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockMovement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockMovementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockMovementValidationError{}
+
+// Validate checks the field values on AdjustStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdjustStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdjustStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustStockRequestMultiError, or nil if none found.
+func (m *AdjustStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdjustStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPartUuid()); err != nil {
+		err = AdjustStockRequestValidationError{
+			field:  "PartUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AdjustStockRequest_Type_InLookup[m.GetType()]; !ok {
+		err := AdjustStockRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [STOCK_MOVEMENT_TYPE_RECEIPT STOCK_MOVEMENT_TYPE_RETURN STOCK_MOVEMENT_TYPE_CORRECTION]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AdjustStockRequest_Delta_NotInLookup[m.GetDelta()]; ok {
+		err := AdjustStockRequestValidationError{
+			field:  "Delta",
+			reason: "value must not be in list [0]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 512 {
+		err := AdjustStockRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 512 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdjustStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AdjustStockRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AdjustStockRequestMultiError is an error wrapping multiple validation errors
+// returned by AdjustStockRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdjustStockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustStockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustStockRequestMultiError) AllErrors() []error { return m }
+
+// AdjustStockRequestValidationError is the validation error returned by
+// AdjustStockRequest.Validate if the designated constraints aren't met.
+type AdjustStockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustStockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustStockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustStockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustStockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustStockRequestValidationError) ErrorName() string {
+	return "AdjustStockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdjustStockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustStockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustStockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustStockRequestValidationError{}
+
+var _AdjustStockRequest_Type_InLookup = map[StockMovementType]struct{}{
+	1: {},
+	4: {},
+	5: {},
+}
+
+var _AdjustStockRequest_Delta_NotInLookup = map[int64]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on AdjustStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdjustStockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdjustStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustStockResponseMultiError, or nil if none found.
+func (m *AdjustStockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdjustStockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMovement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdjustStockResponseValidationError{
+					field:  "Movement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdjustStockResponseValidationError{
+					field:  "Movement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMovement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdjustStockResponseValidationError{
+				field:  "Movement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdjustStockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdjustStockResponseMultiError is an error wrapping multiple validation
+// errors returned by AdjustStockResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdjustStockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustStockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustStockResponseMultiError) AllErrors() []error { return m }
+
+// AdjustStockResponseValidationError is the validation error returned by
+// AdjustStockResponse.Validate if the designated constraints aren't met.
+type AdjustStockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustStockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustStockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustStockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustStockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustStockResponseValidationError) ErrorName() string {
+	return "AdjustStockResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdjustStockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustStockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustStockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustStockResponseValidationError{}
+
+// Validate checks the field values on ListStockMovementsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStockMovementsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStockMovementsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStockMovementsRequestMultiError, or nil if none found.
+func (m *ListStockMovementsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStockMovementsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPartUuid()); err != nil {
+		err = ListStockMovementsRequestValidationError{
+			field:  "PartUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListStockMovementsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListStockMovementsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListStockMovementsRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListStockMovementsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStockMovementsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListStockMovementsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStockMovementsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStockMovementsRequestMultiError) AllErrors() []error { return m }
+
+// ListStockMovementsRequestValidationError is the validation error returned by
+// ListStockMovementsRequest.Validate if the designated constraints aren't met.
+type ListStockMovementsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStockMovementsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStockMovementsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStockMovementsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStockMovementsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStockMovementsRequestValidationError) ErrorName() string {
+	return "ListStockMovementsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStockMovementsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStockMovementsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStockMovementsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStockMovementsRequestValidationError{}
+
+// Validate checks the field values on ListStockMovementsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStockMovementsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStockMovementsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStockMovementsResponseMultiError, or nil if none found.
+func (m *ListStockMovementsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStockMovementsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMovements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStockMovementsResponseValidationError{
+						field:  fmt.Sprintf("Movements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStockMovementsResponseValidationError{
+						field:  fmt.Sprintf("Movements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStockMovementsResponseValidationError{
+					field:  fmt.Sprintf("Movements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListStockMovementsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStockMovementsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListStockMovementsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListStockMovementsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStockMovementsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStockMovementsResponseMultiError) AllErrors() []error { return m }
+
+// ListStockMovementsResponseValidationError is the validation error returned
+// by ListStockMovementsResponse.Validate if the designated constraints aren't met.
+type ListStockMovementsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStockMovementsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStockMovementsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStockMovementsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStockMovementsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStockMovementsResponseValidationError) ErrorName() string {
+	return "ListStockMovementsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStockMovementsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStockMovementsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStockMovementsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStockMovementsResponseValidationError{}
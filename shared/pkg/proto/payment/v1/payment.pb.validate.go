@@ -106,6 +106,46 @@ func (m *PayOrderRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetAmount() == nil {
+		err := PayOrderRequestValidationError{
+			field:  "Amount",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PayOrderRequestValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PayOrderRequestValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PayOrderRequestValidationError{
+				field:  "Amount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return PayOrderRequestMultiError(errors)
 	}
@@ -315,3 +355,311 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = PayOrderResponseValidationError{}
+
+// Validate checks the field values on RefundPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefundPaymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefundPaymentRequestMultiError, or nil if none found.
+func (m *RefundPaymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundPaymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderUuid()); err != nil {
+		err = RefundPaymentRequestValidationError{
+			field:  "OrderUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserUuid()); err != nil {
+		err = RefundPaymentRequestValidationError{
+			field:  "UserUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetTransactionUuid()); err != nil {
+		err = RefundPaymentRequestValidationError{
+			field:  "TransactionUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() == nil {
+		err := RefundPaymentRequestValidationError{
+			field:  "Amount",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefundPaymentRequestValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefundPaymentRequestValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefundPaymentRequestValidationError{
+				field:  "Amount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RefundPaymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RefundPaymentRequest) _validateUuid(uuid string) error {
+	if matched := _payment_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RefundPaymentRequestMultiError is an error wrapping multiple validation
+// errors returned by RefundPaymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefundPaymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundPaymentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundPaymentRequestMultiError) AllErrors() []error { return m }
+
+// RefundPaymentRequestValidationError is the validation error returned by
+// RefundPaymentRequest.Validate if the designated constraints aren't met.
+type RefundPaymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundPaymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundPaymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundPaymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundPaymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundPaymentRequestValidationError) ErrorName() string {
+	return "RefundPaymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefundPaymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundPaymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundPaymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundPaymentRequestValidationError{}
+
+// Validate checks the field values on RefundPaymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefundPaymentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundPaymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefundPaymentResponseMultiError, or nil if none found.
+func (m *RefundPaymentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundPaymentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetRefundUuid()); err != nil {
+		err = RefundPaymentResponseValidationError{
+			field:  "RefundUuid",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RefundPaymentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RefundPaymentResponse) _validateUuid(uuid string) error {
+	if matched := _payment_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RefundPaymentResponseMultiError is an error wrapping multiple validation
+// errors returned by RefundPaymentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefundPaymentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundPaymentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundPaymentResponseMultiError) AllErrors() []error { return m }
+
+// RefundPaymentResponseValidationError is the validation error returned by
+// RefundPaymentResponse.Validate if the designated constraints aren't met.
+type RefundPaymentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundPaymentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundPaymentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundPaymentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundPaymentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundPaymentResponseValidationError) ErrorName() string {
+	return "RefundPaymentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefundPaymentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundPaymentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundPaymentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundPaymentResponseValidationError{}